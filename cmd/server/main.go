@@ -13,18 +13,30 @@ import (
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/usecase"
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
 	"github.com/RodriguesYan/hub-market-data-service/internal/config"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
 	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
 	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/cache"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/calendar"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/factor"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/index"
 	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/persistence"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/scenario"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/spread"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/volatility"
 	"github.com/RodriguesYan/hub-market-data-service/internal/metrics"
 	grpcServer "github.com/RodriguesYan/hub-market-data-service/internal/presentation/grpc"
+	"github.com/RodriguesYan/hub-market-data-service/internal/ratelimit"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
 	cacheHandler "github.com/RodriguesYan/hub-market-data-service/pkg/cache"
 	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
-	pb "github.com/RodriguesYan/hub-proto-contracts/monolith"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -49,7 +61,7 @@ func main() {
 	redisClient := initializeRedis(cfg)
 	defer redisClient.Close()
 
-	marketDataRepo := persistence.NewMarketDataRepository(db)
+	marketDataRepo := persistence.NewMarketDataRepositoryWithChunkSize(db, cfg.MarketData.QueryChunkSize)
 
 	cacheClient := cacheHandler.NewRedisCacheHandler(redisClient)
 	cachedMarketDataRepo := cache.NewMarketDataCacheRepository(
@@ -59,13 +71,98 @@ func main() {
 	)
 
 	getMarketDataUsecase := usecase.NewGetMarketDataUseCase(cachedMarketDataRepo)
+	getBatchMarketDataUsecase := usecase.NewGetBatchMarketDataUseCase(cachedMarketDataRepo, cfg.MarketData.MaxBatchSize)
 
-	assetDataService := domainService.NewAssetDataService()
-	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	indices, err := initializeIndices(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load custom indices: %v", err)
+	}
+
+	// Every engine reads the time from one clock, from the initial quotes to the ticks
+	clock := simulation.SystemClock{}
+
+	assetDataService, err := newAssetDataService(indices, clock.Now())
+	if err != nil {
+		log.Fatalf("Failed to register custom indices: %v", err)
+	}
+	sessionSchedule, err := model.ParseSessionSchedule(cfg.MarketData.SessionCloseTime, cfg.MarketData.SessionTimezone)
+	if err != nil {
+		log.Fatalf("Failed to initialize trading sessions: %v", err)
+	}
+	sessionRolloverService := initializeSessions(cfg, db, assetDataService, sessionSchedule, clock)
+
+	// Restored before the engine is built, so the first quotes continue from the saved prices.
+	// Deferred before the engine stops in waitForShutdown, the final save sees the last prices.
+	stopQuoteStates, err := initializeQuoteStates(cfg, db, assetDataService)
+	if err != nil {
+		log.Fatalf("Failed to initialize simulator state persistence: %v", err)
+	}
+	defer stopQuoteStates()
+
+	marketHoursService, err := initializeMarketHours(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize exchange calendars: %v", err)
+	}
+
+	spreadService, err := initializeSpreads(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize spread models: %v", err)
+	}
+
+	factorModel, err := initializeFactors(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize the factor model: %v", err)
+	}
+
+	volatilitySurface, err := initializeVolatilitySurface(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize the option volatility surface: %v", err)
+	}
+
+	scenarios, err := initializeScenarios(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load scenarios: %v", err)
+	}
+
+	engines := engineConfig{
+		marketHours:  marketHoursService,
+		spreads:      spreadService,
+		factorModel:  factorModel,
+		volatility:   volatilitySurface,
+		indices:      indices,
+		scenarios:    scenarios,
+		limitBand:    decimal.NewFromFloat(cfg.MarketData.LimitBandPercent),
+		haltCooldown: cfg.MarketData.HaltCooldown,
+		sessions:     sessionSchedule,
+		clock:        clock,
+	}
+
+	priceOscillationService, err := engines.newEngine(assetDataService, sessionRolloverService, initializeRandom(cfg))
+	if err != nil {
+		log.Fatalf("Failed to initialize the price simulation: %v", err)
+	}
 	priceOscillationService.Start()
 
+	if cfg.MarketData.Scenario != "" {
+		if _, err := priceOscillationService.StartNamedScenario(cfg.MarketData.Scenario); err != nil {
+			log.Fatalf("Failed to start scenario %s: %v", cfg.MarketData.Scenario, err)
+		}
+	}
+
+	authInterceptor, err := initializeAuth(cfg, assetDataService)
+	if err != nil {
+		log.Fatalf("Failed to initialize authentication: %v", err)
+	}
+
+	rateLimitInterceptor, stopRateLimitEviction := initializeRateLimit(cfg)
+	defer stopRateLimitEviction()
+
+	sandboxService, stopSandboxes := initializeSandboxes(cfg, engines)
+	defer stopSandboxes()
+
 	httpSrv := startMetricsServer(cfg)
-	grpcSrv := startGRPCServer(cfg, getMarketDataUsecase, priceOscillationService)
+	grpcSrv := startGRPCServer(cfg, getMarketDataUsecase, getBatchMarketDataUsecase, priceOscillationService, sandboxService,
+		buildInterceptorOptions(authInterceptor, rateLimitInterceptor)...)
 
 	startUptimeTracker(metricsCollector)
 
@@ -118,21 +215,425 @@ func initializeRedis(cfg *config.Config) *redis.Client {
 	return client
 }
 
+func initializeSessions(
+	cfg *config.Config,
+	db database.Database,
+	assetDataService *domainService.AssetDataService,
+	schedule model.SessionSchedule,
+	clock simulation.Clock,
+) *service.SessionRolloverService {
+	sessionRolloverService := service.NewSessionRolloverService(
+		assetDataService,
+		persistence.NewSessionCloseRepository(db),
+		persistence.NewCorporateActionRepository(db),
+		schedule,
+	)
+
+	// Without the persisted closes the service still runs, measuring the first session
+	// against the initial prices
+	if err := sessionRolloverService.Restore(clock.Now()); err != nil {
+		log.Printf("Failed to restore session closes: %v", err)
+	}
+
+	log.Printf("Trading day rolls over at %s %s", cfg.MarketData.SessionCloseTime, cfg.MarketData.SessionTimezone)
+	return sessionRolloverService
+}
+
+func initializeQuoteStates(
+	cfg *config.Config,
+	db database.Database,
+	assetDataService *domainService.AssetDataService,
+) (func(), error) {
+	var stateRepository repository.IQuoteStateRepository
+	switch cfg.MarketData.StateStore {
+	case "postgres":
+		stateRepository = persistence.NewQuoteStateRepository(db)
+	case "file":
+		stateRepository = persistence.NewQuoteStateFileRepository(cfg.MarketData.StateFile)
+	case "none", "":
+		log.Println("Simulator state persistence is disabled")
+		return func() {}, nil
+	default:
+		return nil, fmt.Errorf("unknown state store %q, expected postgres, file or none", cfg.MarketData.StateStore)
+	}
+
+	quoteStateService := service.NewQuoteStateService(assetDataService, stateRepository)
+
+	// Like the session closes, a missing or unreadable state only means starting from the
+	// initial prices
+	if _, err := quoteStateService.Restore(); err != nil {
+		log.Printf("Failed to restore the simulator state: %v", err)
+	}
+
+	interval := cfg.MarketData.StateSaveInterval
+	if interval <= 0 {
+		interval = service.DefaultStateSaveInterval
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := quoteStateService.Save(); err != nil {
+					log.Printf("Failed to save the simulator state: %v", err)
+				}
+			}
+		}
+	}()
+
+	log.Printf("Simulator state saved to %s every %s", cfg.MarketData.StateStore, interval)
+	return func() {
+		close(stop)
+		<-done
+		if err := quoteStateService.Save(); err != nil {
+			log.Printf("Failed to save the simulator state: %v", err)
+			return
+		}
+		log.Println("Simulator state saved")
+	}, nil
+}
+
+func initializeMarketHours(cfg *config.Config) (*domainService.MarketHoursService, error) {
+	if cfg.MarketData.CalendarsFile == "" {
+		log.Println("No exchange calendar file configured, using US equity hours without holidays, 24/7 crypto and 24/5 FX")
+		return domainService.NewDefaultMarketHoursService(), nil
+	}
+
+	calendars, err := calendar.LoadCalendarFile(cfg.MarketData.CalendarsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Loaded %d exchange calendars from %s (default exchange %s)",
+		len(calendars.Exchanges), cfg.MarketData.CalendarsFile, calendars.DefaultExchange)
+
+	return domainService.NewMarketHoursService(calendars.Exchanges, calendars.DefaultExchange, calendars.AssetClassExchanges)
+}
+
+func initializeSpreads(cfg *config.Config) (*domainService.SpreadService, error) {
+	if cfg.MarketData.SpreadsFile == "" {
+		log.Println("No spread model file configured, using the asset class defaults")
+		return domainService.NewDefaultSpreadService(), nil
+	}
+
+	spreads, err := spread.LoadSpreadFile(cfg.MarketData.SpreadsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Loaded %d asset class and %d symbol spread models from %s",
+		len(spreads.AssetClasses), len(spreads.Symbols), cfg.MarketData.SpreadsFile)
+
+	return domainService.NewSpreadService(spreads.AssetClasses, spreads.Symbols), nil
+}
+
+func initializeRandom(cfg *config.Config) *simulation.Random {
+	if cfg.MarketData.Seed == 0 {
+		return simulation.NewTimeSeededRandom()
+	}
+
+	log.Printf("Simulating prices with seed %d", cfg.MarketData.Seed)
+	return simulation.NewRandom(cfg.MarketData.Seed)
+}
+
+// engineConfig holds the configuration shared by the engine of the shared simulation and the
+// engines of the sandboxes
+type engineConfig struct {
+	marketHours  *domainService.MarketHoursService
+	spreads      *domainService.SpreadService
+	factorModel  model.FactorModel
+	volatility   model.VolatilitySurface
+	indices      []model.IndexSpec
+	scenarios    map[string]model.Scenario
+	limitBand    decimal.Decimal
+	haltCooldown time.Duration
+	sessions     model.SessionSchedule
+	clock        simulation.Clock
+}
+
+// newEngine creates an engine over assetDataService with its own halts, factor levels and
+// scenario, drawing from random, rolling the trading day over with sessions
+func (c engineConfig) newEngine(
+	assetDataService *domainService.AssetDataService,
+	sessions *service.SessionRolloverService,
+	random *simulation.Random,
+) (*service.PriceOscillationService, error) {
+	factors, err := service.NewFactorSimulationService(c.factorModel, random.Fork())
+	if err != nil {
+		return nil, err
+	}
+
+	return service.NewPriceOscillationServiceWithOptions(assetDataService, service.PriceOscillationOptions{
+		Sessions:          sessions,
+		MarketHours:       c.marketHours,
+		Halts:             service.NewTradingHaltService(c.limitBand, c.haltCooldown),
+		Spreads:           c.spreads,
+		Factors:           factors,
+		Scenarios:         service.NewScenarioService(c.scenarios),
+		Clock:             c.clock,
+		Random:            random,
+		VolatilitySurface: &c.volatility,
+	}), nil
+}
+
+func initializeIndices(cfg *config.Config) ([]model.IndexSpec, error) {
+	if cfg.MarketData.IndicesFile == "" {
+		log.Println("No custom index file configured, computing the built-in indices and ETFs only")
+		return nil, nil
+	}
+
+	indices, err := index.LoadIndexFile(cfg.MarketData.IndicesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Loaded %d custom indices from %s", len(indices), cfg.MarketData.IndicesFile)
+	return indices, nil
+}
+
+// newAssetDataService creates the simulated assets quoted at time now, with the custom indices
+// and ETF holdings registered on top of the built-in ones
+func newAssetDataService(indices []model.IndexSpec, now time.Time) (*domainService.AssetDataService, error) {
+	assetDataService := domainService.NewAssetDataService(now)
+	for _, spec := range indices {
+		if err := assetDataService.RegisterIndex(spec); err != nil {
+			return nil, err
+		}
+	}
+	return assetDataService, nil
+}
+
+func initializeVolatilitySurface(cfg *config.Config) (model.VolatilitySurface, error) {
+	if cfg.MarketData.VolatilityFile == "" {
+		log.Println("No volatility surface file configured, pricing options with the default surface")
+		return model.DefaultVolatilitySurface(), nil
+	}
+
+	surface, err := volatility.LoadVolatilityFile(cfg.MarketData.VolatilityFile)
+	if err != nil {
+		return model.VolatilitySurface{}, err
+	}
+
+	log.Printf("Loaded the volatility surface of %d underlyings from %s", len(surface.Underlyings), cfg.MarketData.VolatilityFile)
+	return surface, nil
+}
+
+func initializeFactors(cfg *config.Config) (model.FactorModel, error) {
+	if cfg.MarketData.FactorsFile == "" {
+		log.Println("No factor model file configured, moving every asset class with the market factor")
+		return model.DefaultFactorModel(), nil
+	}
+
+	factorModel, err := factor.LoadFactorFile(cfg.MarketData.FactorsFile)
+	if err != nil {
+		return model.FactorModel{}, err
+	}
+
+	log.Printf("Loaded %d factors and %d symbol exposures from %s",
+		len(factorModel.Factors), len(factorModel.Symbols), cfg.MarketData.FactorsFile)
+
+	return factorModel, nil
+}
+
+func initializeScenarios(cfg *config.Config) (map[string]model.Scenario, error) {
+	if cfg.MarketData.ScenariosDir == "" {
+		log.Println("No scenario directory configured, scenarios can only be started from a definition")
+		return nil, nil
+	}
+
+	scenarios, err := scenario.LoadScenarioDir(cfg.MarketData.ScenariosDir)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Loaded %d scenarios from %s", len(scenarios), cfg.MarketData.ScenariosDir)
+	return scenarios, nil
+}
+
+func initializeSandboxes(cfg *config.Config, engines engineConfig) (*service.SandboxService, func()) {
+	if !cfg.Admin.Enabled || cfg.Admin.MaxSandboxes <= 0 {
+		log.Println("Sandboxes are disabled")
+		return nil, func() {}
+	}
+
+	sandboxService := service.NewSandboxService(
+		func(random *simulation.Random) (*service.PriceOscillationService, error) {
+			assetDataService, err := newAssetDataService(engines.indices, engines.clock.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			// Sandboxes close their sessions in memory only, without corporate actions
+			sessions := service.NewSessionRolloverService(
+				assetDataService,
+				persistence.NewSessionCloseMemoryRepository(),
+				nil,
+				engines.sessions,
+			)
+			if err := sessions.Restore(engines.clock.Now()); err != nil {
+				return nil, err
+			}
+			return engines.newEngine(assetDataService, sessions, random)
+		},
+		cfg.Admin.SandboxIdleTimeout,
+		cfg.Admin.MaxSandboxes,
+		engines.clock,
+	)
+
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				sandboxService.ExpireIdle(now)
+			}
+		}
+	}()
+
+	log.Printf("Sandboxes enabled: up to %d, expiring after %s idle", cfg.Admin.MaxSandboxes, cfg.Admin.SandboxIdleTimeout)
+	return sandboxService, func() {
+		close(stop)
+		sandboxService.DestroyAll()
+	}
+}
+
+func initializeAuth(cfg *config.Config, assetDataService *domainService.AssetDataService) (*grpcServer.AuthInterceptor, error) {
+	if !cfg.Auth.Enabled {
+		log.Println("Authentication is disabled, all callers are allowed")
+		return nil, nil
+	}
+
+	var jwtValidator *auth.JWTValidator
+	if cfg.Auth.JWTSecret != "" || cfg.Auth.JWKSFile != "" {
+		var jwksKeys map[string]interface{}
+		if cfg.Auth.JWKSFile != "" {
+			keys, err := auth.LoadJWKSFile(cfg.Auth.JWKSFile)
+			if err != nil {
+				return nil, err
+			}
+			jwksKeys = keys
+		}
+
+		validator, err := auth.NewJWTValidator(cfg.Auth.JWTSecret, jwksKeys, cfg.Auth.JWTIssuer, cfg.Auth.JWTAudience)
+		if err != nil {
+			return nil, err
+		}
+		jwtValidator = validator
+	}
+
+	var apiKeyStore *auth.APIKeyStore
+	if cfg.Auth.APIKeysFile != "" {
+		store, err := auth.LoadAPIKeyFile(cfg.Auth.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		apiKeyStore = store
+	}
+
+	if jwtValidator == nil && apiKeyStore == nil {
+		return nil, fmt.Errorf("authentication is enabled but no JWT secret, JWKS file or API key file is configured")
+	}
+
+	log.Println("Authentication enabled for gRPC server")
+
+	return grpcServer.NewAuthInterceptor(auth.NewAuthenticator(jwtValidator, apiKeyStore), assetDataService), nil
+}
+
+func initializeRateLimit(cfg *config.Config) (*grpcServer.RateLimitInterceptor, func()) {
+	if !cfg.RateLimit.Enabled {
+		log.Println("Rate limiting is disabled")
+		return nil, func() {}
+	}
+
+	limiter := ratelimit.NewClientRateLimiter(cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst, 10*time.Minute)
+	streamQuota := ratelimit.NewStreamQuota(cfg.RateLimit.MaxStreamsPerClient)
+
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				limiter.EvictIdle()
+			}
+		}
+	}()
+
+	log.Printf("Rate limiting enabled: %.1f req/s (burst %d), %d streams per client, %d symbols per stream",
+		cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst,
+		cfg.RateLimit.MaxStreamsPerClient, cfg.RateLimit.MaxSymbolsPerStream)
+
+	interceptor := grpcServer.NewRateLimitInterceptor(limiter, streamQuota, cfg.RateLimit.MaxSymbolsPerStream)
+	return interceptor, func() { close(stop) }
+}
+
+// buildInterceptorOptions chains authentication before rate limiting so that
+// limits are applied per authenticated client rather than per connection
+func buildInterceptorOptions(
+	authInterceptor *grpcServer.AuthInterceptor,
+	rateLimitInterceptor *grpcServer.RateLimitInterceptor,
+) []grpc.ServerOption {
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+
+	if authInterceptor != nil {
+		unary = append(unary, authInterceptor.Unary())
+		stream = append(stream, authInterceptor.Stream())
+	}
+	if rateLimitInterceptor != nil {
+		unary = append(unary, rateLimitInterceptor.Unary())
+		stream = append(stream, rateLimitInterceptor.Stream())
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
+
 func startGRPCServer(
 	cfg *config.Config,
 	getMarketDataUsecase usecase.IGetMarketDataUsecase,
+	getBatchMarketDataUsecase usecase.IGetBatchMarketDataUsecase,
 	priceOscillationService *service.PriceOscillationService,
+	sandboxService *service.SandboxService,
+	serverOptions ...grpc.ServerOption,
 ) *grpc.Server {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GRPC.Port))
 	if err != nil {
 		log.Fatalf("Failed to listen on port %s: %v", cfg.GRPC.Port, err)
 	}
 
-	grpcSrv := grpc.NewServer()
+	grpcSrv := grpc.NewServer(serverOptions...)
 
-	marketDataServer := grpcServer.NewMarketDataGRPCServer(getMarketDataUsecase, priceOscillationService)
+	marketDataServer := grpcServer.NewMarketDataGRPCServer(getMarketDataUsecase, getBatchMarketDataUsecase, priceOscillationService, sandboxService)
 	pb.RegisterMarketDataServiceServer(grpcSrv, marketDataServer)
 
+	if cfg.Admin.Enabled {
+		if !cfg.Auth.Enabled {
+			log.Println("WARNING: the admin API is enabled without authentication, every caller can control the simulator")
+		}
+		pb.RegisterMarketDataAdminServiceServer(grpcSrv, grpcServer.NewMarketDataAdminGRPCServer(priceOscillationService, sandboxService))
+		log.Println("Admin API enabled")
+	}
+
 	reflection.Register(grpcSrv)
 
 	go func() {
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+)
+
+type CorporateActionDTO struct {
+	ID             int64           `db:"id"`
+	Symbol         string          `db:"symbol"`
+	ActionType     string          `db:"action_type"`
+	ExDate         time.Time       `db:"ex_date"`
+	SplitFrom      int64           `db:"split_from"`
+	SplitTo        int64           `db:"split_to"`
+	DividendAmount decimal.Decimal `db:"dividend_amount"`
+	NewSymbol      string          `db:"new_symbol"`
+}
+
+// ToCorporateActionDomain converts a CorporateActionDTO to domain.CorporateAction
+func ToCorporateActionDomain(dto CorporateActionDTO) model.CorporateAction {
+	return model.CorporateAction{
+		ID:             dto.ID,
+		Symbol:         dto.Symbol,
+		Type:           model.CorporateActionType(dto.ActionType),
+		ExDate:         model.SessionDateOf(dto.ExDate),
+		SplitFrom:      dto.SplitFrom,
+		SplitTo:        dto.SplitTo,
+		DividendAmount: dto.DividendAmount,
+		NewSymbol:      dto.NewSymbol,
+	}
+}
@@ -1,9 +1,15 @@
 package dto
 
+import "github.com/shopspring/decimal"
+
 type MarketDataDTO struct {
-	Id        int     `db:"id"`
-	Symbol    string  `db:"symbol"`
-	Name      string  `db:"name"`
-	LastQuote float32 `db:"last_quote"`
-	Category  int     `db:"category"` //TODO: criar enum pra esse cara
+	Id             int             `db:"id"`
+	Symbol         string          `db:"symbol"`
+	Name           string          `db:"name"`
+	LastQuote      decimal.Decimal `db:"last_quote"`
+	PricePrecision int32           `db:"price_precision"`
+	AssetClass     string          `db:"asset_class"`
+	TickSize       decimal.Decimal `db:"tick_size"`
+	LotSize        int64           `db:"lot_size"`
+	Currency       string          `db:"currency"`
 }
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
 )
 
 // MarketDataMapper handles conversion between MarketDataDTO and domain.MarketDataModel
@@ -14,21 +15,57 @@ func NewMarketDataMapper() *MarketDataMapper {
 
 // ToDomain converts MarketDataDTO to domain.MarketDataModel
 func (m *MarketDataMapper) ToDomain(dto MarketDataDTO) model.MarketDataModel {
+	assetClass := model.AssetClass(dto.AssetClass)
+	instrument := toInstrument(dto, assetClass)
+
 	return model.MarketDataModel{
-		Symbol:    dto.Symbol,
-		Category:  dto.Category,
-		LastQuote: dto.LastQuote,
-		Name:      dto.Name,
+		Symbol:     dto.Symbol,
+		AssetClass: assetClass,
+		LastQuote:  instrument.RoundPrice(dto.LastQuote),
+		Name:       dto.Name,
+		Instrument: instrument,
+	}
+}
+
+// toInstrument reads the instrument metadata of the row. Unset or invalid values fall back
+// to the defaults of the asset class, field by field.
+func toInstrument(dto MarketDataDTO, assetClass model.AssetClass) model.Instrument {
+	instrument := assetClass.DefaultInstrument()
+
+	if dto.PricePrecision > 0 && dto.PricePrecision <= model.MaxPricePrecision {
+		instrument.PricePrecision = dto.PricePrecision
+		instrument.TickSize = decimal.New(1, -dto.PricePrecision)
 	}
+	if withTick := instrument; dto.TickSize.IsPositive() {
+		withTick.TickSize = dto.TickSize
+		if withTick.Validate() == nil {
+			instrument = withTick
+		}
+	}
+	if dto.LotSize > 0 {
+		instrument.LotSize = dto.LotSize
+	}
+	if withCurrency := instrument; dto.Currency != "" {
+		withCurrency.Currency = dto.Currency
+		if withCurrency.Validate() == nil {
+			instrument = withCurrency
+		}
+	}
+
+	return instrument
 }
 
 // ToDTO converts domain.MarketDataModel to MarketDataDTO
 func (m *MarketDataMapper) ToDTO(model model.MarketDataModel) MarketDataDTO {
 	return MarketDataDTO{
-		Symbol:    model.Symbol,
-		Category:  model.Category,
-		Name:      model.Name,
-		LastQuote: model.LastQuote,
+		Symbol:         model.Symbol,
+		AssetClass:     model.AssetClass.String(),
+		Name:           model.Name,
+		LastQuote:      model.LastQuote,
+		PricePrecision: model.PricePrecision,
+		TickSize:       model.TickSize,
+		LotSize:        model.LotSize,
+		Currency:       model.Currency,
 	}
 }
 
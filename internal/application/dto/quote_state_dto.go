@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+)
+
+type QuoteStateDTO struct {
+	Symbol        string          `db:"symbol" json:"symbol"`
+	SessionDate   time.Time       `db:"session_date" json:"session_date"`
+	CurrentPrice  decimal.Decimal `db:"current_price" json:"current_price"`
+	BasePrice     decimal.Decimal `db:"base_price" json:"base_price"`
+	OpenPrice     decimal.Decimal `db:"open_price" json:"open_price"`
+	HighPrice     decimal.Decimal `db:"high_price" json:"high_price"`
+	LowPrice      decimal.Decimal `db:"low_price" json:"low_price"`
+	PreviousClose decimal.Decimal `db:"previous_close" json:"previous_close"`
+	Volume        int64           `db:"volume" json:"volume"`
+	Turnover      decimal.Decimal `db:"turnover" json:"turnover"`
+	VWAP          decimal.Decimal `db:"vwap" json:"vwap"`
+	UpdatedAt     time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+// ToQuoteStateDomain converts a QuoteStateDTO to domain.QuoteState
+func ToQuoteStateDomain(dto QuoteStateDTO) model.QuoteState {
+	return model.QuoteState{
+		Symbol:        dto.Symbol,
+		SessionDate:   model.SessionDateOf(dto.SessionDate),
+		CurrentPrice:  dto.CurrentPrice,
+		BasePrice:     dto.BasePrice,
+		OpenPrice:     dto.OpenPrice,
+		HighPrice:     dto.HighPrice,
+		LowPrice:      dto.LowPrice,
+		PreviousClose: dto.PreviousClose,
+		Volume:        dto.Volume,
+		Turnover:      dto.Turnover,
+		VWAP:          dto.VWAP,
+		UpdatedAt:     dto.UpdatedAt,
+	}
+}
+
+// ToQuoteStateDTO converts a domain.QuoteState to a QuoteStateDTO
+func ToQuoteStateDTO(state model.QuoteState) QuoteStateDTO {
+	return QuoteStateDTO{
+		Symbol:        state.Symbol,
+		SessionDate:   state.SessionDate,
+		CurrentPrice:  state.CurrentPrice,
+		BasePrice:     state.BasePrice,
+		OpenPrice:     state.OpenPrice,
+		HighPrice:     state.HighPrice,
+		LowPrice:      state.LowPrice,
+		PreviousClose: state.PreviousClose,
+		Volume:        state.Volume,
+		Turnover:      state.Turnover,
+		VWAP:          state.VWAP,
+		UpdatedAt:     state.UpdatedAt,
+	}
+}
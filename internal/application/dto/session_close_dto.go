@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+)
+
+type SessionCloseDTO struct {
+	Symbol      string          `db:"symbol"`
+	SessionDate time.Time       `db:"session_date"`
+	ClosePrice  decimal.Decimal `db:"close_price"`
+	Bid         decimal.Decimal `db:"close_bid"`
+	Ask         decimal.Decimal `db:"close_ask"`
+	BidSize     int64           `db:"close_bid_size"`
+	AskSize     int64           `db:"close_ask_size"`
+}
+
+// ToSessionCloseDomain converts a SessionCloseDTO to domain.SessionClose
+func ToSessionCloseDomain(dto SessionCloseDTO) model.SessionClose {
+	return model.SessionClose{
+		Symbol:      dto.Symbol,
+		SessionDate: model.SessionDateOf(dto.SessionDate),
+		ClosePrice:  dto.ClosePrice,
+		Bid:         dto.Bid,
+		Ask:         dto.Ask,
+		BidSize:     dto.BidSize,
+		AskSize:     dto.AskSize,
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"math"
+	"sync"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+)
+
+// FactorSimulationService drives correlated prices. Every tick moves the factor levels with
+// correlated random shocks, and an instrument is priced at its base price moved by its
+// betas to the factor levels plus an idiosyncratic draw, so instruments exposed to the same
+// factors move together.
+type FactorSimulationService struct {
+	factorModel model.FactorModel
+	cholesky    [][]float64
+	// shockScale keeps the standard deviation of each level at the volatility of its factor
+	shockScale  float64
+	factorIndex map[string]int
+	random      *simulation.Random
+
+	mu     sync.RWMutex
+	levels []float64
+}
+
+// NewFactorSimulationService creates the simulation with shocks drawn from random, or from a
+// time seeded source when random is nil
+func NewFactorSimulationService(factorModel model.FactorModel, random *simulation.Random) (*FactorSimulationService, error) {
+	if err := factorModel.Validate(); err != nil {
+		return nil, err
+	}
+
+	cholesky, err := factorModel.Cholesky()
+	if err != nil {
+		return nil, err
+	}
+
+	if random == nil {
+		random = simulation.NewTimeSeededRandom()
+	}
+
+	factorIndex := make(map[string]int, len(factorModel.Factors))
+	for i, factor := range factorModel.Factors {
+		factorIndex[factor.Name] = i
+	}
+
+	return &FactorSimulationService{
+		factorModel: factorModel,
+		cholesky:    cholesky,
+		shockScale:  math.Sqrt(1 - factorModel.Persistence*factorModel.Persistence),
+		factorIndex: factorIndex,
+		random:      random,
+		levels:      make([]float64, len(factorModel.Factors)),
+	}, nil
+}
+
+// NewDefaultFactorSimulationService simulates prices with model.DefaultFactorModel
+func NewDefaultFactorSimulationService(random *simulation.Random) *FactorSimulationService {
+	service, err := NewFactorSimulationService(model.DefaultFactorModel(), random)
+	if err != nil {
+		panic(err)
+	}
+	return service
+}
+
+// Advance moves every factor level by one tick
+func (s *FactorSimulationService) Advance() {
+	draws := make([]float64, len(s.levels))
+	for i := range draws {
+		draws[i] = s.random.NormFloat64()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, factor := range s.factorModel.Factors {
+		var shock float64
+		for j := 0; j <= i; j++ {
+			shock += s.cholesky[i][j] * draws[j]
+		}
+		s.levels[i] = s.factorModel.Persistence*s.levels[i] + s.shockScale*factor.Volatility*shock
+	}
+}
+
+// Exposure returns the factor exposure the quote is simulated with
+func (s *FactorSimulationService) Exposure(quote model.AssetQuote) model.FactorExposure {
+	return s.factorModel.ExposureOf(quote)
+}
+
+// HasFactor reports whether the model has a factor with the given name
+func (s *FactorSimulationService) HasFactor(name string) bool {
+	_, exists := s.factorIndex[name]
+	return exists
+}
+
+// Deviation returns the fraction of its base price the quote is simulated away from it at
+// the current factor levels, e.g. 0.01 for 1% above
+func (s *FactorSimulationService) Deviation(quote model.AssetQuote) float64 {
+	exposure := s.factorModel.ExposureOf(quote)
+	deviation := exposure.IdiosyncraticVolatility * s.random.NormFloat64()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Summed in factor order so a seeded run always rounds the same way
+	for i, factor := range s.factorModel.Factors {
+		deviation += exposure.Betas[factor.Name] * s.levels[i]
+	}
+	return deviation
+}
+
+// Levels returns the current level of every factor by name
+func (s *FactorSimulationService) Levels() map[string]float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	levels := make(map[string]float64, len(s.levels))
+	for name, i := range s.factorIndex {
+		levels[name] = s.levels[i]
+	}
+	return levels
+}
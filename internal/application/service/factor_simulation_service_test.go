@@ -0,0 +1,108 @@
+package service
+
+import (
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func singleFactorModel(persistence float64) model.FactorModel {
+	return model.FactorModel{
+		Factors:     []model.Factor{{Name: model.MarketFactor, Volatility: 0.01}},
+		Correlation: [][]float64{{1}},
+		Persistence: persistence,
+		AssetClasses: map[model.AssetClass]model.FactorExposure{
+			model.AssetClassStock: {Betas: map[string]float64{model.MarketFactor: 1}},
+		},
+		Symbols: map[string]model.FactorExposure{
+			"TSLA": {Betas: map[string]float64{model.MarketFactor: 2}},
+		},
+	}
+}
+
+func TestFactorSimulationService_Deviation(t *testing.T) {
+	// Arrange
+	factors, err := NewFactorSimulationService(singleFactorModel(0), simulation.NewRandom(1))
+	require.NoError(t, err)
+	apple := model.NewAssetQuote("AAPL", "Apple Inc.", model.AssetClassStock, decimal.NewFromInt(100), 0, 0, time.Now())
+	tesla := model.NewAssetQuote("TSLA", "Tesla Inc.", model.AssetClassStock, decimal.NewFromInt(100), 0, 0, time.Now())
+
+	// Act
+	initial := factors.Deviation(apple)
+	factors.Advance()
+	appleDeviation := factors.Deviation(apple)
+	teslaDeviation := factors.Deviation(tesla)
+
+	// Assert: without idiosyncratic moves both follow the market, TSLA twice as much
+	assert.Zero(t, initial)
+	assert.NotZero(t, appleDeviation)
+	assert.Equal(t, factors.Levels()[model.MarketFactor], appleDeviation)
+	assert.InDelta(t, 2*appleDeviation, teslaDeviation, 1e-15)
+}
+
+func TestFactorSimulationService_CorrelatesFactors(t *testing.T) {
+	// Arrange
+	factors, err := NewFactorSimulationService(model.FactorModel{
+		Factors:     []model.Factor{{Name: "A", Volatility: 0.01}, {Name: "B", Volatility: 0.02}},
+		Correlation: [][]float64{{1, 0.8}, {0.8, 1}},
+	}, simulation.NewRandom(1))
+	require.NoError(t, err)
+
+	// Act: with no persistence every level is a fresh correlated draw
+	const draws = 20000
+	var sumA, sumB, sumAA, sumBB, sumAB float64
+	for i := 0; i < draws; i++ {
+		factors.Advance()
+		levels := factors.Levels()
+		a, b := levels["A"], levels["B"]
+		sumA, sumB = sumA+a, sumB+b
+		sumAA, sumBB, sumAB = sumAA+a*a, sumBB+b*b, sumAB+a*b
+	}
+
+	// Assert
+	covariance := sumAB/draws - sumA/draws*sumB/draws
+	stdA := math.Sqrt(sumAA/draws - math.Pow(sumA/draws, 2))
+	stdB := math.Sqrt(sumBB/draws - math.Pow(sumB/draws, 2))
+	assert.InDelta(t, 0.8, covariance/(stdA*stdB), 0.03)
+	assert.InDelta(t, 0.01, stdA, 0.0005)
+	assert.InDelta(t, 0.02, stdB, 0.001)
+}
+
+func TestFactorSimulationService_PersistentLevelsKeepVolatility(t *testing.T) {
+	// Arrange
+	factors, err := NewFactorSimulationService(singleFactorModel(0.9), simulation.NewRandom(1))
+	require.NoError(t, err)
+
+	// Act
+	const draws = 20000
+	var sumSquares, sumProducts, previous float64
+	for i := 0; i < draws; i++ {
+		factors.Advance()
+		level := factors.Levels()[model.MarketFactor]
+		sumSquares += level * level
+		sumProducts += level * previous
+		previous = level
+	}
+
+	// Assert: the level keeps its volatility and is autocorrelated by the persistence
+	assert.InDelta(t, 0.01, math.Sqrt(sumSquares/draws), 0.001)
+	assert.InDelta(t, 0.9, sumProducts/sumSquares, 0.03)
+}
+
+func TestNewFactorSimulationService_InvalidModel(t *testing.T) {
+	// Arrange
+	factorModel := singleFactorModel(0)
+	factorModel.Correlation = [][]float64{{1, 0}}
+
+	// Act
+	_, err := NewFactorSimulationService(factorModel, simulation.NewRandom(1))
+
+	// Assert
+	assert.ErrorIs(t, err, model.ErrInvalidFactorModel)
+}
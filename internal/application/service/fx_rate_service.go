@@ -0,0 +1,63 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+)
+
+// fxRatePrecision is the number of decimal places kept for inverted and cross rates
+const fxRatePrecision = 10
+
+var ErrNoFXRate = errors.New("no fx rate")
+
+// QuoteSource returns the current simulated quote of a symbol
+type QuoteSource interface {
+	Quote(symbol model.Symbol) (model.AssetQuote, bool)
+}
+
+// FXRateService prices one currency in another from the simulated FX pairs of an engine, so
+// converted prices move with the simulated rates. A pair is quoted as BASEQUOTE, the price of
+// one unit of the base currency in the quote currency. Currencies without a pair between them
+// are crossed through the US dollar.
+type FXRateService struct {
+	quotes QuoteSource
+}
+
+func NewFXRateService(quotes QuoteSource) *FXRateService {
+	return &FXRateService{quotes: quotes}
+}
+
+// Rate returns the price of one unit of from in to
+func (s *FXRateService) Rate(from, to string) (decimal.Decimal, error) {
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	if rate, exists := s.pairRate(from, to); exists {
+		return rate, nil
+	}
+
+	if from != model.DefaultCurrency && to != model.DefaultCurrency {
+		fromRate, fromExists := s.pairRate(from, model.DefaultCurrency)
+		toRate, toExists := s.pairRate(model.DefaultCurrency, to)
+		if fromExists && toExists {
+			return fromRate.Mul(toRate).Round(fxRatePrecision), nil
+		}
+	}
+
+	return decimal.Zero, fmt.Errorf("%w from %s to %s", ErrNoFXRate, from, to)
+}
+
+// pairRate prices from in to with the pair of the two currencies, in either direction
+func (s *FXRateService) pairRate(from, to string) (decimal.Decimal, bool) {
+	if quote, exists := s.quotes.Quote(model.Symbol(from + to)); exists && quote.CurrentPrice.IsPositive() {
+		return quote.CurrentPrice, true
+	}
+	if quote, exists := s.quotes.Quote(model.Symbol(to + from)); exists && quote.CurrentPrice.IsPositive() {
+		return decimal.NewFromInt(1).DivRound(quote.CurrentPrice, fxRatePrecision), true
+	}
+	return decimal.Zero, false
+}
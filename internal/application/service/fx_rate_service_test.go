@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// quoteMap is a QuoteSource of fixed FX pairs
+type quoteMap map[model.Symbol]string
+
+func (m quoteMap) Quote(symbol model.Symbol) (model.AssetQuote, bool) {
+	price, exists := m[symbol]
+	if !exists {
+		return model.AssetQuote{}, false
+	}
+	return model.AssetQuote{Symbol: symbol.String(), CurrentPrice: decimal.RequireFromString(price)}, true
+}
+
+func TestFXRateService_Rate(t *testing.T) {
+	// Arrange
+	rates := NewFXRateService(quoteMap{"EURUSD": "1.08", "USDBRL": "5.00", "USDJPY": "150.00"})
+
+	tests := []struct {
+		from, to string
+		expected string
+	}{
+		{"USD", "USD", "1"},
+		{"EUR", "USD", "1.08"},
+		{"USD", "EUR", "0.9259259259"},
+		{"EUR", "BRL", "5.4"},
+		{"BRL", "JPY", "30"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.from+test.to, func(t *testing.T) {
+			// Act
+			rate, err := rates.Rate(test.from, test.to)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, decimal.RequireFromString(test.expected).Equal(rate), rate.String())
+		})
+	}
+}
+
+func TestFXRateService_Rate_Unknown(t *testing.T) {
+	// Arrange
+	rates := NewFXRateService(quoteMap{"EURUSD": "1.08"})
+
+	// Act
+	_, err := rates.Rate("EUR", "CHF")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoFXRate)
+}
+
+func TestPriceOscillationService_FXRates(t *testing.T) {
+	// Arrange
+	priceOscillationService := NewPriceOscillationService(service.NewAssetDataService(time.Now()))
+	usdBRL, _ := priceOscillationService.Quote("USDBRL")
+
+	// Act
+	rate, err := priceOscillationService.FXRates().Rate("USD", "BRL")
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, usdBRL.CurrentPrice.Equal(rate))
+}
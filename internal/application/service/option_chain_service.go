@@ -0,0 +1,147 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultOptionExpiries is the number of monthly expiries listed for every underlying
+	DefaultOptionExpiries = 6
+
+	// DefaultStrikesPerSide is the number of strikes quoted on either side of the money when
+	// a request does not set one
+	DefaultStrikesPerSide = 5
+
+	// MaxStrikesPerSide caps the strikes quoted on either side of the money
+	MaxStrikesPerSide = 25
+
+	// optionQuoteLots is the number of lots shown on either side of an option quote
+	optionQuoteLots = 10
+)
+
+var (
+	ErrNoOptions             = errors.New("no options listed")
+	ErrUnknownExpiry         = errors.New("unknown expiry")
+	ErrInvalidStrikesPerSide = errors.New("invalid strikes per side")
+)
+
+// OptionChainService quotes the listed options of the underlyings simulated by an engine.
+// Contracts are priced with Black-Scholes at the live underlying price and the volatility of
+// their strike and expiry on the surface, so their quotes move with the underlying.
+type OptionChainService struct {
+	quotes  QuoteSource
+	spreads *service.SpreadService
+	surface model.VolatilitySurface
+	clock   simulation.Clock
+}
+
+func NewOptionChainService(
+	quotes QuoteSource,
+	spreads *service.SpreadService,
+	surface model.VolatilitySurface,
+	clock simulation.Clock,
+) *OptionChainService {
+	return &OptionChainService{
+		quotes:  quotes,
+		spreads: spreads,
+		surface: surface,
+		clock:   clock,
+	}
+}
+
+// Chain quotes the calls and puts of underlying expiring on expiry, the nearest expiry when
+// it is zero, at the strike closest to the underlying price and strikesPerSide strikes on
+// either side of it
+func (s *OptionChainService) Chain(underlying model.Symbol, expiry time.Time, strikesPerSide int) (model.OptionChain, error) {
+	if strikesPerSide < 0 || strikesPerSide > MaxStrikesPerSide {
+		return model.OptionChain{}, fmt.Errorf("%w: %d must be between 0 and %d", ErrInvalidStrikesPerSide, strikesPerSide, MaxStrikesPerSide)
+	}
+
+	quote, exists := s.quotes.Quote(underlying)
+	if !exists {
+		return model.OptionChain{}, fmt.Errorf("%w: %s", ErrUnknownSymbol, underlying)
+	}
+	if !quote.AssetClass.HasOptions() {
+		return model.OptionChain{}, fmt.Errorf("%w on %s %s", ErrNoOptions, quote.AssetClass, underlying)
+	}
+
+	now := s.clock.Now()
+	expiries := model.OptionExpiries(now, DefaultOptionExpiries)
+	if expiry.IsZero() {
+		expiry = expiries[0]
+	} else if listed, exists := listedExpiry(expiries, expiry); exists {
+		expiry = listed
+	} else {
+		return model.OptionChain{}, fmt.Errorf("%w: %s has no expiry on %s", ErrUnknownExpiry, underlying, expiry.Format(time.DateOnly))
+	}
+
+	chain := model.OptionChain{
+		Underlying:      underlying,
+		UnderlyingPrice: quote.CurrentPrice,
+		RiskFreeRate:    s.surface.RiskFreeRate,
+		Expiry:          expiry,
+		Expiries:        expiries,
+		Timestamp:       now,
+	}
+
+	for _, strike := range model.OptionStrikes(quote.CurrentPrice, strikesPerSide) {
+		call := model.OptionContract{Underlying: underlying, Type: model.OptionTypeCall, Strike: strike, Expiry: expiry}
+		put := model.OptionContract{Underlying: underlying, Type: model.OptionTypePut, Strike: strike, Expiry: expiry}
+		chain.Calls = append(chain.Calls, s.quote(call, quote.CurrentPrice, now))
+		chain.Puts = append(chain.Puts, s.quote(put, quote.CurrentPrice, now))
+	}
+
+	return chain, nil
+}
+
+// quote prices contract at the volatility of the surface, rounded to a tick and worth at
+// least one tick. The implied volatility is that of the rounded price, or the volatility of
+// the surface when the rounded price is outside the no-arbitrage bounds.
+func (s *OptionChainService) quote(contract model.OptionContract, underlyingPrice decimal.Decimal, now time.Time) model.OptionQuote {
+	spot := underlyingPrice.InexactFloat64()
+	volatility := s.surface.Volatility(contract.Underlying, spot, contract.Strike.InexactFloat64(), contract.YearsToExpiry(now))
+	valuation := contract.Value(spot, s.surface.RiskFreeRate, volatility, now)
+
+	instrument := model.AssetClassOption.DefaultInstrument()
+	price := decimal.Max(instrument.RoundPrice(decimal.NewFromFloat(valuation.Price)), instrument.Tick())
+
+	impliedVolatility, err := contract.ImpliedVolatility(price.InexactFloat64(), spot, s.surface.RiskFreeRate, now)
+	if err != nil {
+		impliedVolatility = volatility
+	}
+
+	spreadModel := s.spreads.ModelFor(model.AssetQuote{Symbol: contract.Symbol(), AssetClass: model.AssetClassOption})
+	bid, ask := spreadModel.BidAsk(price, instrument, decimal.Zero)
+	size := spreadModel.Size(optionQuoteLots, instrument)
+
+	return model.OptionQuote{
+		Contract:          contract,
+		Price:             price,
+		Bid:               bid,
+		Ask:               ask,
+		BidSize:           size,
+		AskSize:           size,
+		Delta:             valuation.Delta,
+		Gamma:             valuation.Gamma,
+		Theta:             valuation.Theta,
+		Vega:              valuation.Vega,
+		ImpliedVolatility: impliedVolatility,
+	}
+}
+
+// listedExpiry finds the listed expiry on the date of expiry
+func listedExpiry(expiries []time.Time, expiry time.Time) (time.Time, bool) {
+	for _, listed := range expiries {
+		if listed.Format(time.DateOnly) == expiry.Format(time.DateOnly) {
+			return listed, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOptionChainService(t *testing.T, underlyingPrice string) *OptionChainService {
+	t.Helper()
+
+	assetDataService := service.NewAssetDataService(time.Now())
+	assetDataService.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(decimal.RequireFromString(underlyingPrice), quote.LastUpdated)
+	})
+
+	clock := simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+	engine := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{Clock: clock})
+	return NewOptionChainService(engine, service.NewDefaultSpreadService(), model.DefaultVolatilitySurface(), clock)
+}
+
+func TestOptionChainService_Chain(t *testing.T) {
+	// Arrange
+	optionChains := newTestOptionChainService(t, "178.35")
+
+	// Act
+	chain, err := optionChains.Chain("AAPL", time.Time{}, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), chain.Expiry)
+	assert.Len(t, chain.Expiries, DefaultOptionExpiries)
+	assert.Equal(t, "178.35", chain.UnderlyingPrice.String())
+	require.Len(t, chain.Calls, 5)
+	require.Len(t, chain.Puts, 5)
+
+	for i := range chain.Calls {
+		call, put := chain.Calls[i], chain.Puts[i]
+		assert.True(t, call.Contract.Strike.Equal(put.Contract.Strike))
+		assert.True(t, call.Bid.LessThan(call.Ask), call.Contract.Symbol())
+		assert.True(t, call.Price.GreaterThanOrEqual(call.Bid) && call.Price.LessThanOrEqual(call.Ask))
+		assert.Greater(t, call.Delta, 0.0)
+		assert.Less(t, put.Delta, 0.0)
+		assert.Greater(t, call.Gamma, 0.0)
+		assert.Less(t, call.Theta, 0.0)
+		assert.Greater(t, put.ImpliedVolatility, 0.0)
+	}
+
+	// Calls lose value and puts gain it as the strike rises
+	assert.True(t, chain.Calls[0].Price.GreaterThan(chain.Calls[4].Price))
+	assert.True(t, chain.Puts[0].Price.LessThan(chain.Puts[4].Price))
+	assert.Equal(t, "AAPL260320C00172500", chain.Calls[0].Contract.Symbol())
+}
+
+func TestOptionChainService_Chain_ImpliedVolatilityFollowsSurface(t *testing.T) {
+	// Arrange
+	optionChains := newTestOptionChainService(t, "180")
+	expiry := time.Date(2026, 8, 21, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	chain, err := optionChains.Chain("AAPL", expiry, 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, chain.Calls, 1)
+	years := chain.Calls[0].Contract.YearsToExpiry(chain.Timestamp)
+	surfaceVolatility := model.DefaultVolatilitySurface().Volatility("AAPL", 180, 180, years)
+	assert.InDelta(t, surfaceVolatility, chain.Calls[0].ImpliedVolatility, 0.001)
+	assert.InDelta(t, surfaceVolatility, chain.Puts[0].ImpliedVolatility, 0.001)
+}
+
+func TestOptionChainService_Chain_Errors(t *testing.T) {
+	// Arrange
+	optionChains := newTestOptionChainService(t, "180")
+
+	// Act
+	_, unknownSymbolErr := optionChains.Chain("ZZZZ", time.Time{}, 2)
+	_, noOptionsErr := optionChains.Chain("BTC-USD", time.Time{}, 2)
+	_, unknownExpiryErr := optionChains.Chain("AAPL", time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC), 2)
+	_, strikesErr := optionChains.Chain("AAPL", time.Time{}, MaxStrikesPerSide+1)
+
+	// Assert
+	assert.ErrorIs(t, unknownSymbolErr, ErrUnknownSymbol)
+	assert.ErrorIs(t, noOptionsErr, ErrNoOptions)
+	assert.ErrorIs(t, unknownExpiryErr, ErrUnknownExpiry)
+	assert.ErrorIs(t, strikesErr, ErrInvalidStrikesPerSide)
+}
+
+func TestPriceOscillationService_OptionChainsFollowTheUnderlying(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Clock: simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)),
+	})
+	before, err := priceOscillationService.OptionChains().Chain("AAPL", time.Time{}, DefaultStrikesPerSide)
+	require.NoError(t, err)
+	atTheMoney := before.Calls[DefaultStrikesPerSide]
+
+	// Act
+	assetDataService.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(before.UnderlyingPrice.Mul(decimal.RequireFromString("1.01")), quote.LastUpdated)
+	})
+	after, err := priceOscillationService.OptionChains().Chain("AAPL", time.Time{}, DefaultStrikesPerSide)
+
+	// Assert
+	require.NoError(t, err)
+	var moved model.OptionQuote
+	for _, call := range after.Calls {
+		if call.Contract.Symbol() == atTheMoney.Contract.Symbol() {
+			moved = call
+		}
+	}
+	require.Equal(t, atTheMoney.Contract.Symbol(), moved.Contract.Symbol())
+	assert.True(t, moved.Price.GreaterThan(atTheMoney.Price))
+	assert.Greater(t, moved.Delta, atTheMoney.Delta)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultBookDepth is the number of simulated levels on each side of a book
+	DefaultBookDepth = 20
+
+	// levelRetention is the chance that a level still in the book keeps its size when the
+	// book is rebuilt, so consecutive books only differ in a few levels
+	levelRetention = 0.7
+)
+
+// OrderBookService simulates a multi-level order book per symbol around the bid and ask of
+// its quote. Levels are one spread model minimum spread apart and their sizes are whole lots
+// of the instrument, larger deeper in the book.
+//
+// Each rebuild draws from a source derived from the seed and the quote, so serving a book on
+// demand never shifts the sizes of the books built afterwards.
+type OrderBookService struct {
+	spreads *service.SpreadService
+	depth   int
+	seed    int64
+
+	mu    sync.Mutex
+	books map[string]model.OrderBook
+}
+
+// NewOrderBookService creates the books with sizes seeded from random, or from a time seeded
+// source when random is nil
+func NewOrderBookService(spreads *service.SpreadService, depth int, random *simulation.Random) *OrderBookService {
+	if depth <= 0 {
+		depth = DefaultBookDepth
+	}
+	if random == nil {
+		random = simulation.NewTimeSeededRandom()
+	}
+
+	return &OrderBookService{
+		spreads: spreads,
+		depth:   depth,
+		seed:    random.Int63(),
+		books:   make(map[string]model.OrderBook),
+	}
+}
+
+// Depth is the number of levels simulated on each side
+func (s *OrderBookService) Depth() int {
+	return s.depth
+}
+
+// BookFor returns the book of the quote, rebuilding it when the top of book of the quote
+// changed since the book was built
+func (s *OrderBookService) BookFor(quote model.AssetQuote) model.OrderBook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, exists := s.books[quote.Symbol]
+	if exists && matchesTopOfBook(previous, quote) {
+		return previous
+	}
+
+	book := s.build(quote, previous)
+	s.books[quote.Symbol] = book
+	return book
+}
+
+func matchesTopOfBook(book model.OrderBook, quote model.AssetQuote) bool {
+	if len(book.Bids) == 0 || len(book.Asks) == 0 {
+		return quote.Bid.IsZero()
+	}
+
+	bestBid, bestAsk := book.Bids[0], book.Asks[0]
+	return bestBid.Price.Equal(quote.Bid) && bestBid.Size == quote.BidSize &&
+		bestAsk.Price.Equal(quote.Ask) && bestAsk.Size == quote.AskSize
+}
+
+func (s *OrderBookService) build(quote model.AssetQuote, previous model.OrderBook) model.OrderBook {
+	book := model.OrderBook{
+		Symbol:     quote.Symbol,
+		Instrument: quote.Instrument,
+		Sequence:   previous.Sequence + 1,
+		UpdatedAt:  quote.LastUpdated,
+	}
+	if quote.Bid.IsZero() || quote.Ask.IsZero() {
+		return book
+	}
+
+	random := s.randomFor(quote)
+	spreadModel := s.spreads.ModelFor(quote)
+	tick := quote.Tick()
+	step := tick
+	if spreadModel.MinTicks > 1 {
+		step = tick.Mul(decimal.NewFromInt(spreadModel.MinTicks))
+	}
+
+	book.Bids = s.buildSide(random, spreadModel, quote.Instrument, quote.Bid, quote.BidSize, step.Neg(), tick, previous.Bids)
+	book.Asks = s.buildSide(random, spreadModel, quote.Instrument, quote.Ask, quote.AskSize, step, tick, previous.Asks)
+	return book
+}
+
+// randomFor returns the source of one rebuild, seeded from the symbol, top of book and time
+// of the quote
+func (s *OrderBookService) randomFor(quote model.AssetQuote) *simulation.Random {
+	hash := fnv.New64a()
+	hash.Write([]byte(quote.Symbol))
+	hash.Write([]byte(quote.Bid.String()))
+	hash.Write([]byte(quote.Ask.String()))
+	binary.Write(hash, binary.LittleEndian, quote.LastUpdated.UnixNano())
+
+	return simulation.NewRandom(s.seed ^ int64(hash.Sum64()))
+}
+
+// buildSide lays out the levels of one side from the best price, moving by increment. Bid
+// levels stop before the price would fall below one tick.
+func (s *OrderBookService) buildSide(
+	random *simulation.Random,
+	spreadModel model.SpreadModel,
+	instrument model.Instrument,
+	best decimal.Decimal,
+	bestSize int64,
+	increment decimal.Decimal,
+	minPrice decimal.Decimal,
+	previous []model.PriceLevel,
+) []model.PriceLevel {
+	previousSizes := make(map[string]int64, len(previous))
+	for _, level := range previous {
+		previousSizes[level.Price.String()] = level.Size
+	}
+
+	levels := make([]model.PriceLevel, 0, s.depth)
+	levels = append(levels, model.PriceLevel{Price: best, Size: bestSize})
+
+	price := best
+	for i := 1; i < s.depth; i++ {
+		price = price.Add(increment)
+		if price.LessThan(minPrice) {
+			break
+		}
+
+		size, exists := previousSizes[price.String()]
+		if !exists || random.Float64() >= levelRetention {
+			size = s.levelSize(random, spreadModel, instrument, i)
+		}
+		levels = append(levels, model.PriceLevel{Price: price, Size: size})
+	}
+
+	return levels
+}
+
+// levelSize draws a random number of lots that grows with the distance from the best price
+func (s *OrderBookService) levelSize(random *simulation.Random, spreadModel model.SpreadModel, instrument model.Instrument, level int) int64 {
+	maxLots := spreadModel.MaxLots
+	if maxLots < 1 {
+		maxLots = 1
+	}
+
+	lots := random.Int63n(maxLots) + 1 + int64(level)*maxLots/int64(s.depth)
+	return instrument.Quantity(lots)
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func quotedAsset(bid, ask string) model.AssetQuote {
+	quote := model.NewAssetQuote("AAPL", "Apple Inc.", model.AssetClassStock, decimal.RequireFromString("100.00"), 0, 0, time.Now())
+	return quote.WithBidAsk(decimal.RequireFromString(bid), decimal.RequireFromString(ask), 300, 500)
+}
+
+func TestOrderBookService_BookFor(t *testing.T) {
+	// Arrange
+	orderBooks := NewOrderBookService(service.NewDefaultSpreadService(), 5, simulation.NewRandom(1))
+	quote := quotedAsset("99.98", "100.02")
+
+	// Act
+	book := orderBooks.BookFor(quote)
+
+	// Assert
+	assert.Equal(t, uint64(1), book.Sequence)
+	assert.Len(t, book.Bids, 5)
+	assert.Len(t, book.Asks, 5)
+	assert.Equal(t, model.PriceLevel{Price: quote.Bid, Size: 300}, book.Bids[0])
+	assert.Equal(t, model.PriceLevel{Price: quote.Ask, Size: 500}, book.Asks[0])
+	for i := 1; i < len(book.Bids); i++ {
+		assert.True(t, book.Bids[i].Price.LessThan(book.Bids[i-1].Price))
+		assert.True(t, book.Asks[i].Price.GreaterThan(book.Asks[i-1].Price))
+		assert.Zero(t, book.Bids[i].Size%100)
+		assert.Positive(t, book.Asks[i].Size)
+	}
+}
+
+func TestOrderBookService_BookFor_RebuildsOnTopOfBookChange(t *testing.T) {
+	// Arrange
+	orderBooks := NewOrderBookService(service.NewDefaultSpreadService(), 5, simulation.NewRandom(1))
+	first := orderBooks.BookFor(quotedAsset("99.98", "100.02"))
+
+	// Act
+	cached := orderBooks.BookFor(quotedAsset("99.98", "100.02"))
+	moved := orderBooks.BookFor(quotedAsset("99.99", "100.03"))
+
+	// Assert
+	assert.Equal(t, first, cached)
+	assert.Equal(t, uint64(2), moved.Sequence)
+	assert.Equal(t, "99.99", moved.Bids[0].Price.String())
+}
+
+func TestOrderBookService_BookFor_StopsAtOneTick(t *testing.T) {
+	// Arrange
+	orderBooks := NewOrderBookService(service.NewDefaultSpreadService(), 5, simulation.NewRandom(1))
+
+	// Act
+	book := orderBooks.BookFor(quotedAsset("0.02", "0.03"))
+
+	// Assert
+	assert.Len(t, book.Bids, 2)
+	assert.Equal(t, "0.01", book.Bids[1].Price.String())
+	assert.Len(t, book.Asks, 5)
+}
+
+func TestOrderBookService_BookFor_IndependentOfOtherBooks(t *testing.T) {
+	// Arrange
+	served := NewOrderBookService(service.NewDefaultSpreadService(), 5, simulation.NewRandom(1))
+	skipped := NewOrderBookService(service.NewDefaultSpreadService(), 5, simulation.NewRandom(1))
+	other := model.NewAssetQuote("MSFT", "Microsoft Corporation", model.AssetClassStock, decimal.RequireFromString("300.00"), 0, 0, time.Now())
+	quote := quotedAsset("99.98", "100.02")
+
+	// Act
+	served.BookFor(other.WithBidAsk(decimal.RequireFromString("299.98"), decimal.RequireFromString("300.02"), 100, 100))
+	book := served.BookFor(quote)
+
+	// Assert
+	assert.Equal(t, skipped.BookFor(quote), book)
+}
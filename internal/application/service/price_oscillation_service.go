@@ -4,42 +4,181 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"log"
-	mathRand "math/rand"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
 )
 
-type Subscriber struct {
-	channel chan map[string]*model.AssetQuote
-	symbols map[string]bool
-	id      string
+// QuoteSnapshot holds the quotes published to a subscriber for one tick, keyed by symbol.
+// Quotes are values, so a snapshot can be read while the engine moves on to the next tick.
+type QuoteSnapshot map[string]model.AssetQuote
+
+// DefaultTickInterval is how often the engine moves prices and publishes snapshots
+const DefaultTickInterval = 4 * time.Second
+
+// PriceOscillationOptions holds the optional collaborators of the engine
+type PriceOscillationOptions struct {
+	// Sessions rolls the trading day over at the exchange close
+	Sessions *SessionRolloverService
+	// MarketHours gates price generation on exchange calendars. Without it every symbol
+	// is always open.
+	MarketHours *service.MarketHoursService
+	// Halts holds trading halts and the limit band. Defaults to a DefaultLimitBandPercent
+	// band with a DefaultHaltCooldown.
+	Halts *TradingHaltService
+	// Spreads quotes the bid and ask of every asset. Defaults to the asset class models.
+	Spreads *service.SpreadService
+	// OrderBooks simulates the depth of every symbol. Defaults to DefaultBookDepth levels
+	// built with the spread models.
+	OrderBooks *OrderBookService
+	// Trades prints the trades of every price move. Defaults to DefaultTradeHistory recent
+	// trades per symbol.
+	Trades *TradeTapeService
+	// Factors correlates price moves. Defaults to model.DefaultFactorModel.
+	Factors *FactorSimulationService
+	// Scenarios runs scripted market events. Defaults to an empty scenario library.
+	Scenarios *ScenarioService
+	// VolatilitySurface prices the option chains of the underlyings. Defaults to
+	// model.DefaultVolatilitySurface.
+	VolatilitySurface *model.VolatilitySurface
+	// Clock paces the ticks and timestamps the quotes. Defaults to the system clock.
+	Clock simulation.Clock
+	// Random drives every random draw of the engine. The default collaborators draw from
+	// forks of it, so an engine built with the same seed produces the same ticks. Defaults
+	// to a time seeded source.
+	Random *simulation.Random
 }
 
+var ErrUnknownSymbol = errors.New("unknown symbol")
+
 type PriceOscillationService struct {
 	assetDataService *service.AssetDataService
-	subscribers      map[string]*Subscriber
-	activeSymbols    map[string]int
-	mu               sync.RWMutex
+	fanout           *QuoteFanout
+	depthFanout      *QuoteFanout
+	tradeFanout      *QuoteFanout
+	sessions         *SessionRolloverService
+	marketHours      *service.MarketHoursService
+	halts            *TradingHaltService
+	spreads          *service.SpreadService
+	orderBooks       *OrderBookService
+	trades           *TradeTapeService
+	factors          *FactorSimulationService
+	scenarios        *ScenarioService
+	fxRates          *FXRateService
+	optionChains     *OptionChainService
+	clock            simulation.Clock
+	random           *simulation.Random
 	ctx              context.Context
 	cancel           context.CancelFunc
-	ticker           *time.Ticker
+	ticker           simulation.Ticker
+
+	statusMu sync.RWMutex
+	statuses map[string]model.SymbolMarketStatus
 }
 
 func NewPriceOscillationService(assetDataService *service.AssetDataService) *PriceOscillationService {
+	return NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{})
+}
+
+// NewPriceOscillationServiceWithOptions creates an engine that, on every tick, rolls the
+// trading day over when it has closed, publishes market status changes and only moves the
+// prices of symbols whose exchange is trading
+func NewPriceOscillationServiceWithOptions(
+	assetDataService *service.AssetDataService,
+	options PriceOscillationOptions,
+) *PriceOscillationService {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &PriceOscillationService{
+	clock := options.Clock
+	if clock == nil {
+		clock = simulation.SystemClock{}
+	}
+
+	random := options.Random
+	if random == nil {
+		random = simulation.NewTimeSeededRandom()
+	}
+
+	halts := options.Halts
+	if halts == nil {
+		halts = NewTradingHaltService(decimal.NewFromInt(DefaultLimitBandPercent), DefaultHaltCooldown)
+	}
+
+	spreads := options.Spreads
+	if spreads == nil {
+		spreads = service.NewDefaultSpreadService()
+	}
+
+	orderBooks := options.OrderBooks
+	if orderBooks == nil {
+		orderBooks = NewOrderBookService(spreads, DefaultBookDepth, random.Fork())
+	}
+
+	trades := options.Trades
+	if trades == nil {
+		trades = NewTradeTapeService(spreads, DefaultTradeHistory, random.Fork())
+	}
+
+	factors := options.Factors
+	if factors == nil {
+		factors = NewDefaultFactorSimulationService(random.Fork())
+	}
+
+	scenarios := options.Scenarios
+	if scenarios == nil {
+		scenarios = NewScenarioService(nil)
+	}
+
+	surface := model.DefaultVolatilitySurface()
+	if options.VolatilitySurface != nil {
+		surface = *options.VolatilitySurface
+	}
+
+	engine := &PriceOscillationService{
 		assetDataService: assetDataService,
-		subscribers:      make(map[string]*Subscriber),
-		activeSymbols:    make(map[string]int),
+		fanout:           NewQuoteFanout(DefaultFanoutShards),
+		depthFanout:      NewQuoteFanout(DefaultFanoutShards),
+		tradeFanout:      NewQuoteFanout(DefaultFanoutShards),
+		sessions:         options.Sessions,
+		marketHours:      options.MarketHours,
+		halts:            halts,
+		spreads:          spreads,
+		orderBooks:       orderBooks,
+		trades:           trades,
+		factors:          factors,
+		scenarios:        scenarios,
+		clock:            clock,
+		random:           random,
 		ctx:              ctx,
 		cancel:           cancel,
-		ticker:           time.NewTicker(4 * time.Second),
+		ticker:           clock.NewTicker(DefaultTickInterval),
+		statuses:         make(map[string]model.SymbolMarketStatus),
+	}
+	engine.fxRates = NewFXRateService(engine)
+	engine.optionChains = NewOptionChainService(engine, spreads, surface, clock)
+	engine.refreshStatuses(clock.Now())
+
+	// Every asset is quoted with a bid and ask before its first price move, in symbol order
+	// so a seeded engine draws the same sizes for each of them
+	assets := assetDataService.GetAllAssets()
+	symbols := make([]string, 0, len(assets))
+	for symbol := range assets {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		assetDataService.Update(symbol, engine.withBidAsk)
 	}
+
+	return engine
 }
 
 func (s *PriceOscillationService) Start() {
@@ -50,176 +189,620 @@ func (s *PriceOscillationService) Start() {
 func (s *PriceOscillationService) Stop() {
 	s.cancel()
 	s.ticker.Stop()
-
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	for _, subscriber := range s.subscribers {
-		close(subscriber.channel)
-	}
-	s.subscribers = make(map[string]*Subscriber)
-	s.activeSymbols = make(map[string]int)
+	s.fanout.Close()
+	s.depthFanout.Close()
+	s.tradeFanout.Close()
 
 	log.Println("Price oscillation service stopped")
 }
 
-func (s *PriceOscillationService) Subscribe(symbols map[string]bool) (string, <-chan map[string]*model.AssetQuote) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
+func (s *PriceOscillationService) Subscribe(symbols map[model.Symbol]bool) (string, <-chan MarketUpdate) {
 	subscriberID := s.generateSubscriberID()
+	quotes := s.fanout.Subscribe(subscriberID, s.symbolsToSlice(symbols))
 
-	subscriber := &Subscriber{
-		channel: make(chan map[string]*model.AssetQuote, 100),
-		symbols: make(map[string]bool),
-		id:      subscriberID,
-	}
+	log.Printf("New subscriber %s for %d symbols. Subscribers: %d",
+		subscriberID, len(symbols), s.fanout.SubscriberCount())
 
-	for symbol := range symbols {
-		subscriber.symbols[symbol] = true
-		s.activeSymbols[symbol]++
+	return subscriberID, quotes
+}
+
+func (s *PriceOscillationService) Unsubscribe(subscriberID string) {
+	if s.fanout.Unsubscribe(subscriberID) {
+		log.Printf("Unsubscribed %s. Subscribers: %d", subscriberID, s.fanout.SubscriberCount())
 	}
+}
 
-	s.subscribers[subscriberID] = subscriber
+// SubscribeDepth subscribes to the order books of the symbols. Every update carries the
+// latest book of the symbols whose depth changed.
+func (s *PriceOscillationService) SubscribeDepth(symbols map[model.Symbol]bool) (string, <-chan MarketUpdate) {
+	subscriberID := s.generateSubscriberID()
+	books := s.depthFanout.Subscribe(subscriberID, s.symbolsToSlice(symbols))
 
-	log.Printf("New subscriber %s for symbols: %v. Active symbols: %v",
-		subscriberID, s.mapToSlice(symbols), s.getActiveSymbolsList())
+	log.Printf("New depth subscriber %s for %d symbols. Depth subscribers: %d",
+		subscriberID, len(symbols), s.depthFanout.SubscriberCount())
 
-	return subscriberID, subscriber.channel
+	return subscriberID, books
 }
 
-func (s *PriceOscillationService) Unsubscribe(subscriberID string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *PriceOscillationService) UnsubscribeDepth(subscriberID string) {
+	if s.depthFanout.Unsubscribe(subscriberID) {
+		log.Printf("Unsubscribed depth %s. Depth subscribers: %d", subscriberID, s.depthFanout.SubscriberCount())
+	}
+}
 
-	subscriber, exists := s.subscribers[subscriberID]
+// OrderBook returns the current simulated order book of the symbol
+func (s *PriceOscillationService) OrderBook(symbol model.Symbol) (model.OrderBook, bool) {
+	quote, exists := s.assetDataService.GetAssetBySymbol(symbol.String())
 	if !exists {
-		return
+		return model.OrderBook{}, false
 	}
+	return s.orderBooks.BookFor(quote), true
+}
 
-	for symbol := range subscriber.symbols {
-		s.activeSymbols[symbol]--
-		if s.activeSymbols[symbol] <= 0 {
-			delete(s.activeSymbols, symbol)
-		}
+// BookDepth is the number of levels simulated on each side of a book
+func (s *PriceOscillationService) BookDepth() int {
+	return s.orderBooks.Depth()
+}
+
+// SubscribeTrades subscribes to the trade prints of the symbols. Every update carries the
+// trades printed since the previous one, oldest first.
+func (s *PriceOscillationService) SubscribeTrades(symbols map[model.Symbol]bool) (string, <-chan MarketUpdate) {
+	subscriberID := s.generateSubscriberID()
+	trades := s.tradeFanout.Subscribe(subscriberID, s.symbolsToSlice(symbols))
+
+	log.Printf("New trade subscriber %s for %d symbols. Trade subscribers: %d",
+		subscriberID, len(symbols), s.tradeFanout.SubscriberCount())
+
+	return subscriberID, trades
+}
+
+func (s *PriceOscillationService) UnsubscribeTrades(subscriberID string) {
+	if s.tradeFanout.Unsubscribe(subscriberID) {
+		log.Printf("Unsubscribed trades %s. Trade subscribers: %d", subscriberID, s.tradeFanout.SubscriberCount())
 	}
+}
+
+// RecentTrades returns up to limit of the latest trades of the symbol, newest first
+func (s *PriceOscillationService) RecentTrades(symbol model.Symbol, limit int) []model.Trade {
+	return s.trades.Recent(symbol.String(), limit)
+}
 
-	close(subscriber.channel)
-	delete(s.subscribers, subscriberID)
+// TradeHistory is how many recent trades are kept per symbol
+func (s *PriceOscillationService) TradeHistory() int {
+	return s.trades.Capacity()
+}
 
-	log.Printf("Unsubscribed %s. Active symbols: %v",
-		subscriberID, s.getActiveSymbolsList())
+// SubscriberCount is the number of quote, depth and trade subscribers of the engine
+func (s *PriceOscillationService) SubscriberCount() int {
+	return s.fanout.SubscriberCount() + s.depthFanout.SubscriberCount() + s.tradeFanout.SubscriberCount()
 }
 
-func (s *PriceOscillationService) GetAllQuotes() map[string]*model.AssetQuote {
+func (s *PriceOscillationService) GetAllQuotes() map[string]model.AssetQuote {
 	return s.assetDataService.GetAllAssets()
 }
 
+// Quote returns the latest simulated quote of the symbol
+func (s *PriceOscillationService) Quote(symbol model.Symbol) (model.AssetQuote, bool) {
+	return s.assetDataService.GetAssetBySymbol(symbol.String())
+}
+
+// FXRates converts between currencies at the rates of the FX pairs simulated by the engine
+func (s *PriceOscillationService) FXRates() *FXRateService {
+	return s.fxRates
+}
+
+// OptionChains quotes the options of the underlyings at the prices simulated by the engine
+func (s *PriceOscillationService) OptionChains() *OptionChainService {
+	return s.optionChains
+}
+
 func (s *PriceOscillationService) oscillatePrices() {
 	for {
 		select {
 		case <-s.ctx.Done():
 			return
-		case <-s.ticker.C:
+		case <-s.ticker.C():
+			now := s.clock.Now()
+			s.rollSession(now)
+			s.fanout.Publish(MarketUpdate{Statuses: s.refreshStatuses(now)})
+			s.expireHalts(now)
+			s.applyScenario(now)
 			s.updatePrices()
 		}
 	}
 }
 
-func (s *PriceOscillationService) updatePrices() {
-	s.mu.RLock()
-	if len(s.activeSymbols) == 0 {
-		s.mu.RUnlock()
+func (s *PriceOscillationService) rollSession(now time.Time) {
+	if s.sessions == nil {
 		return
 	}
 
-	activeSymbolsList := make([]string, 0, len(s.activeSymbols))
-	for symbol := range s.activeSymbols {
-		activeSymbolsList = append(activeSymbolsList, symbol)
+	if rollover := s.sessions.RollIfDue(now); !rollover.IsEmpty() {
+		s.fanout.Publish(MarketUpdate{Quotes: rollover.Quotes, CorporateActions: corporateActionsBySymbol(rollover.Actions)})
+	}
+}
+
+// corporateActionsBySymbol keys the actions by the symbol they apply to, and symbol changes
+// also by their new symbol
+func corporateActionsBySymbol(actions []model.CorporateAction) map[string][]model.CorporateAction {
+	if len(actions) == 0 {
+		return nil
 	}
-	s.mu.RUnlock()
 
-	numToUpdate := mathRand.Intn(len(activeSymbolsList)) + 1
-	if numToUpdate > len(activeSymbolsList) {
-		numToUpdate = len(activeSymbolsList)
+	bySymbol := make(map[string][]model.CorporateAction)
+	for _, action := range actions {
+		bySymbol[action.Symbol] = append(bySymbol[action.Symbol], action)
+		if target := action.TargetSymbol(); target != action.Symbol {
+			bySymbol[target] = append(bySymbol[target], action)
+		}
 	}
+	return bySymbol
+}
 
-	mathRand.Shuffle(len(activeSymbolsList), func(i, j int) {
-		activeSymbolsList[i], activeSymbolsList[j] = activeSymbolsList[j], activeSymbolsList[i]
+// MarketStatus returns the current trading status of the symbol: HALTED while it is
+// halted, otherwise the status of its exchange calendar
+func (s *PriceOscillationService) MarketStatus(symbol model.Symbol) model.SymbolMarketStatus {
+	s.statusMu.RLock()
+	marketStatus, exists := s.statuses[symbol.String()]
+	s.statusMu.RUnlock()
+
+	if !exists {
+		quote, _ := s.assetDataService.GetAssetBySymbol(symbol.String())
+		marketStatus = s.calendarStatus(symbol, quote.AssetClass, s.clock.Now())
+	}
+	return s.withHalt(marketStatus)
+}
+
+// HaltSymbol stops price updates for the symbol until ResumeSymbol is called
+func (s *PriceOscillationService) HaltSymbol(symbol model.Symbol, message string) (model.TradingHalt, error) {
+	if _, exists := s.assetDataService.GetAssetBySymbol(symbol.String()); !exists {
+		return model.TradingHalt{}, ErrUnknownSymbol
+	}
+
+	halt, err := s.halts.Halt(symbol.String(), message, s.clock.Now())
+	if err != nil {
+		return model.TradingHalt{}, err
+	}
+
+	log.Printf("Trading halted for %s: %s", symbol, message)
+	s.publishStatus(symbol.String())
+	return halt, nil
+}
+
+// ResumeSymbol ends the halt of the symbol, whether it was started by an administrator or
+// by the limit band
+func (s *PriceOscillationService) ResumeSymbol(symbol model.Symbol) (model.TradingHalt, error) {
+	halt, err := s.halts.Resume(symbol.String())
+	if err != nil {
+		return model.TradingHalt{}, err
+	}
+
+	log.Printf("Trading resumed for %s", symbol)
+	s.publishStatus(symbol.String())
+	return halt, nil
+}
+
+// Halts returns every active trading halt
+func (s *PriceOscillationService) Halts() []model.TradingHalt {
+	return s.halts.Halts()
+}
+
+func (s *PriceOscillationService) expireHalts(now time.Time) {
+	for _, halt := range s.halts.ExpireHalts(now) {
+		log.Printf("Trading resumed for %s after the %s halt cooldown", halt.Symbol, halt.Reason)
+		s.publishStatus(halt.Symbol)
+	}
+}
+
+// StartScenario runs the scenario from now, replacing the running one
+func (s *PriceOscillationService) StartScenario(scenario model.Scenario) (ScenarioStatus, error) {
+	if err := scenario.Validate(); err != nil {
+		return ScenarioStatus{}, err
+	}
+	for _, event := range scenario.Events {
+		if event.Target.Factor != "" && !s.factors.HasFactor(event.Target.Factor) {
+			return ScenarioStatus{}, fmt.Errorf("%w: scenario %s targets unknown factor %s",
+				model.ErrInvalidScenario, scenario.Name, event.Target.Factor)
+		}
+	}
+
+	log.Printf("Starting scenario %s with %d events", scenario.Name, len(scenario.Events))
+	started := s.scenarios.Start(scenario, s.clock.Now())
+	s.applyScenario(started.StartedAt)
+
+	status, _ := s.scenarios.Status(started.StartedAt)
+	return status, nil
+}
+
+// StartNamedScenario runs the scenario of the library with the given name
+func (s *PriceOscillationService) StartNamedScenario(name string) (ScenarioStatus, error) {
+	scenario, err := s.scenarios.Named(name)
+	if err != nil {
+		return ScenarioStatus{}, err
+	}
+	return s.StartScenario(scenario)
+}
+
+// StopScenario ends the running scenario; the prices it moved are kept
+func (s *PriceOscillationService) StopScenario() (ScenarioStatus, error) {
+	status, err := s.scenarios.Stop(s.clock.Now())
+	if err != nil {
+		return ScenarioStatus{}, err
+	}
+
+	log.Printf("Stopped scenario %s after %d of %d events", status.Name, status.EventsApplied, status.EventsTotal)
+	return status, nil
+}
+
+// ScenarioStatus returns the progress of the running scenario
+func (s *PriceOscillationService) ScenarioStatus() (ScenarioStatus, bool) {
+	return s.scenarios.Status(s.clock.Now())
+}
+
+// ScenarioNames returns the names of the scenarios that can be started by name
+func (s *PriceOscillationService) ScenarioNames() []string {
+	return s.scenarios.Names()
+}
+
+func (s *PriceOscillationService) applyScenario(now time.Time) {
+	step := s.scenarios.Step(now)
+
+	for _, event := range step.Events {
+		switch event.Type {
+		case model.ScenarioEventShock:
+			log.Printf("Scenario shock of %v%%", event.Percent)
+			s.moveBasePrices(event.Target, event.Percent)
+		case model.ScenarioEventHalt:
+			for _, symbol := range s.scenarioSymbols(event.Target) {
+				if _, err := s.HaltSymbol(symbol, event.Message); err != nil {
+					log.Printf("Scenario could not halt %s: %v", symbol, err)
+				}
+			}
+		case model.ScenarioEventResume:
+			for _, symbol := range s.scenarioSymbols(event.Target) {
+				if _, err := s.ResumeSymbol(symbol); err != nil {
+					log.Printf("Scenario could not resume %s: %v", symbol, err)
+				}
+			}
+		}
+	}
+
+	for _, drift := range step.Drifts {
+		s.moveBasePrices(drift.Target, drift.Percent)
+	}
+}
+
+// moveBasePrices moves the price every targeted symbol oscillates around by percent,
+// weighted by how strongly the target selects the symbol
+func (s *PriceOscillationService) moveBasePrices(target model.ScenarioTarget, percent float64) {
+	for symbol, quote := range s.assetDataService.GetAllAssets() {
+		weight := target.Weight(quote, s.factors.Exposure(quote))
+		if weight == 0 {
+			continue
+		}
+
+		move := decimal.NewFromFloat(1 + weight*percent/100)
+		s.assetDataService.Update(symbol, func(quote model.AssetQuote) model.AssetQuote {
+			return quote.WithBasePrice(quote.BasePrice.Mul(move))
+		})
+	}
+}
+
+func (s *PriceOscillationService) scenarioSymbols(target model.ScenarioTarget) []model.Symbol {
+	var symbols []model.Symbol
+	for symbol, quote := range s.assetDataService.GetAllAssets() {
+		if target.Weight(quote, s.factors.Exposure(quote)) != 0 {
+			symbols = append(symbols, model.Symbol(symbol))
+		}
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
+	return symbols
+}
+
+func (s *PriceOscillationService) publishStatus(symbol string) {
+	s.fanout.Publish(MarketUpdate{
+		Statuses: map[string]model.SymbolMarketStatus{symbol: s.MarketStatus(model.Symbol(symbol))},
 	})
+}
 
-	allAssets := s.assetDataService.GetAllAssets()
-	assetsToUpdate := make(map[string]*model.AssetQuote)
+func (s *PriceOscillationService) withHalt(marketStatus model.SymbolMarketStatus) model.SymbolMarketStatus {
+	halt, halted := s.halts.HaltOf(marketStatus.Symbol)
+	if !halted {
+		return marketStatus
+	}
 
-	for i := 0; i < numToUpdate; i++ {
-		symbol := activeSymbolsList[i]
-		if asset, exists := allAssets[symbol]; exists {
-			newPrice := s.calculateNewPrice(asset)
-			asset.UpdatePrice(newPrice)
-			assetsToUpdate[symbol] = asset
+	marketStatus.Status = model.MarketStatusHalted
+	marketStatus.NextChange = halt.ResumeAt
+	marketStatus.Halt = &halt
+	return marketStatus
+}
+
+// ExchangeStatuses returns the current status of every configured exchange
+func (s *PriceOscillationService) ExchangeStatuses() []model.ExchangeMarketStatus {
+	if s.marketHours == nil {
+		return nil
+	}
+
+	now := s.clock.Now()
+	calendars := s.marketHours.Calendars()
+	statuses := make([]model.ExchangeMarketStatus, len(calendars))
+	for i, calendar := range calendars {
+		statuses[i] = model.ExchangeMarketStatus{
+			Exchange:   calendar.Code,
+			Name:       calendar.Name,
+			Timezone:   calendar.Location.String(),
+			Status:     calendar.StatusAt(now),
+			NextChange: calendar.NextStatusChange(now),
 		}
 	}
+	return statuses
+}
+
+// refreshStatuses recomputes the status of every asset at now and returns the ones that
+// changed since the previous refresh
+func (s *PriceOscillationService) refreshStatuses(now time.Time) map[string]model.SymbolMarketStatus {
+	assets := s.assetDataService.GetAllAssets()
+
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
 
-	if len(assetsToUpdate) > 0 {
-		s.notifySubscribers(assetsToUpdate)
+	// Symbols on the same exchange share a calendar, so it is evaluated once per exchange
+	byExchange := make(map[string]model.SymbolMarketStatus)
+
+	changed := make(map[string]model.SymbolMarketStatus)
+	for symbol, quote := range assets {
+		marketStatus := s.exchangeStatus(model.Symbol(symbol), quote.AssetClass, now, byExchange)
+
+		previous, exists := s.statuses[symbol]
+		if exists && previous.Status == marketStatus.Status && previous.NextChange.Equal(marketStatus.NextChange) {
+			continue
+		}
+
+		s.statuses[symbol] = marketStatus
+
+		// Halted symbols stay HALTED whatever their calendar does
+		if _, halted := s.halts.HaltOf(symbol); exists && !halted {
+			changed[symbol] = marketStatus
+		}
 	}
+
+	return changed
 }
 
-func (s *PriceOscillationService) calculateNewPrice(quote *model.AssetQuote) float64 {
-	oscillationPercent := (mathRand.Float64() - 0.5) * 2 * 0.01
+func (s *PriceOscillationService) exchangeStatus(
+	symbol model.Symbol,
+	assetClass model.AssetClass,
+	now time.Time,
+	byExchange map[string]model.SymbolMarketStatus,
+) model.SymbolMarketStatus {
+	if s.marketHours == nil {
+		return s.calendarStatus(symbol, assetClass, now)
+	}
 
-	newPrice := quote.BasePrice * (1 + oscillationPercent)
+	code := s.marketHours.CalendarFor(symbol, assetClass).Code
+	marketStatus, exists := byExchange[code]
+	if !exists {
+		marketStatus = s.calendarStatus(symbol, assetClass, now)
+		byExchange[code] = marketStatus
+	}
 
-	if newPrice < 1.00 {
-		newPrice = 1.00
+	marketStatus.Symbol = symbol.String()
+	return marketStatus
+}
+
+func (s *PriceOscillationService) calendarStatus(symbol model.Symbol, assetClass model.AssetClass, now time.Time) model.SymbolMarketStatus {
+	if s.marketHours == nil {
+		return model.SymbolMarketStatus{Symbol: symbol.String(), Status: model.MarketStatusOpen}
 	}
+	return s.marketHours.StatusAt(symbol, assetClass, now)
+}
 
-	return newPrice
+func (s *PriceOscillationService) isTrading(symbol string) bool {
+	if _, halted := s.halts.HaltOf(symbol); halted {
+		return false
+	}
+
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	marketStatus, exists := s.statuses[symbol]
+	return !exists || marketStatus.Status.IsTrading()
 }
 
-func (s *PriceOscillationService) notifySubscribers(assets map[string]*model.AssetQuote) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *PriceOscillationService) updatePrices() {
+	depthSymbols := make(map[string]bool)
+	for _, symbol := range s.depthFanout.ActiveSymbols() {
+		depthSymbols[symbol] = true
+	}
 
-	for _, subscriber := range s.subscribers {
-		relevantAssets := make(map[string]*model.AssetQuote)
-		for symbol, asset := range assets {
-			if subscriber.symbols[symbol] {
-				relevantAssets[symbol] = asset
-			}
+	// Prices move for every symbol followed by a quote, a depth or a trade subscriber
+	activeSymbols := make(map[string]bool, len(depthSymbols))
+	for _, symbol := range s.fanout.ActiveSymbols() {
+		activeSymbols[symbol] = true
+	}
+	for _, symbol := range s.tradeFanout.ActiveSymbols() {
+		activeSymbols[symbol] = true
+	}
+	for symbol := range depthSymbols {
+		activeSymbols[symbol] = true
+	}
+
+	// Indices and ETFs with holdings are not drawn: their constituents are, and the level or
+	// net asset value of every followed basket is computed from them once they have moved
+	var basketSymbols []string
+	for symbol := range activeSymbols {
+		definition, isBasket := s.assetDataService.GetIndex(symbol)
+		if !isBasket {
+			continue
 		}
+		delete(activeSymbols, symbol)
+		if s.isTrading(symbol) {
+			basketSymbols = append(basketSymbols, symbol)
+		}
+		for _, constituent := range definition.Symbols() {
+			activeSymbols[constituent.String()] = true
+		}
+	}
 
-		if len(relevantAssets) > 0 {
-			select {
-			case subscriber.channel <- relevantAssets:
-			default:
-				log.Printf("⚠️  Subscriber %s channel full, skipping update", subscriber.id)
-			}
+	// Factors move every tick so the prices of all symbols stay consistent with each other
+	s.factors.Advance()
+
+	var activeSymbolsList []string
+	for symbol := range activeSymbols {
+		if s.isTrading(symbol) {
+			activeSymbolsList = append(activeSymbolsList, symbol)
 		}
 	}
+	if len(activeSymbolsList) == 0 && len(basketSymbols) == 0 {
+		return
+	}
+
+	// Sorted first so the shuffle of a seeded run does not depend on map iteration order
+	sort.Strings(activeSymbolsList)
+	sort.Strings(basketSymbols)
+
+	numToUpdate := 0
+	if len(activeSymbolsList) > 0 {
+		numToUpdate = s.random.Intn(len(activeSymbolsList)) + 1
+	}
+
+	s.random.Shuffle(len(activeSymbolsList), func(i, j int) {
+		activeSymbolsList[i], activeSymbolsList[j] = activeSymbolsList[j], activeSymbolsList[i]
+	})
+
+	tick := priceTick{
+		now:          s.clock.Now(),
+		depthSymbols: depthSymbols,
+		update:       MarketUpdate{Quotes: make(QuoteSnapshot, numToUpdate+len(basketSymbols))},
+		depthUpdate:  MarketUpdate{Books: make(map[string]model.OrderBook)},
+		tradeUpdate:  MarketUpdate{Trades: make(map[string][]model.Trade)},
+	}
+
+	for _, symbol := range activeSymbolsList[:numToUpdate] {
+		s.movePrice(&tick, symbol, s.calculateNewPrice)
+	}
+
+	for _, symbol := range basketSymbols {
+		level, err := s.assetDataService.IndexLevel(symbol)
+		if err != nil {
+			log.Printf("Failed to compute the level of %s: %v", symbol, err)
+			continue
+		}
+		s.movePrice(&tick, symbol, func(model.AssetQuote) decimal.Decimal { return level })
+	}
+
+	s.fanout.Publish(tick.update)
+	s.depthFanout.Publish(tick.depthUpdate)
+	s.tradeFanout.Publish(tick.tradeUpdate)
+}
+
+// priceTick collects the updates published by one tick of the engine
+type priceTick struct {
+	now          time.Time
+	depthSymbols map[string]bool
+	update       MarketUpdate
+	depthUpdate  MarketUpdate
+	tradeUpdate  MarketUpdate
+}
+
+// movePrice moves symbol to the price returned by newPrice within its limit band, quotes it
+// and prints its trades into the updates of the tick. Indices are not traded, and ETFs with
+// holdings are moved to their net asset value.
+func (s *PriceOscillationService) movePrice(tick *priceTick, symbol string, newPrice func(model.AssetQuote) decimal.Decimal) {
+	var halt *model.TradingHalt
+	var trades []model.Trade
+	updated, exists := s.assetDataService.Update(symbol, func(quote model.AssetQuote) model.AssetQuote {
+		target := newPrice(quote)
+		var price decimal.Decimal
+		price, halt = s.halts.ApplyLimitBand(quote, target, tick.now)
+		moved := s.withBidAsk(quote.WithPrice(price, tick.now))
+		if !quote.NAV.IsZero() {
+			moved = moved.WithNAV(target)
+		}
+		if halt != nil || quote.AssetClass == model.AssetClassIndex {
+			// Nothing trades through the limit that halted the symbol
+			return moved
+		}
+
+		moved, trades = s.trades.Print(quote, moved, tick.now)
+		return moved
+	})
+	if !exists {
+		return
+	}
+
+	tick.update.Quotes[symbol] = updated
+	if len(trades) > 0 {
+		tick.tradeUpdate.Trades[symbol] = trades
+	}
+	if tick.depthSymbols[symbol] {
+		tick.depthUpdate.Books[symbol] = s.orderBooks.BookFor(updated)
+	}
+	if halt != nil {
+		log.Printf("Trading halted for %s: %s at %s", symbol, halt.Message, halt.LimitPrice)
+		if tick.update.Statuses == nil {
+			tick.update.Statuses = make(map[string]model.SymbolMarketStatus)
+		}
+		tick.update.Statuses[symbol] = s.MarketStatus(model.Symbol(symbol))
+	}
+}
+
+// calculateNewPrice moves the price of an instrument away from its base price by the factor
+// deviation. Bonds are priced from their yield instead: the deviation moves the yield of
+// their base price, so bond prices move inversely to yields and with their duration.
+func (s *PriceOscillationService) calculateNewPrice(quote model.AssetQuote) decimal.Decimal {
+	deviation := s.factors.Deviation(quote) * s.scenarios.VolatilityMultiplier(quote, s.factors.Exposure(quote))
+
+	var newPrice decimal.Decimal
+	if quote.Bond.IsZero() {
+		newPrice = quote.RoundPrice(quote.BasePrice.Mul(decimal.NewFromFloat(1 + deviation)))
+	} else {
+		now := s.clock.Now()
+		yield := quote.Bond.Yield(quote.BasePrice, now).Mul(decimal.NewFromFloat(1 + deviation))
+		newPrice = quote.RoundPrice(quote.Bond.Price(yield, now))
+	}
+
+	// Never quote below one tick of the asset
+	minPrice := quote.Tick()
+	if newPrice.LessThan(minPrice) {
+		newPrice = minPrice
+	}
+
+	return newPrice
+}
+
+// withBidAsk quotes a bid and ask around the current price, with a spread that randomly
+// widens up to the maximum of the asset's spread model and sizes of whole lots
+func (s *PriceOscillationService) withBidAsk(quote model.AssetQuote) model.AssetQuote {
+	spreadModel := s.spreads.ModelFor(quote)
+
+	bid, ask := spreadModel.BidAsk(quote.CurrentPrice, quote.Instrument, decimal.NewFromFloat(s.random.Float64()))
+	bidSize := spreadModel.Size(s.randomLots(spreadModel), quote.Instrument)
+	askSize := spreadModel.Size(s.randomLots(spreadModel), quote.Instrument)
+
+	return quote.WithBidAsk(bid, ask, bidSize, askSize)
+}
+
+func (s *PriceOscillationService) randomLots(spreadModel model.SpreadModel) int64 {
+	if spreadModel.MaxLots <= 1 {
+		return 1
+	}
+	return s.random.Int63n(spreadModel.MaxLots) + 1
 }
 
 func (s *PriceOscillationService) generateSubscriberID() string {
 	bytes := make([]byte, 8)
 	_, err := rand.Read(bytes)
 	if err != nil {
-		return hex.EncodeToString([]byte(time.Now().String()))[:16]
+		return hex.EncodeToString([]byte(s.clock.Now().String()))[:16]
 	}
 	return hex.EncodeToString(bytes)
 }
 
-func (s *PriceOscillationService) mapToSlice(m map[string]bool) []string {
-	slice := make([]string, 0, len(m))
-	for k := range m {
-		slice = append(slice, k)
-	}
-	return slice
-}
-
-func (s *PriceOscillationService) getActiveSymbolsList() []string {
-	slice := make([]string, 0, len(s.activeSymbols))
-	for symbol := range s.activeSymbols {
-		slice = append(slice, symbol)
+func (s *PriceOscillationService) symbolsToSlice(symbols map[model.Symbol]bool) []string {
+	slice := make([]string, 0, len(symbols))
+	for symbol := range symbols {
+		slice = append(slice, symbol.String())
 	}
 	return slice
 }
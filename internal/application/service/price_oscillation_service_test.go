@@ -0,0 +1,733 @@
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceOscillationService_PublishesSnapshots(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	_, quotes := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true})
+
+	// Act
+	priceOscillationService.updatePrices()
+
+	// Assert
+	select {
+	case update := <-quotes:
+		published, exists := update.Quotes["AAPL"]
+		require.True(t, exists)
+
+		// Later ticks must not change a snapshot that was already published
+		priceBefore := published.CurrentPrice
+		for i := 0; i < 10; i++ {
+			priceOscillationService.updatePrices()
+		}
+		assert.True(t, priceBefore.Equal(published.CurrentPrice))
+		assert.True(t, published.Change.Equal(published.CurrentPrice.Sub(published.BasePrice)))
+	case <-time.After(time.Second):
+		t.Fatal("no snapshot published")
+	}
+}
+
+// TestPriceOscillationService_ConcurrentSubscribers is meant to run with -race: many
+// subscribers come and go and read their snapshots while the engine keeps ticking
+func TestPriceOscillationService_ConcurrentSubscribers(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	symbolSets := []map[model.Symbol]bool{
+		{"AAPL": true, "MSFT": true},
+		{"GOOGL": true, "SPY": true, "QQQ": true},
+		{"TSLA": true},
+		{"AAPL": true, "NVDA": true, "VTI": true, "GLD": true},
+	}
+
+	const subscribers = 200
+	const ticks = 200
+
+	// Ticks keep coming until every subscriber is done, so no subscriber can miss them all
+	done := make(chan struct{})
+	var subscribersDone atomic.Bool
+	var tickerWG sync.WaitGroup
+	tickerWG.Add(1)
+	go func() {
+		defer tickerWG.Done()
+		for i := 0; i < ticks || !subscribersDone.Load(); i++ {
+			priceOscillationService.updatePrices()
+		}
+		close(done)
+	}()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	received := 0
+
+	// Act
+	for i := 0; i < subscribers; i++ {
+		wg.Add(1)
+		go func(symbols map[model.Symbol]bool) {
+			defer wg.Done()
+
+			for round := 0; round < 3; round++ {
+				subscriberID, quotes := priceOscillationService.Subscribe(symbols)
+
+				for reads := 0; reads < 5; reads++ {
+					select {
+					case update, ok := <-quotes:
+						if !ok {
+							return
+						}
+						for symbol, quote := range update.Quotes {
+							assert.True(t, symbols[model.Symbol(symbol)], "unexpected symbol %s", symbol)
+							assert.Equal(t, symbol, quote.Symbol)
+							assert.True(t, quote.CurrentPrice.IsPositive())
+							assert.True(t, quote.Change.Equal(quote.CurrentPrice.Sub(quote.BasePrice)))
+						}
+						mu.Lock()
+						received++
+						mu.Unlock()
+					case <-done:
+					}
+				}
+
+				priceOscillationService.Unsubscribe(subscriberID)
+			}
+		}(symbolSets[i%len(symbolSets)])
+	}
+
+	wg.Wait()
+	subscribersDone.Store(true)
+	tickerWG.Wait()
+
+	// Assert
+	assert.Greater(t, received, 0)
+	assert.Zero(t, priceOscillationService.fanout.SubscriberCount())
+	assert.Empty(t, priceOscillationService.fanout.ActiveSymbols())
+}
+
+func TestPriceOscillationService_DoesNotMovePricesWhileClosed(t *testing.T) {
+	// Arrange: an exchange without trading days is always closed
+	closedCalendar := service.DefaultUSCalendar()
+	closedCalendar.TradingDays = map[time.Weekday]bool{}
+	marketHours, err := service.NewMarketHoursService([]model.ExchangeCalendar{closedCalendar}, service.DefaultExchangeCode, nil)
+	require.NoError(t, err)
+
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		MarketHours: marketHours,
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true})
+	before, _ := assetDataService.GetAssetBySymbol("AAPL")
+
+	// Act
+	for i := 0; i < 10; i++ {
+		priceOscillationService.updatePrices()
+	}
+
+	// Assert
+	after, _ := assetDataService.GetAssetBySymbol("AAPL")
+	assert.True(t, before.CurrentPrice.Equal(after.CurrentPrice))
+	assert.Equal(t, model.MarketStatusClosed, priceOscillationService.MarketStatus("AAPL").Status)
+
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected update while the market is closed: %v", update)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPriceOscillationService_PublishesStatusChanges(t *testing.T) {
+	// Arrange
+	marketHours := service.NewDefaultMarketHoursService()
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		MarketHours: marketHours,
+	})
+	defer priceOscillationService.Stop()
+
+	newYork := marketHours.CalendarFor("AAPL", model.AssetClassStock).Location
+	priceOscillationService.refreshStatuses(time.Date(2026, 3, 10, 11, 0, 0, 0, newYork))
+
+	// Act
+	unchanged := priceOscillationService.refreshStatuses(time.Date(2026, 3, 10, 12, 0, 0, 0, newYork))
+	changed := priceOscillationService.refreshStatuses(time.Date(2026, 3, 10, 16, 30, 0, 0, newYork))
+
+	// Assert
+	assert.Empty(t, unchanged)
+	require.Contains(t, changed, "AAPL")
+	assert.Equal(t, model.MarketStatusPostMarket, changed["AAPL"].Status)
+	assert.Equal(t, "US", changed["AAPL"].Exchange)
+	// FX and crypto pairs trade around the clock on their own calendars
+	assert.NotContains(t, changed, "EURUSD")
+	assert.NotContains(t, changed, "BTC-USD")
+	aroundTheClock := len(assetDataService.GetAssetsByClass(model.AssetClassFX)) + len(assetDataService.GetAssetsByClass(model.AssetClassCrypto))
+	assert.Len(t, changed, len(assetDataService.GetAllAssets())-aroundTheClock)
+	assert.Equal(t, model.MarketStatusPostMarket, priceOscillationService.MarketStatus("AAPL").Status)
+	assert.Equal(t, model.MarketStatusOpen, priceOscillationService.MarketStatus("EURUSD").Status)
+}
+
+func TestPriceOscillationService_HaltAndResumeSymbol(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true})
+	before, _ := assetDataService.GetAssetBySymbol("AAPL")
+
+	// Act
+	halt, err := priceOscillationService.HaltSymbol("AAPL", "pending news")
+	require.NoError(t, err)
+	haltUpdate := receiveUpdate(t, updates)
+
+	for i := 0; i < 10; i++ {
+		priceOscillationService.updatePrices()
+	}
+	during, _ := assetDataService.GetAssetBySymbol("AAPL")
+
+	_, err = priceOscillationService.ResumeSymbol("AAPL")
+	require.NoError(t, err)
+	resumeUpdate := receiveUpdate(t, updates)
+
+	// Assert
+	assert.Equal(t, model.HaltReasonAdmin, halt.Reason)
+	assert.Equal(t, model.MarketStatusHalted, haltUpdate.Statuses["AAPL"].Status)
+	assert.Equal(t, "pending news", haltUpdate.Statuses["AAPL"].Halt.Message)
+	assert.True(t, before.CurrentPrice.Equal(during.CurrentPrice))
+	assert.Equal(t, model.MarketStatusOpen, resumeUpdate.Statuses["AAPL"].Status)
+	assert.Nil(t, resumeUpdate.Statuses["AAPL"].Halt)
+
+	_, err = priceOscillationService.HaltSymbol("UNKNOWN", "")
+	assert.ErrorIs(t, err, ErrUnknownSymbol)
+}
+
+func TestPriceOscillationService_HaltsOnLimitBandBreach(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Halts: NewTradingHaltService(decimal.NewFromInt(5), time.Minute),
+	})
+	defer priceOscillationService.Stop()
+
+	// Any simulated price is far above half the base price
+	apple, _ := assetDataService.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPreviousClose(quote.BasePrice.Div(decimal.NewFromInt(2)))
+	})
+	limitUp := model.RoundPrice(apple.PreviousClose.Mul(decimal.RequireFromString("1.05")), apple.PricePrecision)
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true})
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, updates)
+	priceOscillationService.expireHalts(time.Now().Add(2 * time.Minute))
+	resumeUpdate := receiveUpdate(t, updates)
+
+	// Assert
+	assert.True(t, limitUp.Equal(update.Quotes["AAPL"].CurrentPrice))
+	require.Contains(t, update.Statuses, "AAPL")
+	assert.Equal(t, model.MarketStatusHalted, update.Statuses["AAPL"].Status)
+	assert.Equal(t, model.HaltReasonLimitUp, update.Statuses["AAPL"].Halt.Reason)
+	assert.False(t, update.Statuses["AAPL"].NextChange.IsZero())
+
+	assert.Equal(t, model.MarketStatusOpen, resumeUpdate.Statuses["AAPL"].Status)
+	assert.Empty(t, priceOscillationService.Halts())
+}
+
+func TestPriceOscillationService_QuotesBidAndAsk(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true})
+	initial, _ := priceOscillationService.Quote("AAPL")
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, updates)
+
+	// Assert
+	for _, quote := range []model.AssetQuote{initial, update.Quotes["AAPL"]} {
+		assert.True(t, quote.Bid.LessThan(quote.CurrentPrice), "bid %s, price %s", quote.Bid, quote.CurrentPrice)
+		assert.True(t, quote.Ask.GreaterThan(quote.CurrentPrice), "ask %s, price %s", quote.Ask, quote.CurrentPrice)
+		assert.Zero(t, quote.BidSize%100)
+		assert.Positive(t, quote.BidSize)
+		assert.Positive(t, quote.AskSize)
+	}
+}
+
+func TestPriceOscillationService_PublishesOrderBooksToDepthSubscribers(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	depthID, books := priceOscillationService.SubscribeDepth(map[model.Symbol]bool{"AAPL": true})
+	defer priceOscillationService.UnsubscribeDepth(depthID)
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, books)
+
+	// Assert
+	quote, _ := priceOscillationService.Quote("AAPL")
+	book := update.Books["AAPL"]
+	assert.Empty(t, update.Quotes)
+	assert.Len(t, book.Bids, DefaultBookDepth)
+	assert.True(t, book.Bids[0].Price.Equal(quote.Bid))
+	assert.True(t, book.Asks[0].Price.Equal(quote.Ask))
+
+	current, exists := priceOscillationService.OrderBook("AAPL")
+	assert.True(t, exists)
+	assert.Equal(t, book.Sequence, current.Sequence)
+}
+
+func TestPriceOscillationService_PublishesTradesToTradeSubscribers(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	initial, _ := priceOscillationService.Quote("AAPL")
+	tradesID, trades := priceOscillationService.SubscribeTrades(map[model.Symbol]bool{"AAPL": true})
+	defer priceOscillationService.UnsubscribeTrades(tradesID)
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, trades)
+
+	// Assert
+	printed := update.Trades["AAPL"]
+	quote, _ := priceOscillationService.Quote("AAPL")
+	assert.NotEmpty(t, printed)
+	assert.Equal(t, quote.Volume, printed[len(printed)-1].Volume)
+	assert.Greater(t, quote.Volume, initial.Volume)
+	assert.Equal(t, printed[len(printed)-1], priceOscillationService.RecentTrades("AAPL", 1)[0])
+}
+
+func TestPriceOscillationService_MovesCorrelatedSymbolsTogether(t *testing.T) {
+	// Arrange
+	factors, err := NewFactorSimulationService(model.FactorModel{
+		Factors:     []model.Factor{{Name: model.MarketFactor, Volatility: 0.004}},
+		Correlation: [][]float64{{1}},
+		AssetClasses: map[model.AssetClass]model.FactorExposure{
+			model.AssetClassETF: {Betas: map[string]float64{model.MarketFactor: 1}},
+		},
+	}, simulation.NewRandom(1))
+	require.NoError(t, err)
+
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Factors: factors,
+	})
+	defer priceOscillationService.Stop()
+
+	subscriberID, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"SPY": true, "QQQ": true, "XLK": true})
+	defer priceOscillationService.Unsubscribe(subscriberID)
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, updates)
+
+	// Assert: every ETF moved to the side of its base price the market factor is on
+	market := factors.Levels()[model.MarketFactor]
+	assert.NotEmpty(t, update.Quotes)
+	for symbol, quote := range update.Quotes {
+		move := quote.CurrentPrice.Sub(quote.BasePrice)
+		if move.IsZero() {
+			continue
+		}
+		assert.Equal(t, market > 0, move.IsPositive(), "%s moved %s with the market at %v", symbol, move, market)
+	}
+}
+
+func TestPriceOscillationService_StartScenario(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	before, _ := priceOscillationService.Quote("AAPL")
+	untouched, _ := priceOscillationService.Quote("MSFT")
+
+	// Act
+	status, err := priceOscillationService.StartScenario(model.Scenario{
+		Name: "gap",
+		Events: []model.ScenarioEvent{
+			{Type: model.ScenarioEventShock, Percent: 8, Target: model.ScenarioTarget{Symbols: []string{"AAPL"}}},
+			{Type: model.ScenarioEventHalt, Message: "News pending", Target: model.ScenarioTarget{Symbols: []string{"AAPL"}}},
+			{At: time.Hour, Type: model.ScenarioEventResume, Target: model.ScenarioTarget{Symbols: []string{"AAPL"}}},
+		},
+	})
+
+	// Assert: events due at the start are applied at once
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.EventsApplied)
+
+	after, _ := priceOscillationService.Quote("AAPL")
+	assert.Equal(t, "189.54", model.FormatPrice(after.BasePrice, after.PricePrecision))
+	assert.True(t, before.BasePrice.Equal(decimal.RequireFromString("175.50")))
+
+	msft, _ := priceOscillationService.Quote("MSFT")
+	assert.True(t, untouched.BasePrice.Equal(msft.BasePrice))
+
+	halt, halted := priceOscillationService.halts.HaltOf("AAPL")
+	assert.True(t, halted)
+	assert.Equal(t, "News pending", halt.Message)
+}
+
+func TestPriceOscillationService_StartScenario_Invalid(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	// Act
+	_, unknownFactorErr := priceOscillationService.StartScenario(model.Scenario{
+		Name:   "rotation",
+		Events: []model.ScenarioEvent{{Type: model.ScenarioEventShock, Percent: 2, Target: model.ScenarioTarget{Factor: "TECH"}}},
+	})
+	_, unknownNameErr := priceOscillationService.StartNamedScenario("crash")
+
+	// Assert
+	assert.ErrorIs(t, unknownFactorErr, model.ErrInvalidScenario)
+	assert.ErrorIs(t, unknownNameErr, ErrUnknownScenario)
+	_, running := priceOscillationService.ScenarioStatus()
+	assert.False(t, running)
+}
+
+// runSeededTicks steps a seeded engine on a virtual clock starting at start and returns the
+// quotes of every tick
+func runSeededTicks(t *testing.T, seed int64, start time.Time, ticks int) []QuoteSnapshot {
+	t.Helper()
+
+	clock := simulation.NewVirtualClock(start)
+	priceOscillationService := NewPriceOscillationServiceWithOptions(service.NewAssetDataService(clock.Now()), PriceOscillationOptions{
+		Clock:  clock,
+		Random: simulation.NewRandom(seed),
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"AAPL": true, "MSFT": true, "GOOGL": true, "SPY": true, "UST10Y": true})
+	priceOscillationService.Start()
+
+	snapshots := make([]QuoteSnapshot, 0, ticks)
+	for i := 0; i < ticks; i++ {
+		clock.Advance(DefaultTickInterval)
+
+		select {
+		case update := <-updates:
+			snapshots = append(snapshots, update.Quotes)
+		case <-time.After(time.Second):
+			t.Fatalf("no update for tick %d", i+1)
+		}
+	}
+	return snapshots
+}
+
+func TestPriceOscillationService_SeededRunsAreReproducible(t *testing.T) {
+	// Act
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	first := runSeededTicks(t, 42, start, 5)
+	second := runSeededTicks(t, 42, start, 5)
+	other := runSeededTicks(t, 7, start, 5)
+
+	// Assert
+	require.Len(t, first, 5)
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, other)
+
+	// Quotes are stamped with the virtual time of their tick
+	for i, snapshot := range first {
+		for _, quote := range snapshot {
+			assert.Equal(t, time.Date(2026, 3, 10, 15, 0, 4*(i+1), 0, time.UTC), quote.LastUpdated)
+		}
+	}
+}
+
+func TestPriceOscillationService_SeededInitialBidAskIsReproducible(t *testing.T) {
+	// Arrange
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	newEngine := func() *service.AssetDataService {
+		assetDataService := service.NewAssetDataService(start)
+		NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+			Clock:  simulation.NewVirtualClock(start),
+			Random: simulation.NewRandom(42),
+		}).Stop()
+		return assetDataService
+	}
+
+	// Act
+	first := newEngine().GetAllAssets()
+	second := newEngine().GetAllAssets()
+
+	// Assert
+	require.NotEmpty(t, first)
+	for symbol, quote := range first {
+		other := second[symbol]
+		assert.True(t, quote.Bid.IsPositive(), symbol)
+		assert.Equal(t, quote.Bid.String(), other.Bid.String(), symbol)
+		assert.Equal(t, quote.Ask.String(), other.Ask.String(), symbol)
+		assert.Equal(t, quote.BidSize, other.BidSize, symbol)
+		assert.Equal(t, quote.AskSize, other.AskSize, symbol)
+	}
+}
+
+func TestPriceOscillationService_SeededRunsFollowTheVirtualStart(t *testing.T) {
+	// Arrange
+	starts := []time.Time{
+		time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC),
+		time.Date(2027, 9, 16, 15, 0, 0, 0, time.UTC),
+	}
+
+	for _, start := range starts {
+		t.Run(start.Format("2006-01-02"), func(t *testing.T) {
+			// Act
+			first := runSeededTicks(t, 42, start, 3)
+			second := runSeededTicks(t, 42, start, 3)
+
+			// Assert
+			assert.Equal(t, first, second)
+
+			// Bonds are priced from their yield at the virtual start, not at the wall clock
+			bond := first[0]["UST10Y"]
+			expected := bond.RoundPrice(bond.Bond.Price(decimal.RequireFromString("4.30"), start))
+			assert.Equal(t, expected.String(), bond.BasePrice.String())
+			assert.Equal(t, start.Add(DefaultTickInterval), bond.LastUpdated)
+		})
+	}
+}
+
+func TestPriceOscillationService_QuotesWholeTicksAndLots(t *testing.T) {
+	// Arrange
+	clock := simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+	assetDataService := service.NewAssetDataService(time.Now())
+	instrument := model.Instrument{TickSize: decimal.RequireFromString("0.05"), PricePrecision: 2, LotSize: 25, Currency: "USD"}
+	assetDataService.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithInstrument(instrument)
+	})
+
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Clock:  clock,
+		Random: simulation.NewRandom(7),
+	})
+	defer priceOscillationService.Stop()
+
+	symbols := map[model.Symbol]bool{"AAPL": true}
+	_, updates := priceOscillationService.Subscribe(symbols)
+	_, books := priceOscillationService.SubscribeDepth(symbols)
+	_, trades := priceOscillationService.SubscribeTrades(symbols)
+	priceOscillationService.Start()
+
+	isTick := func(price decimal.Decimal) bool {
+		return price.Mod(instrument.TickSize).IsZero()
+	}
+
+	// Act
+	for i := 0; i < 20; i++ {
+		clock.Advance(DefaultTickInterval)
+
+		select {
+		case update := <-updates:
+			// Assert
+			quote := update.Quotes["AAPL"]
+			assert.True(t, isTick(quote.CurrentPrice), "price %s", quote.CurrentPrice)
+			assert.True(t, isTick(quote.Bid) && isTick(quote.Ask), "bid %s ask %s", quote.Bid, quote.Ask)
+			assert.Zero(t, quote.BidSize%instrument.LotSize)
+			assert.Zero(t, quote.AskSize%instrument.LotSize)
+		case <-time.After(time.Second):
+			t.Fatalf("no update for tick %d", i+1)
+		}
+
+		select {
+		case update := <-books:
+			for _, level := range append(update.Books["AAPL"].Bids, update.Books["AAPL"].Asks...) {
+				assert.True(t, isTick(level.Price), "level %s", level.Price)
+				assert.Zero(t, level.Size%instrument.LotSize)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no book for tick %d", i+1)
+		}
+
+		select {
+		case update := <-trades:
+			for _, trade := range update.Trades["AAPL"] {
+				assert.True(t, isTick(trade.Price), "trade %s", trade.Price)
+				assert.Zero(t, trade.Size%instrument.LotSize)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no trades for tick %d", i+1)
+		}
+	}
+}
+
+func TestPriceOscillationService_ComputesIndicesFromConstituents(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Random: simulation.NewRandom(11),
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"MEGA7": true})
+	_, trades := priceOscillationService.SubscribeTrades(map[model.Symbol]bool{"MEGA7": true})
+
+	for i := 0; i < 5; i++ {
+		// Act
+		priceOscillationService.updatePrices()
+		update := receiveUpdate(t, updates)
+
+		// Assert: the level follows the constituents, which moved without a subscriber
+		level, err := assetDataService.IndexLevel("MEGA7")
+		require.NoError(t, err)
+		require.Contains(t, update.Quotes, "MEGA7")
+		assert.Equal(t, level.Round(2).String(), update.Quotes["MEGA7"].CurrentPrice.String())
+	}
+
+	apple, _ := priceOscillationService.Quote("AAPL")
+	initialApple, _ := service.NewAssetDataService(time.Now()).GetAssetBySymbol("AAPL")
+	assert.NotEqual(t, initialApple.CurrentPrice.String(), apple.CurrentPrice.String())
+	select {
+	case update := <-trades:
+		assert.Empty(t, update.Trades["MEGA7"], "indices are not traded")
+	default:
+	}
+}
+
+func TestPriceOscillationService_PricesETFsAtTheirNAV(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Random: simulation.NewRandom(3),
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"XLK": true})
+	_, trades := priceOscillationService.SubscribeTrades(map[model.Symbol]bool{"XLK": true})
+
+	traded := false
+	for i := 0; i < 5; i++ {
+		// Act
+		priceOscillationService.updatePrices()
+		update := receiveUpdate(t, updates)
+
+		// Assert: the ETF trades at the value of its holdings
+		nav, err := assetDataService.IndexLevel("XLK")
+		require.NoError(t, err)
+		require.Contains(t, update.Quotes, "XLK")
+		etf := update.Quotes["XLK"]
+		assert.Equal(t, nav.Round(4).String(), etf.NAV.String())
+		assert.Equal(t, nav.Round(2).String(), etf.CurrentPrice.String())
+
+		tradeUpdate := receiveUpdate(t, trades)
+		traded = traded || len(tradeUpdate.Trades["XLK"]) > 0
+	}
+
+	assert.True(t, traded, "ETFs with holdings keep trading")
+}
+
+func TestPriceOscillationService_PricesBondsFromYield(t *testing.T) {
+	// Arrange
+	clock := simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+	priceOscillationService := NewPriceOscillationServiceWithOptions(service.NewAssetDataService(time.Now()), PriceOscillationOptions{
+		Clock:  clock,
+		Random: simulation.NewRandom(5),
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"UST10Y": true})
+	initial, _ := priceOscillationService.Quote("UST10Y")
+
+	for i := 0; i < 10; i++ {
+		// Act
+		clock.Advance(DefaultTickInterval)
+		priceOscillationService.updatePrices()
+		update := receiveUpdate(t, updates)
+
+		// Assert
+		bond := update.Quotes["UST10Y"]
+		assert.Equal(t, bond.Bond.Yield(bond.CurrentPrice, bond.LastUpdated).String(), bond.Yield.String())
+		if bond.CurrentPrice.GreaterThan(initial.BasePrice) {
+			assert.True(t, bond.Yield.LessThanOrEqual(initial.Bond.Yield(initial.BasePrice, bond.LastUpdated)))
+		}
+		assert.True(t, bond.Yield.Sub(decimal.RequireFromString("4.3")).Abs().LessThan(decimal.RequireFromString("0.5")), bond.Yield.String())
+	}
+}
+
+func TestPriceOscillationService_TradesCryptoAroundTheClock(t *testing.T) {
+	// Arrange: Sunday afternoon, US equities and FX are closed
+	clock := simulation.NewVirtualClock(time.Date(2026, 3, 8, 15, 0, 0, 0, time.UTC))
+	priceOscillationService := NewPriceOscillationServiceWithOptions(service.NewAssetDataService(time.Now()), PriceOscillationOptions{
+		MarketHours: service.NewDefaultMarketHoursService(),
+		Clock:       clock,
+	})
+	defer priceOscillationService.Stop()
+
+	_, updates := priceOscillationService.Subscribe(map[model.Symbol]bool{"BTC-USD": true, "AAPL": true, "EURUSD": true})
+
+	// Act
+	priceOscillationService.updatePrices()
+	update := receiveUpdate(t, updates)
+
+	// Assert
+	assert.Contains(t, update.Quotes, "BTC-USD")
+	assert.NotContains(t, update.Quotes, "AAPL")
+	assert.NotContains(t, update.Quotes, "EURUSD")
+	assert.Equal(t, "CRYPTO", priceOscillationService.MarketStatus("BTC-USD").Exchange)
+}
+
+func TestPriceOscillationService_PublishesCorporateActions(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	actionRepository := &MockCorporateActionRepository{}
+	schedule := newTestSchedule(t)
+	afterClose := time.Date(2026, 3, 10, 16, 1, 0, 0, schedule.Location)
+	rename := model.CorporateAction{ID: 5, Symbol: "NFLX", Type: model.CorporateActionSymbolChange, NewSymbol: "NFLY",
+		ExDate: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)}
+
+	closeRepository.On("SaveSessionCloses", mock.Anything).Return(nil)
+	actionRepository.On("GetPendingCorporateActions", mock.Anything).Return([]model.CorporateAction{rename}, nil)
+	actionRepository.On("ApplyCorporateAction", mock.Anything, mock.Anything).Return(nil)
+
+	priceOscillationService := NewPriceOscillationServiceWithOptions(assetDataService, PriceOscillationOptions{
+		Sessions: NewSessionRolloverService(assetDataService, closeRepository, actionRepository, schedule),
+	})
+	defer priceOscillationService.Stop()
+
+	_, oldSymbolUpdates := priceOscillationService.Subscribe(map[model.Symbol]bool{"NFLX": true})
+	_, newSymbolUpdates := priceOscillationService.Subscribe(map[model.Symbol]bool{"NFLY": true})
+
+	// Act
+	priceOscillationService.rollSession(afterClose)
+
+	// Assert
+	oldSymbolUpdate := receiveUpdate(t, oldSymbolUpdates)
+	require.Len(t, oldSymbolUpdate.CorporateActions["NFLX"], 1)
+	assert.Equal(t, "NFLY", oldSymbolUpdate.CorporateActions["NFLX"][0].NewSymbol)
+	assert.Empty(t, oldSymbolUpdate.Quotes)
+
+	newSymbolUpdate := receiveUpdate(t, newSymbolUpdates)
+	assert.Len(t, newSymbolUpdate.CorporateActions["NFLY"], 1)
+	assert.Equal(t, "NFLY", newSymbolUpdate.Quotes["NFLY"].Symbol)
+}
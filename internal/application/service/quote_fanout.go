@@ -0,0 +1,361 @@
+package service
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+)
+
+const (
+	// DefaultFanoutShards is the number of independently locked partitions of the symbol index
+	DefaultFanoutShards = 64
+
+	// maxConflatedTrades is how many trades of one symbol a subscriber keeps while it is
+	// behind; older trades are dropped
+	maxConflatedTrades = 1000
+)
+
+// MarketUpdate is what a stream subscriber receives for one publish: the new quotes, the
+// market status changes, the new order books, the trades and the corporate actions of its
+// symbols, each keyed by symbol. A symbol change is keyed by both its old and new symbol.
+type MarketUpdate struct {
+	Quotes           QuoteSnapshot
+	Statuses         map[string]model.SymbolMarketStatus
+	Books            map[string]model.OrderBook
+	Trades           map[string][]model.Trade
+	CorporateActions map[string][]model.CorporateAction
+}
+
+func (u MarketUpdate) IsEmpty() bool {
+	return len(u.Quotes) == 0 && len(u.Statuses) == 0 && len(u.Books) == 0 && len(u.Trades) == 0 &&
+		len(u.CorporateActions) == 0
+}
+
+// symbols returns every symbol the update carries data for
+func (u MarketUpdate) symbols() map[string]bool {
+	symbols := make(map[string]bool, len(u.Quotes)+len(u.Statuses)+len(u.Books)+len(u.Trades)+len(u.CorporateActions))
+	for symbol := range u.Quotes {
+		symbols[symbol] = true
+	}
+	for symbol := range u.Statuses {
+		symbols[symbol] = true
+	}
+	for symbol := range u.Books {
+		symbols[symbol] = true
+	}
+	for symbol := range u.Trades {
+		symbols[symbol] = true
+	}
+	for symbol := range u.CorporateActions {
+		symbols[symbol] = true
+	}
+	return symbols
+}
+
+// mergeSymbol copies the data of one symbol from source, replacing older values. Trades and
+// corporate actions are appended instead, so a conflated update still carries every trade up
+// to maxConflatedTrades and every action.
+func (u *MarketUpdate) mergeSymbol(symbol string, source MarketUpdate) {
+	if quote, exists := source.Quotes[symbol]; exists {
+		if u.Quotes == nil {
+			u.Quotes = make(QuoteSnapshot)
+		}
+		u.Quotes[symbol] = quote
+	}
+	if marketStatus, exists := source.Statuses[symbol]; exists {
+		if u.Statuses == nil {
+			u.Statuses = make(map[string]model.SymbolMarketStatus)
+		}
+		u.Statuses[symbol] = marketStatus
+	}
+	if book, exists := source.Books[symbol]; exists {
+		if u.Books == nil {
+			u.Books = make(map[string]model.OrderBook)
+		}
+		u.Books[symbol] = book
+	}
+	if trades, exists := source.Trades[symbol]; exists {
+		if u.Trades == nil {
+			u.Trades = make(map[string][]model.Trade)
+		}
+		merged := append(u.Trades[symbol], trades...)
+		if len(merged) > maxConflatedTrades {
+			merged = merged[len(merged)-maxConflatedTrades:]
+		}
+		u.Trades[symbol] = merged
+	}
+	if actions, exists := source.CorporateActions[symbol]; exists {
+		if u.CorporateActions == nil {
+			u.CorporateActions = make(map[string][]model.CorporateAction)
+		}
+		u.CorporateActions[symbol] = append(u.CorporateActions[symbol], actions...)
+	}
+}
+
+// Subscriber receives the updates of its symbols through a dedicated writer goroutine and an
+// unbuffered channel. Updates published while the consumer is behind are conflated: only the
+// latest quote, status and book of each symbol is kept and trades are accumulated, so a slow
+// consumer never blocks the publisher and never falls behind by more than the update being
+// handed to it and one pending update per symbol.
+type Subscriber struct {
+	id      string
+	symbols []string
+	out     chan MarketUpdate
+	wake    chan struct{}
+	done    chan struct{}
+
+	mu        sync.Mutex
+	pending   MarketUpdate
+	stagedSeq uint64
+}
+
+func newSubscriber(id string, symbols []string) *Subscriber {
+	subscriber := &Subscriber{
+		id:      id,
+		symbols: symbols,
+		out:     make(chan MarketUpdate),
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go subscriber.writeLoop()
+	return subscriber
+}
+
+// stage adds the data of one symbol to the next update. It returns true the first time the
+// subscriber is staged for the publish identified by seq, so the publisher wakes it only once.
+func (s *Subscriber) stage(seq uint64, symbol string, update MarketUpdate) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending.mergeSymbol(symbol, update)
+
+	first := s.stagedSeq != seq
+	s.stagedSeq = seq
+	return first
+}
+
+func (s *Subscriber) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Subscriber) writeLoop() {
+	defer close(s.out)
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.wake:
+		}
+
+		s.mu.Lock()
+		update := s.pending
+		s.pending = MarketUpdate{}
+		s.mu.Unlock()
+
+		if update.IsEmpty() {
+			continue
+		}
+
+		select {
+		case s.out <- update:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+type fanoutShard struct {
+	mu    sync.RWMutex
+	index map[string]map[string]*Subscriber
+}
+
+// QuoteFanout delivers market updates to subscribers through a symbol-indexed,
+// sharded subscription table. Publishing only visits the subscribers of the updated
+// symbols, and shards are locked independently so subscriptions on unrelated symbols
+// do not contend with each other or with the publisher.
+type QuoteFanout struct {
+	shards []*fanoutShard
+
+	mu          sync.Mutex
+	subscribers map[string]*Subscriber
+	publishSeq  uint64
+}
+
+func NewQuoteFanout(shardCount int) *QuoteFanout {
+	if shardCount <= 0 {
+		shardCount = DefaultFanoutShards
+	}
+
+	shards := make([]*fanoutShard, shardCount)
+	for i := range shards {
+		shards[i] = &fanoutShard{index: make(map[string]map[string]*Subscriber)}
+	}
+
+	return &QuoteFanout{
+		shards:      shards,
+		subscribers: make(map[string]*Subscriber),
+	}
+}
+
+// Subscribe registers a subscriber for the symbols and returns the channel its updates
+// are delivered on. The channel is closed by Unsubscribe or Close.
+func (f *QuoteFanout) Subscribe(id string, symbols []string) <-chan MarketUpdate {
+	subscriber := newSubscriber(id, symbols)
+
+	// The subscriber is indexed in the same critical section that registers it, so a
+	// concurrent Unsubscribe or Close always finds it in every shard it removes it from
+	f.mu.Lock()
+	previous := f.subscribers[id]
+	f.subscribers[id] = subscriber
+	f.index(subscriber)
+	f.mu.Unlock()
+
+	if previous != nil {
+		f.remove(previous)
+	}
+
+	return subscriber.out
+}
+
+// Unsubscribe removes the subscriber and closes its channel. It reports whether the
+// subscriber existed.
+func (f *QuoteFanout) Unsubscribe(id string) bool {
+	f.mu.Lock()
+	subscriber, exists := f.subscribers[id]
+	delete(f.subscribers, id)
+	f.mu.Unlock()
+
+	if !exists {
+		return false
+	}
+
+	f.remove(subscriber)
+	return true
+}
+
+// Close removes every subscriber and closes their channels
+func (f *QuoteFanout) Close() {
+	f.mu.Lock()
+	subscribers := f.subscribers
+	f.subscribers = make(map[string]*Subscriber)
+	f.mu.Unlock()
+
+	for _, subscriber := range subscribers {
+		f.remove(subscriber)
+	}
+}
+
+// Publish stages the data of every symbol of the update on the subscribers of that symbol,
+// then wakes each affected subscriber once. Shards are processed in parallel.
+func (f *QuoteFanout) Publish(update MarketUpdate) {
+	if update.IsEmpty() {
+		return
+	}
+
+	f.mu.Lock()
+	f.publishSeq++
+	seq := f.publishSeq
+	f.mu.Unlock()
+
+	symbolsByShard := make(map[*fanoutShard][]string)
+	for symbol := range update.symbols() {
+		shard := f.shardFor(symbol)
+		symbolsByShard[shard] = append(symbolsByShard[shard], symbol)
+	}
+
+	staged := make([][]*Subscriber, 0, len(symbolsByShard))
+	var stagedMu sync.Mutex
+	var wg sync.WaitGroup
+
+	for shard, symbols := range symbolsByShard {
+		wg.Add(1)
+		go func(shard *fanoutShard, symbols []string) {
+			defer wg.Done()
+
+			var woken []*Subscriber
+			shard.mu.RLock()
+			for _, symbol := range symbols {
+				for _, subscriber := range shard.index[symbol] {
+					if subscriber.stage(seq, symbol, update) {
+						woken = append(woken, subscriber)
+					}
+				}
+			}
+			shard.mu.RUnlock()
+
+			stagedMu.Lock()
+			staged = append(staged, woken)
+			stagedMu.Unlock()
+		}(shard, symbols)
+	}
+	wg.Wait()
+
+	// Wake subscribers only after every shard has staged its data, so an update always
+	// holds the whole publish for the subscriber's symbols
+	for _, subscribers := range staged {
+		for _, subscriber := range subscribers {
+			subscriber.notify()
+		}
+	}
+}
+
+// ActiveSymbols returns the symbols that have at least one subscriber
+func (f *QuoteFanout) ActiveSymbols() []string {
+	var symbols []string
+	for _, shard := range f.shards {
+		shard.mu.RLock()
+		for symbol := range shard.index {
+			symbols = append(symbols, symbol)
+		}
+		shard.mu.RUnlock()
+	}
+	return symbols
+}
+
+func (f *QuoteFanout) SubscriberCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.subscribers)
+}
+
+func (f *QuoteFanout) index(subscriber *Subscriber) {
+	for _, symbol := range subscriber.symbols {
+		shard := f.shardFor(symbol)
+		shard.mu.Lock()
+		subscribers, exists := shard.index[symbol]
+		if !exists {
+			subscribers = make(map[string]*Subscriber)
+			shard.index[symbol] = subscribers
+		}
+		subscribers[subscriber.id] = subscriber
+		shard.mu.Unlock()
+	}
+}
+
+func (f *QuoteFanout) remove(subscriber *Subscriber) {
+	for _, symbol := range subscriber.symbols {
+		shard := f.shardFor(symbol)
+		shard.mu.Lock()
+		if subscribers, exists := shard.index[symbol]; exists && subscribers[subscriber.id] == subscriber {
+			delete(subscribers, subscriber.id)
+			if len(subscribers) == 0 {
+				delete(shard.index, symbol)
+			}
+		}
+		shard.mu.Unlock()
+	}
+
+	close(subscriber.done)
+}
+
+func (f *QuoteFanout) shardFor(symbol string) *fanoutShard {
+	hash := fnv.New32a()
+	hash.Write([]byte(symbol))
+	return f.shards[hash.Sum32()%uint32(len(f.shards))]
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQuote(symbol string, price string) model.AssetQuote {
+	quote := model.NewAssetQuote(symbol, symbol, model.AssetClassStock, decimal.RequireFromString("100"), 0, 0, time.Now())
+	return quote.WithPrice(decimal.RequireFromString(price), time.Now())
+}
+
+func receiveUpdate(t *testing.T, updates <-chan MarketUpdate) MarketUpdate {
+	t.Helper()
+
+	select {
+	case update, ok := <-updates:
+		require.True(t, ok, "channel closed")
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("no update delivered")
+		return MarketUpdate{}
+	}
+}
+
+func TestQuoteFanout_DeliversOnlySubscribedSymbols(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	defer fanout.Close()
+
+	appleQuotes := fanout.Subscribe("apple", []string{"AAPL"})
+	techQuotes := fanout.Subscribe("tech", []string{"AAPL", "MSFT"})
+	etfQuotes := fanout.Subscribe("etf", []string{"SPY"})
+
+	// Act
+	fanout.Publish(MarketUpdate{
+		Quotes: QuoteSnapshot{
+			"AAPL": newTestQuote("AAPL", "101"),
+			"MSFT": newTestQuote("MSFT", "99"),
+		},
+		Statuses: map[string]model.SymbolMarketStatus{
+			"MSFT": {Symbol: "MSFT", Status: model.MarketStatusClosed},
+		},
+	})
+
+	// Assert
+	appleUpdate := receiveUpdate(t, appleQuotes)
+	assert.Len(t, appleUpdate.Quotes, 1)
+	assert.Contains(t, appleUpdate.Quotes, "AAPL")
+	assert.Empty(t, appleUpdate.Statuses)
+
+	techUpdate := receiveUpdate(t, techQuotes)
+	assert.Len(t, techUpdate.Quotes, 2)
+	assert.Equal(t, model.MarketStatusClosed, techUpdate.Statuses["MSFT"].Status)
+
+	select {
+	case <-etfQuotes:
+		t.Fatal("subscriber received quotes for symbols it did not subscribe to")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestQuoteFanout_ConflatesSlowSubscriber(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	defer fanout.Close()
+
+	quotes := fanout.Subscribe("slow", []string{"AAPL"})
+
+	// Act: publish many snapshots without reading any
+	const publishes = 64
+	for i := 1; i <= publishes; i++ {
+		fanout.Publish(MarketUpdate{Quotes: QuoteSnapshot{"AAPL": newTestQuote("AAPL", fmt.Sprintf("%d", 100+i))}})
+	}
+
+	// Assert: the publisher never blocked, and the subscriber gets at most the update its
+	// writer was holding before the latest price
+	var last model.AssetQuote
+	received := 0
+	deadline := time.After(time.Second)
+	for !last.CurrentPrice.Equal(decimal.NewFromInt(100 + publishes)) {
+		select {
+		case update := <-quotes:
+			last = update.Quotes["AAPL"]
+			received++
+		case <-deadline:
+			t.Fatalf("latest quote not delivered, last price %s", last.CurrentPrice)
+		}
+	}
+	assert.LessOrEqual(t, received, 2)
+}
+
+func TestQuoteFanout_KeepsEveryTradeOfSlowSubscriber(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	defer fanout.Close()
+
+	trades := fanout.Subscribe("slow", []string{"AAPL"})
+
+	// Act: publish many trades without reading any
+	const publishes = 64
+	for i := 1; i <= publishes; i++ {
+		fanout.Publish(MarketUpdate{Trades: map[string][]model.Trade{"AAPL": {{ID: uint64(i), Symbol: "AAPL"}}}})
+	}
+
+	// Assert: conflation merges the trades instead of dropping them
+	var received []uint64
+	deadline := time.After(time.Second)
+	for len(received) < publishes {
+		select {
+		case update := <-trades:
+			for _, trade := range update.Trades["AAPL"] {
+				received = append(received, trade.ID)
+			}
+		case <-deadline:
+			t.Fatalf("received %d of %d trades", len(received), publishes)
+		}
+	}
+	for i, id := range received {
+		assert.Equal(t, uint64(i+1), id)
+	}
+}
+
+func TestQuoteFanout_UnsubscribeClosesChannel(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	quotes := fanout.Subscribe("subscriber", []string{"AAPL", "MSFT"})
+
+	// Act
+	removed := fanout.Unsubscribe("subscriber")
+
+	// Assert
+	assert.True(t, removed)
+	assert.False(t, fanout.Unsubscribe("subscriber"))
+	assert.Zero(t, fanout.SubscriberCount())
+	assert.Empty(t, fanout.ActiveSymbols())
+
+	select {
+	case _, ok := <-quotes:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed")
+	}
+}
+
+func TestQuoteFanout_ResubscribeReplacesSymbols(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	defer fanout.Close()
+
+	oldQuotes := fanout.Subscribe("subscriber", []string{"AAPL"})
+
+	// Act
+	newQuotes := fanout.Subscribe("subscriber", []string{"MSFT"})
+	fanout.Publish(MarketUpdate{Quotes: QuoteSnapshot{"MSFT": newTestQuote("MSFT", "99")}})
+
+	// Assert
+	_, ok := <-oldQuotes
+	assert.False(t, ok)
+	assert.Equal(t, []string{"MSFT"}, fanout.ActiveSymbols())
+	assert.Contains(t, receiveUpdate(t, newQuotes).Quotes, "MSFT")
+}
+
+func TestQuoteFanout_ConcurrentSubscribeAndUnsubscribe(t *testing.T) {
+	// Arrange
+	fanout := NewQuoteFanout(4)
+	defer fanout.Close()
+
+	symbols := make([]string, 20)
+	for i := range symbols {
+		symbols[i] = fmt.Sprintf("SYM%d", i)
+	}
+
+	// Act: each subscription races its replacement and its unsubscribe
+	var wg sync.WaitGroup
+	for i := 0; i < 500; i++ {
+		id := fmt.Sprintf("subscriber-%d", i)
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			fanout.Subscribe(id, symbols)
+		}()
+		go func() {
+			defer wg.Done()
+			fanout.Subscribe(id, symbols[:5])
+		}()
+		go func() {
+			defer wg.Done()
+			fanout.Unsubscribe(id)
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < 500; i++ {
+		fanout.Unsubscribe(fmt.Sprintf("subscriber-%d", i))
+	}
+
+	// Assert: no removed subscriber is left in the symbol index
+	assert.Zero(t, fanout.SubscriberCount())
+	assert.Empty(t, fanout.ActiveSymbols())
+}
+
+// BenchmarkQuoteFanout_Publish measures one tick delivered to every subscriber, from
+// Publish until each consumer has read its snapshot
+func BenchmarkQuoteFanout_Publish(b *testing.B) {
+	symbols := make([]string, 100)
+	snapshot := make(QuoteSnapshot, len(symbols))
+	for i := range symbols {
+		symbols[i] = fmt.Sprintf("SYM%d", i)
+		snapshot[symbols[i]] = newTestQuote(symbols[i], "101")
+	}
+
+	for _, subscriberCount := range []int{1000, 10000, 50000} {
+		b.Run(fmt.Sprintf("subscribers=%d", subscriberCount), func(b *testing.B) {
+			fanout := NewQuoteFanout(DefaultFanoutShards)
+			defer fanout.Close()
+
+			var delivered sync.WaitGroup
+			for i := 0; i < subscriberCount; i++ {
+				// Each subscriber follows a window of five symbols
+				start := i % (len(symbols) - 5)
+				quotes := fanout.Subscribe(fmt.Sprintf("subscriber-%d", i), symbols[start:start+5])
+				go func() {
+					for range quotes {
+						delivered.Done()
+					}
+				}()
+			}
+
+			var slowest time.Duration
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				started := time.Now()
+				delivered.Add(subscriberCount)
+				fanout.Publish(MarketUpdate{Quotes: snapshot})
+				delivered.Wait()
+
+				if elapsed := time.Since(started); elapsed > slowest {
+					slowest = elapsed
+				}
+			}
+			b.StopTimer()
+
+			b.ReportMetric(float64(slowest.Microseconds())/1000, "max-ms/tick")
+			if slowest > DefaultTickInterval {
+				b.Errorf("fan-out to %d subscribers took %s, longer than the %s tick interval",
+					subscriberCount, slowest, DefaultTickInterval)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+)
+
+// DefaultStateSaveInterval is how often the simulator state is saved while running
+const DefaultStateSaveInterval = time.Minute
+
+// QuoteStateService saves the simulated prices and session statistics of every asset and
+// resumes them on startup, so a restart does not reset prices to their initial values
+type QuoteStateService struct {
+	assetDataService *service.AssetDataService
+	stateRepository  repository.IQuoteStateRepository
+}
+
+func NewQuoteStateService(
+	assetDataService *service.AssetDataService,
+	stateRepository repository.IQuoteStateRepository,
+) *QuoteStateService {
+	return &QuoteStateService{
+		assetDataService: assetDataService,
+		stateRepository:  stateRepository,
+	}
+}
+
+// Save persists the current state of every asset
+func (s *QuoteStateService) Save() error {
+	assets := s.assetDataService.GetAllAssets()
+
+	states := make([]model.QuoteState, 0, len(assets))
+	for _, quote := range assets {
+		states = append(states, quote.State())
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Symbol < states[j].Symbol })
+
+	return s.stateRepository.SaveQuoteStates(states)
+}
+
+// Restore resumes every asset from its saved state and returns how many were resumed. It
+// runs after the sessions are restored, so states saved in an earlier session are closed
+// at their last price. Saved symbols that are no longer simulated are ignored.
+func (s *QuoteStateService) Restore() (int, error) {
+	states, err := s.stateRepository.GetQuoteStates()
+	if err != nil {
+		return 0, err
+	}
+
+	restored := 0
+	for _, state := range states {
+		if _, exists := s.assetDataService.Update(state.Symbol, func(quote model.AssetQuote) model.AssetQuote {
+			return quote.WithState(state)
+		}); exists {
+			restored++
+		}
+	}
+
+	log.Printf("Restored the simulated state of %d of %d saved symbols", restored, len(states))
+	return restored, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockQuoteStateRepository struct {
+	mock.Mock
+}
+
+func (m *MockQuoteStateRepository) SaveQuoteStates(states []model.QuoteState) error {
+	args := m.Called(states)
+	return args.Error(0)
+}
+
+func (m *MockQuoteStateRepository) GetQuoteStates() ([]model.QuoteState, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.QuoteState), args.Error(1)
+}
+
+func TestQuoteStateService_Save(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	stateRepository := &MockQuoteStateRepository{}
+	stateService := NewQuoteStateService(assetDataService, stateRepository)
+
+	var saved []model.QuoteState
+	stateRepository.On("SaveQuoteStates", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).([]model.QuoteState)
+	}).Return(nil)
+
+	// Act
+	err := stateService.Save()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, saved, len(assetDataService.GetAllAssets()))
+	for i := 1; i < len(saved); i++ {
+		assert.Less(t, saved[i-1].Symbol, saved[i].Symbol)
+	}
+}
+
+func TestQuoteStateService_Restore(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	stateRepository := &MockQuoteStateRepository{}
+	stateService := NewQuoteStateService(assetDataService, stateRepository)
+
+	apple, exists := assetDataService.GetAssetBySymbol("AAPL")
+	require.True(t, exists)
+	state := apple.State()
+	state.CurrentPrice = decimal.RequireFromString("321.45")
+	state.BasePrice = decimal.RequireFromString("320")
+
+	stateRepository.On("GetQuoteStates").Return([]model.QuoteState{
+		state,
+		{Symbol: "DELISTED", CurrentPrice: decimal.NewFromInt(1)},
+	}, nil)
+
+	// Act
+	restored, err := stateService.Restore()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, restored)
+	apple, _ = assetDataService.GetAssetBySymbol("AAPL")
+	assert.Equal(t, "321.45", model.FormatPrice(apple.CurrentPrice, apple.PricePrecision))
+	assert.Equal(t, "320.00", model.FormatPrice(apple.BasePrice, apple.PricePrecision))
+	_, exists = assetDataService.GetAssetBySymbol("DELISTED")
+	assert.False(t, exists)
+}
+
+func TestQuoteStateService_Restore_RepositoryError(t *testing.T) {
+	// Arrange
+	stateRepository := &MockQuoteStateRepository{}
+	stateService := NewQuoteStateService(service.NewAssetDataService(time.Now()), stateRepository)
+	stateRepository.On("GetQuoteStates").Return(nil, errors.New("connection refused"))
+
+	// Act
+	restored, err := stateService.Restore()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, 0, restored)
+}
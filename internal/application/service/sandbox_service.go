@@ -0,0 +1,222 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+)
+
+const (
+	// DefaultSandboxIdleTimeout is how long a sandbox without requests or streams is kept
+	DefaultSandboxIdleTimeout = 30 * time.Minute
+
+	// DefaultMaxSandboxes is how many sandboxes can run at the same time
+	DefaultMaxSandboxes = 20
+)
+
+var (
+	ErrInvalidSandboxName = errors.New("invalid sandbox name")
+	ErrSandboxExists      = errors.New("sandbox already exists")
+	ErrUnknownSandbox     = errors.New("unknown sandbox")
+	ErrTooManySandboxes   = errors.New("too many sandboxes")
+)
+
+var sandboxNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// SandboxEngineFactory builds the engine of a new sandbox, with its own assets and
+// collaborators, drawing from random. The engine is started by the SandboxService.
+type SandboxEngineFactory func(random *simulation.Random) (*PriceOscillationService, error)
+
+// Sandbox describes an isolated simulation. Owner is the client that created it, empty when
+// clients are not authenticated.
+type Sandbox struct {
+	Name      string
+	Owner     string
+	Seed      int64
+	CreatedAt time.Time
+	LastUsed  time.Time
+	ExpiresAt time.Time
+}
+
+type sandbox struct {
+	info   Sandbox
+	engine *PriceOscillationService
+}
+
+// SandboxService runs named simulations isolated from the shared one, so a scenario or a
+// halt in one sandbox is only seen by the clients that select it. A sandbox is only visible
+// to its owner: to the other clients it is unknown. Sandboxes that are not used for the idle
+// timeout and have no open stream are destroyed by ExpireIdle.
+type SandboxService struct {
+	newEngine    SandboxEngineFactory
+	idleTimeout  time.Duration
+	maxSandboxes int
+	clock        simulation.Clock
+
+	mu        sync.Mutex
+	sandboxes map[string]*sandbox
+}
+
+func NewSandboxService(
+	newEngine SandboxEngineFactory,
+	idleTimeout time.Duration,
+	maxSandboxes int,
+	clock simulation.Clock,
+) *SandboxService {
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultSandboxIdleTimeout
+	}
+	if maxSandboxes <= 0 {
+		maxSandboxes = DefaultMaxSandboxes
+	}
+	if clock == nil {
+		clock = simulation.SystemClock{}
+	}
+
+	return &SandboxService{
+		newEngine:    newEngine,
+		idleTimeout:  idleTimeout,
+		maxSandboxes: maxSandboxes,
+		clock:        clock,
+		sandboxes:    make(map[string]*sandbox),
+	}
+}
+
+// Create starts a sandbox of owner simulated with seed, or with a time seeded source when
+// seed is 0
+func (s *SandboxService) Create(name, owner string, seed int64) (Sandbox, error) {
+	if !sandboxNamePattern.MatchString(name) {
+		return Sandbox{}, fmt.Errorf("%w: %q must be 1 to 64 letters, digits, '-' or '_'", ErrInvalidSandboxName, name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.sandboxes[name]; exists {
+		return Sandbox{}, ErrSandboxExists
+	}
+	if len(s.sandboxes) >= s.maxSandboxes {
+		return Sandbox{}, fmt.Errorf("%w: at most %d can run", ErrTooManySandboxes, s.maxSandboxes)
+	}
+
+	random := simulation.NewTimeSeededRandom()
+	if seed != 0 {
+		random = simulation.NewRandom(seed)
+	}
+
+	engine, err := s.newEngine(random)
+	if err != nil {
+		return Sandbox{}, err
+	}
+	engine.Start()
+
+	now := s.clock.Now()
+	created := &sandbox{
+		info:   Sandbox{Name: name, Owner: owner, Seed: seed, CreatedAt: now, LastUsed: now},
+		engine: engine,
+	}
+	s.sandboxes[name] = created
+
+	log.Printf("Created sandbox %s. Sandboxes: %d", name, len(s.sandboxes))
+	return s.describe(created), nil
+}
+
+// Destroy stops the sandbox of owner; its open streams end
+func (s *SandboxService) Destroy(name, owner string) (Sandbox, error) {
+	s.mu.Lock()
+	destroyed, exists := s.sandboxes[name]
+	exists = exists && destroyed.info.Owner == owner
+	if exists {
+		delete(s.sandboxes, name)
+	}
+	s.mu.Unlock()
+
+	if !exists {
+		return Sandbox{}, ErrUnknownSandbox
+	}
+
+	destroyed.engine.Stop()
+	log.Printf("Destroyed sandbox %s", name)
+	return s.describe(destroyed), nil
+}
+
+// DestroyAll stops the sandboxes of every owner, on shutdown
+func (s *SandboxService) DestroyAll() {
+	s.mu.Lock()
+	destroyed := s.sandboxes
+	s.sandboxes = make(map[string]*sandbox)
+	s.mu.Unlock()
+
+	for name, sandbox := range destroyed {
+		sandbox.engine.Stop()
+		log.Printf("Destroyed sandbox %s", name)
+	}
+}
+
+// Engine returns the engine of the sandbox of owner and marks the sandbox as used
+func (s *SandboxService) Engine(name, owner string) (*PriceOscillationService, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sandbox, exists := s.sandboxes[name]
+	if !exists || sandbox.info.Owner != owner {
+		return nil, ErrUnknownSandbox
+	}
+
+	sandbox.info.LastUsed = s.clock.Now()
+	return sandbox.engine, nil
+}
+
+// List returns the running sandboxes of owner, by name
+func (s *SandboxService) List(owner string) []Sandbox {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sandboxes := make([]Sandbox, 0, len(s.sandboxes))
+	for _, sandbox := range s.sandboxes {
+		if sandbox.info.Owner == owner {
+			sandboxes = append(sandboxes, s.describe(sandbox))
+		}
+	}
+	sort.Slice(sandboxes, func(i, j int) bool { return sandboxes[i].Name < sandboxes[j].Name })
+	return sandboxes
+}
+
+// ExpireIdle destroys the sandboxes idle for longer than the idle timeout at now and returns
+// their names. A sandbox with an open stream is in use and never expires.
+func (s *SandboxService) ExpireIdle(now time.Time) []string {
+	s.mu.Lock()
+	var expired []*sandbox
+	for name, sandbox := range s.sandboxes {
+		if sandbox.engine.SubscriberCount() > 0 {
+			sandbox.info.LastUsed = now
+			continue
+		}
+		if now.Sub(sandbox.info.LastUsed) >= s.idleTimeout {
+			expired = append(expired, sandbox)
+			delete(s.sandboxes, name)
+		}
+	}
+	s.mu.Unlock()
+
+	names := make([]string, 0, len(expired))
+	for _, sandbox := range expired {
+		sandbox.engine.Stop()
+		log.Printf("Sandbox %s expired after %s idle", sandbox.info.Name, s.idleTimeout)
+		names = append(names, sandbox.info.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *SandboxService) describe(sandbox *sandbox) Sandbox {
+	info := sandbox.info
+	info.ExpiresAt = info.LastUsed.Add(s.idleTimeout)
+	return info
+}
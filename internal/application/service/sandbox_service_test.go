@@ -0,0 +1,147 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSandboxService(t *testing.T, clock simulation.Clock, maxSandboxes int) *SandboxService {
+	sandboxes := NewSandboxService(func(random *simulation.Random) (*PriceOscillationService, error) {
+		return NewPriceOscillationServiceWithOptions(service.NewAssetDataService(time.Now()), PriceOscillationOptions{Random: random}), nil
+	}, time.Hour, maxSandboxes, clock)
+	t.Cleanup(sandboxes.DestroyAll)
+	return sandboxes
+}
+
+func TestSandboxService_IsolatesSandboxes(t *testing.T) {
+	// Arrange
+	sandboxes := newTestSandboxService(t, nil, 5)
+	_, err := sandboxes.Create("alice", "qa", 42)
+	require.NoError(t, err)
+	_, err = sandboxes.Create("bob", "qa", 0)
+	require.NoError(t, err)
+
+	alice, err := sandboxes.Engine("alice", "qa")
+	require.NoError(t, err)
+	bob, err := sandboxes.Engine("bob", "qa")
+	require.NoError(t, err)
+
+	// Act
+	_, haltErr := alice.HaltSymbol("AAPL", "QA")
+
+	// Assert
+	require.NoError(t, haltErr)
+	assert.Equal(t, model.MarketStatusHalted, alice.MarketStatus("AAPL").Status)
+	assert.Equal(t, model.MarketStatusOpen, bob.MarketStatus("AAPL").Status)
+
+	list := sandboxes.List("qa")
+	require.Len(t, list, 2)
+	assert.Equal(t, "alice", list[0].Name)
+	assert.Equal(t, int64(42), list[0].Seed)
+	assert.Equal(t, "bob", list[1].Name)
+}
+
+func TestSandboxService_CreateErrors(t *testing.T) {
+	// Arrange
+	sandboxes := newTestSandboxService(t, nil, 1)
+	_, err := sandboxes.Create("alice", "qa", 0)
+	require.NoError(t, err)
+
+	// Act
+	_, existsErr := sandboxes.Create("alice", "qa", 0)
+	_, tooManyErr := sandboxes.Create("bob", "qa", 0)
+	_, invalidErr := sandboxes.Create("not valid!", "qa", 0)
+	_, emptyErr := sandboxes.Create("", "qa", 0)
+
+	// Assert
+	assert.ErrorIs(t, existsErr, ErrSandboxExists)
+	assert.ErrorIs(t, tooManyErr, ErrTooManySandboxes)
+	assert.ErrorIs(t, invalidErr, ErrInvalidSandboxName)
+	assert.ErrorIs(t, emptyErr, ErrInvalidSandboxName)
+}
+
+func TestSandboxService_Destroy(t *testing.T) {
+	// Arrange
+	sandboxes := newTestSandboxService(t, nil, 5)
+	_, err := sandboxes.Create("alice", "qa", 0)
+	require.NoError(t, err)
+
+	engine, err := sandboxes.Engine("alice", "qa")
+	require.NoError(t, err)
+	_, updates := engine.Subscribe(map[model.Symbol]bool{"AAPL": true})
+
+	// Act
+	destroyed, destroyErr := sandboxes.Destroy("alice", "qa")
+	_, engineErr := sandboxes.Engine("alice", "qa")
+	_, destroyAgainErr := sandboxes.Destroy("alice", "qa")
+
+	// Assert: the streams of the sandbox end
+	require.NoError(t, destroyErr)
+	assert.Equal(t, "alice", destroyed.Name)
+	assert.ErrorIs(t, engineErr, ErrUnknownSandbox)
+	assert.ErrorIs(t, destroyAgainErr, ErrUnknownSandbox)
+
+	_, open := <-updates
+	assert.False(t, open)
+}
+
+func TestSandboxService_ScopesSandboxesToOwner(t *testing.T) {
+	// Arrange
+	sandboxes := newTestSandboxService(t, nil, 5)
+	_, err := sandboxes.Create("alice", "qa-alice", 0)
+	require.NoError(t, err)
+
+	// Act
+	_, engineErr := sandboxes.Engine("alice", "qa-bob")
+	_, destroyErr := sandboxes.Destroy("alice", "qa-bob")
+	bobList := sandboxes.List("qa-bob")
+	_, ownerErr := sandboxes.Engine("alice", "qa-alice")
+
+	// Assert: the sandbox is unknown to other clients and survives their destroy
+	assert.ErrorIs(t, engineErr, ErrUnknownSandbox)
+	assert.ErrorIs(t, destroyErr, ErrUnknownSandbox)
+	assert.Empty(t, bobList)
+	assert.NoError(t, ownerErr)
+
+	list := sandboxes.List("qa-alice")
+	require.Len(t, list, 1)
+	assert.Equal(t, "qa-alice", list[0].Owner)
+}
+
+func TestSandboxService_ExpireIdle(t *testing.T) {
+	// Arrange
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	clock := simulation.NewVirtualClock(start)
+	sandboxes := newTestSandboxService(t, clock, 5)
+
+	for _, name := range []string{"idle", "used", "streaming"} {
+		_, err := sandboxes.Create(name, "qa", 0)
+		require.NoError(t, err)
+	}
+
+	streaming, err := sandboxes.Engine("streaming", "qa")
+	require.NoError(t, err)
+	streaming.Subscribe(map[model.Symbol]bool{"AAPL": true})
+
+	clock.Advance(45 * time.Minute)
+	_, err = sandboxes.Engine("used", "qa")
+	require.NoError(t, err)
+
+	// Act
+	notYet := sandboxes.ExpireIdle(start.Add(59 * time.Minute))
+	expired := sandboxes.ExpireIdle(start.Add(time.Hour))
+
+	// Assert
+	assert.Empty(t, notYet)
+	assert.Equal(t, []string{"idle"}, expired)
+
+	list := sandboxes.List("qa")
+	require.Len(t, list, 2)
+	assert.Equal(t, start.Add(45*time.Minute+time.Hour), list[1].ExpiresAt)
+}
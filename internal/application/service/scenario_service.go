@@ -0,0 +1,222 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+)
+
+var (
+	ErrUnknownScenario   = errors.New("unknown scenario")
+	ErrNoScenarioRunning = errors.New("no scenario running")
+)
+
+// ScenarioStatus is the progress of the running or last stopped scenario
+type ScenarioStatus struct {
+	Name          string
+	Description   string
+	StartedAt     time.Time
+	EventsApplied int
+	EventsTotal   int
+	Completed     bool
+}
+
+// ScenarioStep is what a running scenario does on one engine tick: the events that became
+// due and the share of every active drift that elapsed since the previous tick
+type ScenarioStep struct {
+	Events []model.ScenarioEvent
+	Drifts []ScenarioDrift
+}
+
+// ScenarioDrift moves the base price of its target by Percent on this tick
+type ScenarioDrift struct {
+	Target  model.ScenarioTarget
+	Percent float64
+}
+
+type scenarioEffect struct {
+	event model.ScenarioEvent
+	start time.Time
+}
+
+func (e scenarioEffect) end() time.Time {
+	return e.start.Add(e.event.Duration)
+}
+
+// ScenarioService runs one scenario at a time over the engine ticks and keeps the library
+// of named scenarios that can be started by name
+type ScenarioService struct {
+	library map[string]model.Scenario
+
+	mu           sync.Mutex
+	scenario     *model.Scenario
+	startedAt    time.Time
+	lastStep     time.Time
+	next         int
+	drifts       []scenarioEffect
+	volatilities []scenarioEffect
+}
+
+func NewScenarioService(library map[string]model.Scenario) *ScenarioService {
+	scenarios := make(map[string]model.Scenario, len(library))
+	for name, scenario := range library {
+		scenarios[name] = scenario
+	}
+
+	return &ScenarioService{library: scenarios}
+}
+
+// Names returns the names of the scenarios of the library in alphabetical order
+func (s *ScenarioService) Names() []string {
+	names := make([]string, 0, len(s.library))
+	for name := range s.library {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Named returns the scenario of the library with the given name
+func (s *ScenarioService) Named(name string) (model.Scenario, error) {
+	scenario, exists := s.library[name]
+	if !exists {
+		return model.Scenario{}, ErrUnknownScenario
+	}
+	return scenario, nil
+}
+
+// Start runs the scenario from now, replacing the running one and its active effects
+func (s *ScenarioService) Start(scenario model.Scenario, now time.Time) ScenarioStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scenario = &scenario
+	s.startedAt = now
+	s.lastStep = now
+	s.next = 0
+	s.drifts = nil
+	s.volatilities = nil
+	return s.status(now)
+}
+
+// Stop ends the running scenario. Price moves it already made are kept.
+func (s *ScenarioService) Stop(now time.Time) (ScenarioStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.scenario == nil {
+		return ScenarioStatus{}, ErrNoScenarioRunning
+	}
+
+	status := s.status(now)
+	s.scenario = nil
+	s.drifts = nil
+	s.volatilities = nil
+	return status, nil
+}
+
+// Status returns the progress of the running scenario
+func (s *ScenarioService) Status(now time.Time) (ScenarioStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.scenario == nil {
+		return ScenarioStatus{}, false
+	}
+	return s.status(now), true
+}
+
+func (s *ScenarioService) status(now time.Time) ScenarioStatus {
+	return ScenarioStatus{
+		Name:          s.scenario.Name,
+		Description:   s.scenario.Description,
+		StartedAt:     s.startedAt,
+		EventsApplied: s.next,
+		EventsTotal:   len(s.scenario.Events),
+		Completed:     now.Sub(s.startedAt) >= s.scenario.Length() && s.next == len(s.scenario.Events),
+	}
+}
+
+// Step advances the running scenario to now
+func (s *ScenarioService) Step(now time.Time) ScenarioStep {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var step ScenarioStep
+	if s.scenario == nil {
+		return step
+	}
+
+	elapsed := now.Sub(s.startedAt)
+	for s.next < len(s.scenario.Events) && s.scenario.Events[s.next].At <= elapsed {
+		event := s.scenario.Events[s.next]
+		effect := scenarioEffect{event: event, start: s.startedAt.Add(event.At)}
+		s.next++
+
+		switch event.Type {
+		case model.ScenarioEventDrift:
+			s.drifts = append(s.drifts, effect)
+		case model.ScenarioEventVolatility:
+			s.volatilities = append(s.volatilities, effect)
+		}
+		step.Events = append(step.Events, event)
+	}
+
+	activeDrifts := s.drifts[:0]
+	for _, drift := range s.drifts {
+		from, to := maxTime(s.lastStep, drift.start), minTime(now, drift.end())
+		if to.After(from) {
+			// Compounded so that the steps of a drift add up to its whole percent
+			share := to.Sub(from).Seconds() / drift.event.Duration.Seconds()
+			percent := (math.Pow(1+drift.event.Percent/100, share) - 1) * 100
+			step.Drifts = append(step.Drifts, ScenarioDrift{Target: drift.event.Target, Percent: percent})
+		}
+		if now.Before(drift.end()) {
+			activeDrifts = append(activeDrifts, drift)
+		}
+	}
+	s.drifts = activeDrifts
+
+	activeVolatilities := s.volatilities[:0]
+	for _, volatility := range s.volatilities {
+		if volatility.event.Duration == 0 || now.Before(volatility.end()) {
+			activeVolatilities = append(activeVolatilities, volatility)
+		}
+	}
+	s.volatilities = activeVolatilities
+
+	s.lastStep = now
+	return step
+}
+
+// VolatilityMultiplier is the factor the simulated move of the quote is scaled by
+func (s *ScenarioService) VolatilityMultiplier(quote model.AssetQuote, exposure model.FactorExposure) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	multiplier := 1.0
+	for _, volatility := range s.volatilities {
+		if volatility.event.Target.Weight(quote, exposure) != 0 {
+			multiplier *= volatility.event.Multiplier
+		}
+	}
+	return multiplier
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
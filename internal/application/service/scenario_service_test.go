@@ -0,0 +1,86 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testScenario() model.Scenario {
+	return model.Scenario{
+		Name: "crash",
+		Events: []model.ScenarioEvent{
+			{At: 0, Type: model.ScenarioEventShock, Percent: -5},
+			{At: 10 * time.Second, Type: model.ScenarioEventDrift, Percent: -4, Duration: 40 * time.Second},
+			{At: 10 * time.Second, Type: model.ScenarioEventVolatility, Multiplier: 3, Duration: 20 * time.Second,
+				Target: model.ScenarioTarget{Symbols: []string{"AAPL"}}},
+		},
+	}
+}
+
+func TestScenarioService_Step(t *testing.T) {
+	// Arrange
+	scenarios := NewScenarioService(nil)
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	apple := model.NewAssetQuote("AAPL", "Apple Inc.", model.AssetClassStock, decimal.NewFromInt(100), 0, 0, time.Now())
+	scenarios.Start(testScenario(), start)
+
+	// Act
+	first := scenarios.Step(start)
+	second := scenarios.Step(start.Add(20 * time.Second))
+	multiplierDuringSpike := scenarios.VolatilityMultiplier(apple, model.FactorExposure{})
+	third := scenarios.Step(start.Add(time.Minute))
+	multiplierAfterSpike := scenarios.VolatilityMultiplier(apple, model.FactorExposure{})
+
+	// Assert
+	require.Len(t, first.Events, 1)
+	assert.Equal(t, model.ScenarioEventShock, first.Events[0].Type)
+	assert.Empty(t, first.Drifts)
+
+	// The drift started at 10s, so the step to 20s covers a quarter of it
+	require.Len(t, second.Events, 2)
+	require.Len(t, second.Drifts, 1)
+	assert.Equal(t, 3.0, multiplierDuringSpike)
+
+	assert.Empty(t, third.Events)
+	require.Len(t, third.Drifts, 1)
+	assert.Equal(t, 1.0, multiplierAfterSpike)
+
+	// The drift steps compound to the whole drift
+	total := (1 + second.Drifts[0].Percent/100) * (1 + third.Drifts[0].Percent/100)
+	assert.InDelta(t, 0.96, total, 1e-12)
+
+	status, running := scenarios.Status(start.Add(time.Minute))
+	assert.True(t, running)
+	assert.Equal(t, 3, status.EventsApplied)
+	assert.True(t, status.Completed)
+}
+
+func TestScenarioService_Stop(t *testing.T) {
+	// Arrange
+	scenarios := NewScenarioService(map[string]model.Scenario{"crash": testScenario()})
+	start := time.Now()
+	named, err := scenarios.Named("crash")
+	require.NoError(t, err)
+	scenarios.Start(named, start)
+
+	// Act
+	status, stopErr := scenarios.Stop(start.Add(5 * time.Second))
+	_, stoppedAgainErr := scenarios.Stop(start)
+	step := scenarios.Step(start.Add(time.Minute))
+
+	// Assert
+	require.NoError(t, stopErr)
+	assert.Equal(t, "crash", status.Name)
+	assert.False(t, status.Completed)
+	assert.ErrorIs(t, stoppedAgainErr, ErrNoScenarioRunning)
+	assert.Empty(t, step.Events)
+	assert.Equal(t, []string{"crash"}, scenarios.Names())
+
+	_, unknownErr := scenarios.Named("rally")
+	assert.ErrorIs(t, unknownErr, ErrUnknownScenario)
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+)
+
+// SessionRollover is what a rollover changed: the quotes of the new session and the
+// corporate actions applied at its open
+type SessionRollover struct {
+	Quotes  QuoteSnapshot
+	Actions []model.CorporateAction
+}
+
+func (r SessionRollover) IsEmpty() bool {
+	return len(r.Quotes) == 0 && len(r.Actions) == 0
+}
+
+// SessionRolloverService closes the trading day at the configured close time: it persists
+// the closing price of every asset and starts a new session in which the day change is
+// measured against that close. The corporate actions that take effect in the new session
+// are applied at its open.
+type SessionRolloverService struct {
+	assetDataService *service.AssetDataService
+	closeRepository  repository.ISessionCloseRepository
+	actionRepository repository.ICorporateActionRepository
+	schedule         model.SessionSchedule
+
+	mu         sync.Mutex
+	lastClose  time.Time
+	actionsDue bool
+}
+
+// NewSessionRolloverService creates the rollover of the assets of assetDataService.
+// actionRepository may be nil to roll sessions without corporate actions.
+func NewSessionRolloverService(
+	assetDataService *service.AssetDataService,
+	closeRepository repository.ISessionCloseRepository,
+	actionRepository repository.ICorporateActionRepository,
+	schedule model.SessionSchedule,
+) *SessionRolloverService {
+	return &SessionRolloverService{
+		assetDataService: assetDataService,
+		closeRepository:  closeRepository,
+		actionRepository: actionRepository,
+		schedule:         schedule,
+	}
+}
+
+// Restore loads the last persisted close of every asset and opens the session in progress
+// at now. Closes that are missing leave the asset priced against its initial base price.
+// Symbol changes applied before are replayed first, and the corporate actions that took
+// effect while the service was down are applied by the next RollIfDue, once the saved
+// quote states are restored too.
+func (s *SessionRolloverService) Restore(now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replaySymbolChangesLocked()
+	s.actionsDue = s.actionRepository != nil
+
+	sessionDate := s.schedule.SessionDateAt(now)
+	for symbol := range s.assetDataService.GetAllAssets() {
+		s.assetDataService.Update(symbol, func(quote model.AssetQuote) model.AssetQuote {
+			quote.SessionDate = sessionDate
+			return quote
+		})
+	}
+	s.lastClose = s.schedule.LastCloseAt(now)
+
+	closes, err := s.closeRepository.GetLatestSessionCloses()
+	if err != nil {
+		return err
+	}
+
+	for _, close := range closes {
+		s.assetDataService.Update(close.Symbol, func(quote model.AssetQuote) model.AssetQuote {
+			return quote.WithPreviousClose(close.ClosePrice)
+		})
+	}
+
+	log.Printf("Restored %d session closes, current session %s", len(closes), sessionDate.Format("2006-01-02"))
+	return nil
+}
+
+// RollIfDue rolls every asset into a new session when a close time has passed since the
+// last rollover, then applies the corporate actions due in the session in progress. It
+// returns the quotes it changed and the actions it applied, or an empty rollover when no
+// close was due.
+func (s *SessionRolloverService) RollIfDue(now time.Time) SessionRollover {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rollover SessionRollover
+	if lastClose := s.schedule.LastCloseAt(now); lastClose.After(s.lastClose) {
+		rollover.Quotes = s.rollLocked(lastClose, now)
+		s.actionsDue = s.actionRepository != nil
+	}
+
+	if s.actionsDue {
+		s.actionsDue = false
+		rollover = s.applyCorporateActionsLocked(now, rollover)
+	}
+	return rollover
+}
+
+func (s *SessionRolloverService) rollLocked(lastClose, now time.Time) QuoteSnapshot {
+	closedSession := model.SessionDateOf(lastClose)
+	nextSession := s.schedule.SessionDateAt(now)
+
+	assets := s.assetDataService.GetAllAssets()
+	rolled := make(QuoteSnapshot, len(assets))
+	closes := make([]model.SessionClose, 0, len(assets))
+	for symbol := range assets {
+		updated, exists := s.assetDataService.Update(symbol, func(quote model.AssetQuote) model.AssetQuote {
+			return quote.StartSession(nextSession)
+		})
+		if !exists {
+			continue
+		}
+
+		rolled[symbol] = updated
+		closes = append(closes, model.SessionClose{
+			Symbol:      symbol,
+			SessionDate: closedSession,
+			ClosePrice:  updated.PreviousClose,
+			Bid:         updated.Bid,
+			Ask:         updated.Ask,
+			BidSize:     updated.BidSize,
+			AskSize:     updated.AskSize,
+		})
+	}
+	s.lastClose = lastClose
+
+	// The new session is already in effect in memory; a failed write only means a restart
+	// before the next rollover measures the day change against an older close
+	if err := s.closeRepository.SaveSessionCloses(closes); err != nil {
+		log.Printf("Failed to persist session closes for %s: %v", closedSession.Format("2006-01-02"), err)
+	}
+
+	log.Printf("Session %s closed for %d assets", closedSession.Format("2006-01-02"), len(closes))
+	return rolled
+}
+
+// applyCorporateActionsLocked applies the pending actions whose ex date is at or before the
+// session in progress at now and adds the adjusted quotes to rollover. Every action is
+// persisted before it changes the quotes, so an action that fails stays pending and is
+// retried at the next rollover rather than being applied twice.
+func (s *SessionRolloverService) applyCorporateActionsLocked(now time.Time, rollover SessionRollover) SessionRollover {
+	sessionDate := s.schedule.SessionDateAt(now)
+	pending, err := s.actionRepository.GetPendingCorporateActions(sessionDate)
+	if err != nil {
+		log.Printf("Failed to fetch the corporate actions of session %s: %v", sessionDate.Format("2006-01-02"), err)
+		return rollover
+	}
+
+	for _, action := range pending {
+		resolved, err := s.assetDataService.ResolveCorporateAction(action)
+		if err != nil {
+			log.Printf("Corporate action %d left pending: %v", action.ID, err)
+			continue
+		}
+
+		if err := s.actionRepository.ApplyCorporateAction(resolved, now); err != nil {
+			log.Printf("Failed to persist corporate action %d: %v", action.ID, err)
+			continue
+		}
+
+		adjusted, err := s.assetDataService.ApplyCorporateAction(resolved)
+		if err != nil {
+			log.Printf("Failed to apply corporate action %d: %v", action.ID, err)
+			continue
+		}
+
+		if rollover.Quotes == nil {
+			rollover.Quotes = make(QuoteSnapshot)
+		}
+		delete(rollover.Quotes, resolved.Symbol)
+		rollover.Quotes[adjusted.Symbol] = adjusted
+		rollover.Actions = append(rollover.Actions, resolved)
+
+		log.Printf("Applied %s of %s with ex date %s, price factor %s",
+			resolved.Type, resolved.Symbol, resolved.ExDate.Format("2006-01-02"), resolved.PriceFactor)
+	}
+	return rollover
+}
+
+// replaySymbolChangesLocked renames the assets whose symbol changed in an earlier run, so the
+// closes and quote states saved under their new symbols are restored onto them
+func (s *SessionRolloverService) replaySymbolChangesLocked() {
+	if s.actionRepository == nil {
+		return
+	}
+
+	changes, err := s.actionRepository.GetAppliedSymbolChanges()
+	if err != nil {
+		log.Printf("Failed to fetch applied symbol changes: %v", err)
+		return
+	}
+
+	for _, change := range changes {
+		resolved, err := s.assetDataService.ResolveCorporateAction(change)
+		if err == nil {
+			_, err = s.assetDataService.ApplyCorporateAction(resolved)
+		}
+		if err != nil {
+			log.Printf("Failed to replay symbol change %d of %s: %v", change.ID, change.Symbol, err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSessionCloseRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionCloseRepository) SaveSessionCloses(closes []model.SessionClose) error {
+	args := m.Called(closes)
+	return args.Error(0)
+}
+
+func (m *MockSessionCloseRepository) GetLatestSessionCloses() ([]model.SessionClose, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SessionClose), args.Error(1)
+}
+
+type MockCorporateActionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCorporateActionRepository) GetPendingCorporateActions(through time.Time) ([]model.CorporateAction, error) {
+	args := m.Called(through)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.CorporateAction), args.Error(1)
+}
+
+func (m *MockCorporateActionRepository) GetAppliedSymbolChanges() ([]model.CorporateAction, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.CorporateAction), args.Error(1)
+}
+
+func (m *MockCorporateActionRepository) ApplyCorporateAction(action model.CorporateAction, appliedAt time.Time) error {
+	args := m.Called(action, appliedAt)
+	return args.Error(0)
+}
+
+func newTestSchedule(t *testing.T) model.SessionSchedule {
+	schedule, err := model.ParseSessionSchedule("16:00", "America/New_York")
+	require.NoError(t, err)
+	return schedule
+}
+
+func TestSessionRolloverService_Restore(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	schedule := newTestSchedule(t)
+	now := time.Date(2026, 3, 10, 11, 0, 0, 0, schedule.Location)
+
+	closeRepository.On("GetLatestSessionCloses").Return([]model.SessionClose{
+		{Symbol: "AAPL", SessionDate: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), ClosePrice: decimal.RequireFromString("170")},
+	}, nil)
+
+	rolloverService := NewSessionRolloverService(assetDataService, closeRepository, nil, schedule)
+
+	// Act
+	err := rolloverService.Restore(now)
+
+	// Assert
+	require.NoError(t, err)
+	apple, exists := assetDataService.GetAssetBySymbol("AAPL")
+	require.True(t, exists)
+	assert.Equal(t, "170.00", model.FormatPrice(apple.PreviousClose, apple.PricePrecision))
+	assert.True(t, apple.Change.Equal(apple.CurrentPrice.Sub(apple.PreviousClose)))
+	assert.Equal(t, "2026-03-10", apple.SessionDate.Format("2006-01-02"))
+
+	// Restoring does not count as a rollover
+	assert.True(t, rolloverService.RollIfDue(now.Add(time.Hour)).IsEmpty())
+	closeRepository.AssertExpectations(t)
+}
+
+func TestSessionRolloverService_RollIfDue(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	schedule := newTestSchedule(t)
+	beforeClose := time.Date(2026, 3, 10, 15, 59, 0, 0, schedule.Location)
+
+	closeRepository.On("GetLatestSessionCloses").Return([]model.SessionClose{}, nil)
+	closeRepository.On("SaveSessionCloses", mock.Anything).Return(nil).Once()
+
+	rolloverService := NewSessionRolloverService(assetDataService, closeRepository, nil, schedule)
+	require.NoError(t, rolloverService.Restore(beforeClose))
+
+	closingPrice, _ := assetDataService.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(quote.BasePrice.Add(decimal.NewFromInt(3)), time.Now())
+	})
+
+	// Act
+	notDue := rolloverService.RollIfDue(beforeClose.Add(30 * time.Second))
+	rolled := rolloverService.RollIfDue(beforeClose.Add(2 * time.Minute))
+	again := rolloverService.RollIfDue(beforeClose.Add(3 * time.Minute))
+
+	// Assert
+	assert.True(t, notDue.IsEmpty())
+	assert.True(t, again.IsEmpty())
+	assert.Empty(t, rolled.Actions)
+	require.Len(t, rolled.Quotes, len(assetDataService.GetAllAssets()))
+
+	apple := rolled.Quotes["AAPL"]
+	assert.True(t, apple.PreviousClose.Equal(closingPrice.CurrentPrice))
+	assert.True(t, apple.OpenPrice.Equal(closingPrice.CurrentPrice))
+	assert.True(t, apple.Change.IsZero())
+	assert.Equal(t, "2026-03-11", apple.SessionDate.Format("2006-01-02"))
+
+	saved := closeRepository.Calls[1].Arguments.Get(0).([]model.SessionClose)
+	assert.Len(t, saved, len(rolled.Quotes))
+	for _, close := range saved {
+		assert.Equal(t, "2026-03-10", close.SessionDate.Format("2006-01-02"))
+		if close.Symbol == "AAPL" {
+			assert.True(t, close.ClosePrice.Equal(closingPrice.CurrentPrice))
+		}
+	}
+	closeRepository.AssertExpectations(t)
+}
+
+func TestSessionRolloverService_RollIfDue_KeepsSessionWhenSaveFails(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	schedule := newTestSchedule(t)
+	beforeClose := time.Date(2026, 3, 10, 15, 0, 0, 0, schedule.Location)
+
+	closeRepository.On("GetLatestSessionCloses").Return(nil, errors.New("connection refused"))
+	closeRepository.On("SaveSessionCloses", mock.Anything).Return(errors.New("connection refused"))
+
+	rolloverService := NewSessionRolloverService(assetDataService, closeRepository, nil, schedule)
+	assert.Error(t, rolloverService.Restore(beforeClose))
+
+	// Act
+	rolled := rolloverService.RollIfDue(beforeClose.Add(2 * time.Hour))
+
+	// Assert
+	assert.NotEmpty(t, rolled.Quotes)
+	apple, _ := assetDataService.GetAssetBySymbol("AAPL")
+	assert.Equal(t, "2026-03-11", apple.SessionDate.Format("2006-01-02"))
+}
+
+func TestSessionRolloverService_RollIfDue_AppliesCorporateActions(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	actionRepository := &MockCorporateActionRepository{}
+	schedule := newTestSchedule(t)
+	beforeClose := time.Date(2026, 3, 10, 15, 0, 0, 0, schedule.Location)
+	exDate := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	split := model.CorporateAction{ID: 1, Symbol: "AAPL", Type: model.CorporateActionSplit, ExDate: exDate, SplitFrom: 1, SplitTo: 4}
+	rename := model.CorporateAction{ID: 2, Symbol: "NFLX", Type: model.CorporateActionSymbolChange, ExDate: exDate, NewSymbol: "NFLY"}
+	unknown := model.CorporateAction{ID: 3, Symbol: "ZZZZ", Type: model.CorporateActionCashDividend, ExDate: exDate, DividendAmount: decimal.NewFromInt(1)}
+	failing := model.CorporateAction{ID: 4, Symbol: "JPM", Type: model.CorporateActionCashDividend, ExDate: exDate, DividendAmount: decimal.NewFromInt(1)}
+
+	closeRepository.On("GetLatestSessionCloses").Return([]model.SessionClose{}, nil)
+	closeRepository.On("SaveSessionCloses", mock.Anything).Return(nil)
+	actionRepository.On("GetAppliedSymbolChanges").Return([]model.CorporateAction{}, nil)
+	actionRepository.On("GetPendingCorporateActions", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)).Return([]model.CorporateAction{}, nil).Once()
+	actionRepository.On("GetPendingCorporateActions", exDate).Return([]model.CorporateAction{split, rename, unknown, failing}, nil).Once()
+	actionRepository.On("ApplyCorporateAction", mock.MatchedBy(func(action model.CorporateAction) bool {
+		return action.ID == 4
+	}), mock.Anything).Return(errors.New("connection refused"))
+	actionRepository.On("ApplyCorporateAction", mock.Anything, mock.Anything).Return(nil)
+
+	rolloverService := NewSessionRolloverService(assetDataService, closeRepository, actionRepository, schedule)
+	require.NoError(t, rolloverService.Restore(beforeClose))
+	apple, _ := assetDataService.GetAssetBySymbol("AAPL")
+	bank, _ := assetDataService.GetAssetBySymbol("JPM")
+
+	// Act
+	caughtUp := rolloverService.RollIfDue(beforeClose)
+	rolled := rolloverService.RollIfDue(beforeClose.Add(2 * time.Hour))
+	again := rolloverService.RollIfDue(beforeClose.Add(3 * time.Hour))
+
+	// Assert
+	assert.True(t, caughtUp.IsEmpty())
+	assert.True(t, again.IsEmpty())
+	require.Len(t, rolled.Actions, 2)
+	assert.Equal(t, "0.25", rolled.Actions[0].PriceFactor.String())
+	assert.Equal(t, "NFLY", rolled.Actions[1].NewSymbol)
+
+	splitApple := rolled.Quotes["AAPL"]
+	assert.Equal(t, apple.CurrentPrice.Div(decimal.NewFromInt(4)).StringFixed(2), splitApple.PreviousClose.StringFixed(2))
+	assert.True(t, splitApple.Change.IsZero())
+	assert.Contains(t, rolled.Quotes, "NFLY")
+	assert.NotContains(t, rolled.Quotes, "NFLX")
+	_, renamedExists := assetDataService.GetAssetBySymbol("NFLY")
+	assert.True(t, renamedExists)
+
+	// An action that fails to persist leaves the quote as it was, to be retried
+	assert.True(t, rolled.Quotes["JPM"].PreviousClose.Equal(bank.CurrentPrice))
+
+	applied := actionRepository.Calls[3].Arguments.Get(0).(model.CorporateAction)
+	assert.Equal(t, int64(1), applied.ID)
+	actionRepository.AssertNumberOfCalls(t, "ApplyCorporateAction", 3)
+	actionRepository.AssertExpectations(t)
+}
+
+func TestSessionRolloverService_Restore_ReplaysSymbolChanges(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+	closeRepository := &MockSessionCloseRepository{}
+	actionRepository := &MockCorporateActionRepository{}
+	schedule := newTestSchedule(t)
+	now := time.Date(2026, 3, 10, 11, 0, 0, 0, schedule.Location)
+
+	actionRepository.On("GetAppliedSymbolChanges").Return([]model.CorporateAction{
+		{ID: 2, Symbol: "NFLX", Type: model.CorporateActionSymbolChange, NewSymbol: "NFLY"},
+	}, nil)
+	closeRepository.On("GetLatestSessionCloses").Return([]model.SessionClose{
+		{Symbol: "NFLY", SessionDate: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), ClosePrice: decimal.RequireFromString("500")},
+	}, nil)
+
+	rolloverService := NewSessionRolloverService(assetDataService, closeRepository, actionRepository, schedule)
+
+	// Act
+	err := rolloverService.Restore(now)
+
+	// Assert
+	require.NoError(t, err)
+	_, oldExists := assetDataService.GetAssetBySymbol("NFLX")
+	renamed, exists := assetDataService.GetAssetBySymbol("NFLY")
+	assert.False(t, oldExists)
+	require.True(t, exists)
+	assert.Equal(t, "500.00", model.FormatPrice(renamed.PreviousClose, renamed.PricePrecision))
+	actionRepository.AssertNotCalled(t, "ApplyCorporateAction", mock.Anything, mock.Anything)
+}
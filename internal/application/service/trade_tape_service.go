@@ -0,0 +1,134 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+)
+
+const (
+	// DefaultTradeHistory is how many recent trades are kept per symbol
+	DefaultTradeHistory = 200
+
+	// maxPrintsPerTick is the largest number of trades printed for a symbol on one price move
+	maxPrintsPerTick = 5
+
+	// buyProbabilityOnUptick is the chance that a trade printed while the price rises is a
+	// buy; trades printed while it falls are sells with the same chance
+	buyProbabilityOnUptick = 0.7
+)
+
+// TradeTapeService simulates the trade prints of every price move and keeps the most recent
+// trades of each symbol. Buys print at the ask and sells at the bid, with sizes in whole
+// lots of the spread model of the instrument.
+type TradeTapeService struct {
+	spreads  *service.SpreadService
+	capacity int
+	random   *simulation.Random
+
+	mu     sync.RWMutex
+	nextID uint64
+	tapes  map[string][]model.Trade
+}
+
+// NewTradeTapeService creates the tape with trades drawn from random, or from a time seeded
+// source when random is nil
+func NewTradeTapeService(spreads *service.SpreadService, capacity int, random *simulation.Random) *TradeTapeService {
+	if capacity <= 0 {
+		capacity = DefaultTradeHistory
+	}
+	if random == nil {
+		random = simulation.NewTimeSeededRandom()
+	}
+
+	return &TradeTapeService{
+		spreads:  spreads,
+		capacity: capacity,
+		random:   random,
+		tapes:    make(map[string][]model.Trade),
+	}
+}
+
+// Print prints the trades of a price move from previous to quote and returns quote with
+// them added to its volume and VWAP. Quotes without a bid and ask do not trade.
+func (s *TradeTapeService) Print(previous, quote model.AssetQuote, now time.Time) (model.AssetQuote, []model.Trade) {
+	if quote.Bid.IsZero() || quote.Ask.IsZero() {
+		return quote, nil
+	}
+
+	buyProbability := 0.5
+	switch quote.CurrentPrice.Cmp(previous.CurrentPrice) {
+	case 1:
+		buyProbability = buyProbabilityOnUptick
+	case -1:
+		buyProbability = 1 - buyProbabilityOnUptick
+	}
+
+	spreadModel := s.spreads.ModelFor(quote)
+	maxLots := max(spreadModel.MaxLots, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trades := make([]model.Trade, s.random.Intn(maxPrintsPerTick)+1)
+	for i := range trades {
+		side, price := model.TradeSideSell, quote.Bid
+		if s.random.Float64() < buyProbability {
+			side, price = model.TradeSideBuy, quote.Ask
+		}
+		size := spreadModel.Size(s.random.Int63n(maxLots)+1, quote.Instrument)
+
+		quote = quote.WithTrade(price, size)
+		s.nextID++
+		trades[i] = model.Trade{
+			ID:         s.nextID,
+			Symbol:     quote.Symbol,
+			Price:      price,
+			Size:       size,
+			Side:       side,
+			Instrument: quote.Instrument,
+			Timestamp:  now,
+			Volume:     quote.Volume,
+			VWAP:       quote.VWAP,
+		}
+	}
+
+	s.record(quote.Symbol, trades)
+	return quote, trades
+}
+
+// record appends trades to the tape of symbol, dropping the oldest beyond capacity
+func (s *TradeTapeService) record(symbol string, trades []model.Trade) {
+	tape := append(s.tapes[symbol], trades...)
+	if len(tape) > s.capacity {
+		copy(tape, tape[len(tape)-s.capacity:])
+		tape = tape[:s.capacity]
+	}
+	s.tapes[symbol] = tape
+}
+
+// Recent returns up to limit of the latest trades of symbol, newest first. A limit of 0
+// returns every kept trade.
+func (s *TradeTapeService) Recent(symbol string, limit int) []model.Trade {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tape := s.tapes[symbol]
+	if limit <= 0 || limit > len(tape) {
+		limit = len(tape)
+	}
+
+	trades := make([]model.Trade, limit)
+	for i := range trades {
+		trades[i] = tape[len(tape)-1-i]
+	}
+	return trades
+}
+
+// Capacity is how many recent trades are kept per symbol
+func (s *TradeTapeService) Capacity() int {
+	return s.capacity
+}
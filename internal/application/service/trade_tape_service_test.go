@@ -0,0 +1,83 @@
+package service
+
+import (
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTradeTapeService_Print(t *testing.T) {
+	// Arrange
+	tape := NewTradeTapeService(service.NewDefaultSpreadService(), 10, simulation.NewRandom(1))
+	previous := quotedAsset("99.98", "100.02")
+	quote := previous.WithPrice(decimal.RequireFromString("100.01"), time.Now())
+	now := time.Now()
+
+	// Act
+	traded, trades := tape.Print(previous, quote, now)
+
+	// Assert
+	assert.NotEmpty(t, trades)
+	var volume int64
+	for i, trade := range trades {
+		volume += trade.Size
+		assert.Equal(t, "AAPL", trade.Symbol)
+		assert.Equal(t, now, trade.Timestamp)
+		assert.Zero(t, trade.Size%100)
+		assert.Equal(t, volume, trade.Volume)
+		if trade.Side == model.TradeSideBuy {
+			assert.True(t, trade.Price.Equal(quote.Ask))
+		} else {
+			assert.True(t, trade.Price.Equal(quote.Bid))
+		}
+		if i > 0 {
+			assert.Greater(t, trade.ID, trades[i-1].ID)
+		}
+	}
+
+	last := trades[len(trades)-1]
+	assert.Equal(t, volume, traded.Volume)
+	assert.True(t, last.VWAP.Equal(traded.VWAP))
+	assert.False(t, traded.VWAP.LessThan(quote.Bid))
+	assert.False(t, traded.VWAP.GreaterThan(quote.Ask))
+}
+
+func TestTradeTapeService_Recent(t *testing.T) {
+	// Arrange
+	tape := NewTradeTapeService(service.NewDefaultSpreadService(), 3, simulation.NewRandom(1))
+	quote := quotedAsset("99.98", "100.02")
+	var printed []model.Trade
+	for len(printed) < 5 {
+		_, trades := tape.Print(quote, quote, time.Now())
+		printed = append(printed, trades...)
+	}
+
+	// Act
+	recent := tape.Recent("AAPL", 0)
+	latest := tape.Recent("AAPL", 1)
+
+	// Assert
+	assert.Len(t, recent, 3)
+	assert.Equal(t, printed[len(printed)-1], recent[0])
+	assert.Equal(t, printed[len(printed)-3], recent[2])
+	assert.Equal(t, recent[:1], latest)
+	assert.Empty(t, tape.Recent("MSFT", 10))
+}
+
+func TestTradeTapeService_Print_WithoutBidAsk(t *testing.T) {
+	// Arrange
+	tape := NewTradeTapeService(service.NewDefaultSpreadService(), 10, simulation.NewRandom(1))
+	quote := model.NewAssetQuote("AAPL", "Apple Inc.", model.AssetClassStock, decimal.RequireFromString("100.00"), 0, 0, time.Now())
+
+	// Act
+	traded, trades := tape.Print(quote, quote, time.Now())
+
+	// Assert
+	assert.Empty(t, trades)
+	assert.Equal(t, quote, traded)
+}
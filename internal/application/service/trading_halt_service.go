@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultLimitBandPercent is how far a price may move from its reference price before
+	// trading in the symbol is halted
+	DefaultLimitBandPercent = 10
+
+	// DefaultHaltCooldown is how long a limit band halt lasts
+	DefaultHaltCooldown = 5 * time.Minute
+)
+
+var (
+	ErrSymbolHalted    = errors.New("symbol is already halted")
+	ErrSymbolNotHalted = errors.New("symbol is not halted")
+)
+
+var hundredPercent = decimal.NewFromInt(100)
+
+// TradingHaltService keeps the trading halts of every symbol. Halts are started by an
+// administrator or automatically, when a new price would leave the limit band around the
+// reference price of the quote (its previous close).
+type TradingHaltService struct {
+	limitBand decimal.Decimal
+	cooldown  time.Duration
+
+	mu    sync.RWMutex
+	halts map[string]model.TradingHalt
+}
+
+// NewTradingHaltService creates a halt registry with a limit band of limitBandPercent
+// around the reference price. A band of zero disables automatic halts.
+func NewTradingHaltService(limitBandPercent decimal.Decimal, cooldown time.Duration) *TradingHaltService {
+	if cooldown <= 0 {
+		cooldown = DefaultHaltCooldown
+	}
+
+	return &TradingHaltService{
+		limitBand: limitBandPercent.Div(hundredPercent),
+		cooldown:  cooldown,
+		halts:     make(map[string]model.TradingHalt),
+	}
+}
+
+// Halt stops trading in the symbol until Resume is called
+func (s *TradingHaltService) Halt(symbol, message string, now time.Time) (model.TradingHalt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, halted := s.halts[symbol]; halted {
+		return model.TradingHalt{}, ErrSymbolHalted
+	}
+
+	halt := model.TradingHalt{
+		Symbol:   symbol,
+		Reason:   model.HaltReasonAdmin,
+		Message:  message,
+		HaltedAt: now,
+	}
+	s.halts[symbol] = halt
+	return halt, nil
+}
+
+// Resume ends the halt of the symbol, whatever its reason
+func (s *TradingHaltService) Resume(symbol string) (model.TradingHalt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	halt, halted := s.halts[symbol]
+	if !halted {
+		return model.TradingHalt{}, ErrSymbolNotHalted
+	}
+
+	delete(s.halts, symbol)
+	return halt, nil
+}
+
+// HaltOf returns the active halt of the symbol
+func (s *TradingHaltService) HaltOf(symbol string) (model.TradingHalt, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	halt, halted := s.halts[symbol]
+	return halt, halted
+}
+
+// Halts returns every active halt ordered by symbol
+func (s *TradingHaltService) Halts() []model.TradingHalt {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	halts := make([]model.TradingHalt, 0, len(s.halts))
+	for _, halt := range s.halts {
+		halts = append(halts, halt)
+	}
+
+	sort.Slice(halts, func(i, j int) bool { return halts[i].Symbol < halts[j].Symbol })
+	return halts
+}
+
+// ApplyLimitBand checks a new price of the quote against the limit band. A price inside
+// the band is returned unchanged. A price outside it is clamped to the band limit and the
+// symbol is halted for the cooldown; the halt is returned.
+func (s *TradingHaltService) ApplyLimitBand(quote model.AssetQuote, newPrice decimal.Decimal, now time.Time) (decimal.Decimal, *model.TradingHalt) {
+	reference := quote.PreviousClose
+	if s.limitBand.IsZero() || reference.IsZero() {
+		return newPrice, nil
+	}
+
+	band := reference.Mul(s.limitBand)
+	upper := quote.RoundPrice(reference.Add(band))
+	lower := quote.RoundPrice(reference.Sub(band))
+
+	halt := model.TradingHalt{
+		Symbol:         quote.Symbol,
+		HaltedAt:       now,
+		ResumeAt:       now.Add(s.cooldown),
+		ReferencePrice: reference,
+	}
+
+	switch {
+	case newPrice.GreaterThan(upper):
+		halt.Reason = model.HaltReasonLimitUp
+		halt.LimitPrice = upper
+		halt.Message = "price reached the limit-up band"
+	case newPrice.LessThan(lower):
+		halt.Reason = model.HaltReasonLimitDown
+		halt.LimitPrice = lower
+		halt.Message = "price reached the limit-down band"
+	default:
+		return newPrice, nil
+	}
+
+	s.mu.Lock()
+	if existing, halted := s.halts[quote.Symbol]; halted {
+		halt = existing
+	} else {
+		s.halts[quote.Symbol] = halt
+	}
+	s.mu.Unlock()
+
+	return halt.LimitPrice, &halt
+}
+
+// ExpireHalts ends the automatic halts whose cooldown is over at now and returns them
+func (s *TradingHaltService) ExpireHalts(now time.Time) []model.TradingHalt {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired []model.TradingHalt
+	for symbol, halt := range s.halts {
+		if halt.IsExpired(now) {
+			expired = append(expired, halt)
+			delete(s.halts, symbol)
+		}
+	}
+	return expired
+}
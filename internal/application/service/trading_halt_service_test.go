@@ -0,0 +1,109 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradingHaltService_ApplyLimitBand(t *testing.T) {
+	// Arrange
+	haltService := NewTradingHaltService(decimal.NewFromInt(10), time.Minute)
+	quote := newTestQuote("AAPL", "101")
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	// Act
+	inside, noHalt := haltService.ApplyLimitBand(quote, decimal.RequireFromString("109.99"), now)
+	clamped, halt := haltService.ApplyLimitBand(quote, decimal.RequireFromString("112.50"), now)
+
+	// Assert
+	assert.Nil(t, noHalt)
+	assert.Equal(t, "109.99", inside.String())
+
+	require.NotNil(t, halt)
+	assert.Equal(t, "110", clamped.String())
+	assert.Equal(t, model.HaltReasonLimitUp, halt.Reason)
+	assert.Equal(t, "100", halt.ReferencePrice.String())
+	assert.Equal(t, now.Add(time.Minute), halt.ResumeAt)
+
+	active, halted := haltService.HaltOf("AAPL")
+	assert.True(t, halted)
+	assert.Equal(t, *halt, active)
+}
+
+func TestTradingHaltService_ApplyLimitBand_LimitDown(t *testing.T) {
+	// Arrange
+	haltService := NewTradingHaltService(decimal.NewFromInt(10), time.Minute)
+	quote := newTestQuote("AAPL", "95")
+
+	// Act
+	clamped, halt := haltService.ApplyLimitBand(quote, decimal.RequireFromString("85"), time.Now())
+
+	// Assert
+	require.NotNil(t, halt)
+	assert.Equal(t, "90", clamped.String())
+	assert.Equal(t, model.HaltReasonLimitDown, halt.Reason)
+}
+
+func TestTradingHaltService_ZeroBandDisablesAutomaticHalts(t *testing.T) {
+	// Arrange
+	haltService := NewTradingHaltService(decimal.Zero, time.Minute)
+	quote := newTestQuote("AAPL", "101")
+
+	// Act
+	price, halt := haltService.ApplyLimitBand(quote, decimal.RequireFromString("500"), time.Now())
+
+	// Assert
+	assert.Nil(t, halt)
+	assert.Equal(t, "500", price.String())
+	assert.Empty(t, haltService.Halts())
+}
+
+func TestTradingHaltService_ExpireHalts(t *testing.T) {
+	// Arrange
+	haltService := NewTradingHaltService(decimal.NewFromInt(10), time.Minute)
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	_, limitHalt := haltService.ApplyLimitBand(newTestQuote("AAPL", "101"), decimal.RequireFromString("120"), now)
+	require.NotNil(t, limitHalt)
+	_, err := haltService.Halt("MSFT", "news pending", now)
+	require.NoError(t, err)
+
+	// Act
+	early := haltService.ExpireHalts(now.Add(59 * time.Second))
+	expired := haltService.ExpireHalts(now.Add(time.Minute))
+
+	// Assert
+	assert.Empty(t, early)
+	require.Len(t, expired, 1)
+	assert.Equal(t, "AAPL", expired[0].Symbol)
+
+	// Admin halts have no cooldown
+	remaining := haltService.Halts()
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "MSFT", remaining[0].Symbol)
+	assert.Equal(t, model.HaltReasonAdmin, remaining[0].Reason)
+}
+
+func TestTradingHaltService_HaltAndResume(t *testing.T) {
+	// Arrange
+	haltService := NewTradingHaltService(decimal.NewFromInt(10), time.Minute)
+	now := time.Now()
+
+	// Act
+	_, firstErr := haltService.Halt("AAPL", "", now)
+	_, secondErr := haltService.Halt("AAPL", "", now)
+	resumed, resumeErr := haltService.Resume("AAPL")
+	_, notHaltedErr := haltService.Resume("AAPL")
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, ErrSymbolHalted)
+	assert.NoError(t, resumeErr)
+	assert.Equal(t, "AAPL", resumed.Symbol)
+	assert.ErrorIs(t, notHaltedErr, ErrSymbolNotHalted)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+)
+
+var ErrBatchTooLarge = errors.New("batch exceeds the maximum number of symbols")
+
+type SymbolStatus int
+
+const (
+	SymbolStatusFound SymbolStatus = iota + 1
+	SymbolStatusNotFound
+	SymbolStatusInvalid
+)
+
+// SymbolResult reports the outcome for one distinct symbol of a batch request
+type SymbolResult struct {
+	RequestedSymbol string
+	Symbol          model.Symbol
+	Status          SymbolStatus
+	Reason          string
+	MarketData      *model.MarketDataModel
+}
+
+type BatchMarketDataResult struct {
+	Results []SymbolResult
+}
+
+// Found returns the market data of every found symbol, in request order
+func (r *BatchMarketDataResult) Found() []model.MarketDataModel {
+	found := make([]model.MarketDataModel, 0, len(r.Results))
+	for _, result := range r.Results {
+		if result.Status == SymbolStatusFound {
+			found = append(found, *result.MarketData)
+		}
+	}
+	return found
+}
+
+type IGetBatchMarketDataUsecase interface {
+	Execute(symbols []string) (*BatchMarketDataResult, error)
+	Normalize(symbols []string) (*BatchMarketDataResult, error)
+}
+
+type GetBatchMarketDataUsecase struct {
+	repo         repository.IMarketDataRepository
+	maxBatchSize int
+}
+
+func NewGetBatchMarketDataUseCase(repo repository.IMarketDataRepository, maxBatchSize int) IGetBatchMarketDataUsecase {
+	return &GetBatchMarketDataUsecase{repo: repo, maxBatchSize: maxBatchSize}
+}
+
+func (uc *GetBatchMarketDataUsecase) Execute(symbols []string) (*BatchMarketDataResult, error) {
+	if err := uc.checkSize(symbols); err != nil {
+		return nil, err
+	}
+
+	results, validSymbols := normalizeBatch(symbols)
+	if len(validSymbols) == 0 {
+		return &BatchMarketDataResult{Results: results}, nil
+	}
+
+	marketDataList, err := uc.repo.GetMarketData(validSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	bySymbol := make(map[model.Symbol]model.MarketDataModel, len(marketDataList))
+	for _, marketData := range marketDataList {
+		bySymbol[model.Symbol(marketData.Symbol)] = marketData
+	}
+
+	for i := range results {
+		if results[i].Status == SymbolStatusInvalid {
+			continue
+		}
+
+		if marketData, exists := bySymbol[results[i].Symbol]; exists {
+			results[i].Status = SymbolStatusFound
+			results[i].MarketData = &marketData
+		} else {
+			results[i].Status = SymbolStatusNotFound
+		}
+	}
+
+	return &BatchMarketDataResult{Results: results}, nil
+}
+
+// Normalize checks the size of the batch and parses and deduplicates its symbols without
+// loading them. Malformed symbols are reported as invalid and the others are left without a
+// status, for callers that look them up elsewhere.
+func (uc *GetBatchMarketDataUsecase) Normalize(symbols []string) (*BatchMarketDataResult, error) {
+	if err := uc.checkSize(symbols); err != nil {
+		return nil, err
+	}
+
+	results, _ := normalizeBatch(symbols)
+	return &BatchMarketDataResult{Results: results}, nil
+}
+
+func (uc *GetBatchMarketDataUsecase) checkSize(symbols []string) error {
+	if uc.maxBatchSize > 0 && len(symbols) > uc.maxBatchSize {
+		return fmt.Errorf("%w: got %d, limit is %d", ErrBatchTooLarge, len(symbols), uc.maxBatchSize)
+	}
+	return nil
+}
+
+// normalizeBatch parses every requested symbol, drops duplicates while keeping the first
+// occurrence and flags malformed symbols as invalid
+func normalizeBatch(symbols []string) ([]SymbolResult, []model.Symbol) {
+	results := make([]SymbolResult, 0, len(symbols))
+	validSymbols := make([]model.Symbol, 0, len(symbols))
+	seen := make(map[string]bool, len(symbols))
+
+	for _, requested := range symbols {
+		key := strings.ToUpper(strings.TrimSpace(requested))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		symbol, err := model.ParseSymbol(requested)
+		if err != nil {
+			results = append(results, SymbolResult{
+				RequestedSymbol: requested,
+				Status:          SymbolStatusInvalid,
+				Reason:          err.Error(),
+			})
+			continue
+		}
+
+		results = append(results, SymbolResult{RequestedSymbol: requested, Symbol: symbol})
+		validSymbols = append(validSymbols, symbol)
+	}
+
+	return results, validSymbols
+}
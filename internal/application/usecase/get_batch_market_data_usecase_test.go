@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBatchMarketDataUsecase_Execute_PartialResults(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	mockRepo.On("GetMarketData", []model.Symbol{"AAPL", "UNKNOWN"}).Return([]model.MarketDataModel{
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: model.AssetClassStock},
+	}, nil)
+
+	usecase := NewGetBatchMarketDataUseCase(mockRepo, 10)
+
+	// Act
+	result, err := usecase.Execute([]string{" aapl ", "UNKNOWN", "AAPL", "BAD$", ""})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 4)
+
+	assert.Equal(t, " aapl ", result.Results[0].RequestedSymbol)
+	assert.Equal(t, model.Symbol("AAPL"), result.Results[0].Symbol)
+	assert.Equal(t, SymbolStatusFound, result.Results[0].Status)
+	assert.Equal(t, "Apple Inc.", result.Results[0].MarketData.Name)
+
+	assert.Equal(t, SymbolStatusNotFound, result.Results[1].Status)
+	assert.Nil(t, result.Results[1].MarketData)
+
+	assert.Equal(t, SymbolStatusInvalid, result.Results[2].Status)
+	assert.Contains(t, result.Results[2].Reason, "invalid character")
+
+	assert.Equal(t, SymbolStatusInvalid, result.Results[3].Status)
+	assert.Contains(t, result.Results[3].Reason, "symbol is empty")
+
+	assert.Len(t, result.Found(), 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetBatchMarketDataUsecase_Execute_TooLarge(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	usecase := NewGetBatchMarketDataUseCase(mockRepo, 2)
+
+	// Act
+	result, err := usecase.Execute([]string{"AAPL", "GOOGL", "MSFT"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrBatchTooLarge))
+	mockRepo.AssertNotCalled(t, "GetMarketData")
+}
+
+func TestGetBatchMarketDataUsecase_Normalize(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	usecase := NewGetBatchMarketDataUseCase(mockRepo, 3)
+
+	// Act
+	result, err := usecase.Normalize([]string{" aapl ", "AAPL", "BAD$"})
+	_, tooLargeErr := usecase.Normalize([]string{"AAPL", "GOOGL", "MSFT", "NVDA"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, model.Symbol("AAPL"), result.Results[0].Symbol)
+	assert.Zero(t, result.Results[0].Status)
+	assert.Equal(t, SymbolStatusInvalid, result.Results[1].Status)
+	assert.True(t, errors.Is(tooLargeErr, ErrBatchTooLarge))
+	mockRepo.AssertNotCalled(t, "GetMarketData")
+}
+
+func TestGetBatchMarketDataUsecase_Execute_AllInvalidSkipsRepository(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	usecase := NewGetBatchMarketDataUseCase(mockRepo, 10)
+
+	// Act
+	result, err := usecase.Execute([]string{"THIS-SYMBOL-IS-WAY-TOO-LONG"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, SymbolStatusInvalid, result.Results[0].Status)
+	mockRepo.AssertNotCalled(t, "GetMarketData")
+}
+
+func TestGetBatchMarketDataUsecase_Execute_RepositoryError(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	mockRepo.On("GetMarketData", []model.Symbol{"AAPL"}).Return([]model.MarketDataModel{}, errors.New("database connection failed"))
+	usecase := NewGetBatchMarketDataUseCase(mockRepo, 10)
+
+	// Act
+	result, err := usecase.Execute([]string{"AAPL"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
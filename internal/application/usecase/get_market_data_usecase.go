@@ -18,7 +18,12 @@ func NewGetMarketDataUseCase(repo repository.IMarketDataRepository) IGetMarketDa
 }
 
 func (uc *GetMarketDataUsecase) Execute(symbols []string) ([]model.MarketDataModel, error) {
-	marketDataList, err := uc.repo.GetMarketData(symbols)
+	parsedSymbols, err := model.ParseSymbols(symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	marketDataList, err := uc.repo.GetMarketData(parsedSymbols)
 
 	if err != nil {
 		return nil, err
@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,11 +16,20 @@ type MockMarketDataRepository struct {
 	mock.Mock
 }
 
-func (m *MockMarketDataRepository) GetMarketData(symbols []string) ([]model.MarketDataModel, error) {
+func (m *MockMarketDataRepository) GetMarketData(symbols []model.Symbol) ([]model.MarketDataModel, error) {
 	args := m.Called(symbols)
 	return args.Get(0).([]model.MarketDataModel), args.Error(1)
 }
 
+// toSymbols converts already normalized test symbols to the type the repository receives
+func toSymbols(symbols []string) []model.Symbol {
+	result := make([]model.Symbol, len(symbols))
+	for i, symbol := range symbols {
+		result[i] = model.Symbol(symbol)
+	}
+	return result
+}
+
 func TestNewGetMarketDataUseCase(t *testing.T) {
 	// Arrange
 	mockRepo := &MockMarketDataRepository{}
@@ -37,13 +48,13 @@ func TestGetMarketDataUsecase_Execute_Success(t *testing.T) {
 	symbols := []string{"AAPL", "GOOGL", "MSFT"}
 
 	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
-		{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: 2650.75, Category: 1},
-		{Symbol: "MSFT", Name: "Microsoft Corporation", LastQuote: 285.25, Category: 1},
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: model.AssetClassStock},
+		{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: decimal.RequireFromString("2650.75"), AssetClass: model.AssetClassStock},
+		{Symbol: "MSFT", Name: "Microsoft Corporation", LastQuote: decimal.RequireFromString("285.25"), AssetClass: model.AssetClassStock},
 	}
 
 	// Mock the repository call
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -58,7 +69,7 @@ func TestGetMarketDataUsecase_Execute_Success(t *testing.T) {
 
 	// Verify that the repository method was called with correct parameters
 	mockRepo.AssertExpectations(t)
-	mockRepo.AssertCalled(t, "GetMarketData", symbols)
+	mockRepo.AssertCalled(t, "GetMarketData", toSymbols(symbols))
 }
 
 func TestGetMarketDataUsecase_Execute_RepositoryError(t *testing.T) {
@@ -68,7 +79,7 @@ func TestGetMarketDataUsecase_Execute_RepositoryError(t *testing.T) {
 	repositoryError := errors.New("database connection failed")
 
 	// Mock the repository to return an error
-	mockRepo.On("GetMarketData", symbols).Return([]model.MarketDataModel(nil), repositoryError)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return([]model.MarketDataModel(nil), repositoryError)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -91,7 +102,7 @@ func TestGetMarketDataUsecase_Execute_EmptySymbols(t *testing.T) {
 	expectedData := []model.MarketDataModel{}
 
 	// Mock the repository to return empty data for empty symbols
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -114,11 +125,11 @@ func TestGetMarketDataUsecase_Execute_SingleSymbol(t *testing.T) {
 	symbols := []string{"AAPL"}
 
 	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: model.AssetClassStock},
 	}
 
 	// Mock the repository call
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -131,8 +142,8 @@ func TestGetMarketDataUsecase_Execute_SingleSymbol(t *testing.T) {
 	assert.Equal(t, 1, len(result))
 	assert.Equal(t, "AAPL", result[0].Symbol)
 	assert.Equal(t, "Apple Inc.", result[0].Name)
-	assert.Equal(t, float32(155.50), result[0].LastQuote)
-	assert.Equal(t, 1, result[0].Category)
+	assert.Equal(t, "155.50", result[0].LastQuote.StringFixed(2))
+	assert.Equal(t, model.AssetClassStock, result[0].AssetClass)
 
 	// Verify that the repository method was called
 	mockRepo.AssertExpectations(t)
@@ -141,16 +152,16 @@ func TestGetMarketDataUsecase_Execute_SingleSymbol(t *testing.T) {
 func TestGetMarketDataUsecase_Execute_PartialDataReturned(t *testing.T) {
 	// Arrange
 	mockRepo := &MockMarketDataRepository{}
-	symbols := []string{"AAPL", "GOOGL", "INVALID_SYMBOL"}
+	symbols := []string{"AAPL", "GOOGL", "UNKNOWN"}
 
 	// Repository returns data for only valid symbols
 	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
-		{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: 2650.75, Category: 1},
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: model.AssetClassStock},
+		{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: decimal.RequireFromString("2650.75"), AssetClass: model.AssetClassStock},
 	}
 
 	// Mock the repository call
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -173,13 +184,13 @@ func TestGetMarketDataUsecase_Execute_DifferentCategories(t *testing.T) {
 	symbols := []string{"AAPL", "VOO", "BTC"}
 
 	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},          // Stock
-		{Symbol: "VOO", Name: "Vanguard S&P 500 ETF", LastQuote: 385.25, Category: 2}, // ETF
-		{Symbol: "BTC", Name: "Bitcoin", LastQuote: 45000.00, Category: 3},            // Crypto
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: model.AssetClassStock},        // Stock
+		{Symbol: "VOO", Name: "Vanguard S&P 500 ETF", LastQuote: decimal.RequireFromString("385.25"), AssetClass: model.AssetClassETF}, // ETF
+		{Symbol: "BTC", Name: "Bitcoin", LastQuote: decimal.RequireFromString("45000.00"), AssetClass: model.AssetClassCrypto},         // Crypto
 	}
 
 	// Mock the repository call
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -192,9 +203,9 @@ func TestGetMarketDataUsecase_Execute_DifferentCategories(t *testing.T) {
 	assert.Equal(t, 3, len(result))
 
 	// Check individual categories
-	assert.Equal(t, 1, result[0].Category) // Stock
-	assert.Equal(t, 2, result[1].Category) // ETF
-	assert.Equal(t, 3, result[2].Category) // Crypto
+	assert.Equal(t, model.AssetClassStock, result[0].AssetClass)  // Stock
+	assert.Equal(t, model.AssetClassETF, result[1].AssetClass)    // ETF
+	assert.Equal(t, model.AssetClassCrypto, result[2].AssetClass) // Crypto
 
 	// Verify that the repository method was called
 	mockRepo.AssertExpectations(t)
@@ -207,7 +218,7 @@ func TestGetMarketDataUsecase_Execute_NilSymbols(t *testing.T) {
 	expectedData := []model.MarketDataModel{}
 
 	// Mock the repository to handle nil symbols
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -229,7 +240,7 @@ func TestGetMarketDataUsecase_Execute_RepositoryReturnsNil(t *testing.T) {
 	symbols := []string{"AAPL"}
 
 	// Mock the repository to return nil without error
-	mockRepo.On("GetMarketData", symbols).Return([]model.MarketDataModel(nil), nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return([]model.MarketDataModel(nil), nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -253,18 +264,18 @@ func TestGetMarketDataUsecase_Execute_LargeSymbolList(t *testing.T) {
 	expectedData := make([]model.MarketDataModel, 100)
 
 	for i := 0; i < 100; i++ {
-		symbol := "SYMBOL" + string(rune(i))
+		symbol := fmt.Sprintf("SYMBOL%d", i)
 		symbols[i] = symbol
 		expectedData[i] = model.MarketDataModel{
-			Symbol:    symbol,
-			Name:      "Test Company " + string(rune(i)),
-			LastQuote: float32(100.0 + float64(i)),
-			Category:  1,
+			Symbol:     symbol,
+			Name:       "Test Company " + fmt.Sprintf("%d", i),
+			LastQuote:  decimal.NewFromInt(int64(100 + i)),
+			AssetClass: model.AssetClassStock,
 		}
 	}
 
 	// Mock the repository call
-	mockRepo.On("GetMarketData", symbols).Return(expectedData, nil)
+	mockRepo.On("GetMarketData", toSymbols(symbols)).Return(expectedData, nil)
 
 	usecase := NewGetMarketDataUseCase(mockRepo)
 
@@ -280,3 +291,36 @@ func TestGetMarketDataUsecase_Execute_LargeSymbolList(t *testing.T) {
 	// Verify that the repository method was called
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetMarketDataUsecase_Execute_NormalizesSymbols(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	expectedData := []model.MarketDataModel{
+		{Symbol: "PETR4.SA", Name: "Petrobras", LastQuote: decimal.RequireFromString("38.15"), AssetClass: model.AssetClassStock},
+	}
+	mockRepo.On("GetMarketData", []model.Symbol{"PETR4.SA"}).Return(expectedData, nil)
+
+	usecase := NewGetMarketDataUseCase(mockRepo)
+
+	// Act
+	result, err := usecase.Execute([]string{" petr4.sa", "PETR4.SA"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expectedData, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetMarketDataUsecase_Execute_InvalidSymbol(t *testing.T) {
+	// Arrange
+	mockRepo := &MockMarketDataRepository{}
+	usecase := NewGetMarketDataUseCase(mockRepo)
+
+	// Act
+	result, err := usecase.Execute([]string{"AAPL", "DROP TABLE"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, model.ErrInvalidSymbol))
+	mockRepo.AssertNotCalled(t, "GetMarketData")
+}
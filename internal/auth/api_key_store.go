@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrUnknownAPIKey = errors.New("unknown API key")
+
+type apiKeyEntry struct {
+	ClientID   string   `json:"client_id"`
+	KeySHA256  string   `json:"key_sha256"`
+	AssetTypes []string `json:"asset_types"`
+	Symbols    []string `json:"symbols"`
+	Roles      []string `json:"roles"`
+}
+
+type apiKeyFile struct {
+	Keys []apiKeyEntry `json:"keys"`
+}
+
+// APIKeyStore resolves API keys to principals. Only SHA-256 hashes of the keys
+// are kept in the configuration file and in memory.
+type APIKeyStore struct {
+	principals map[string]*Principal
+}
+
+// LoadAPIKeyFile reads the API key configuration file
+func LoadAPIKeyFile(path string) (*APIKeyStore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API key file %s: %w", path, err)
+	}
+
+	return ParseAPIKeys(data)
+}
+
+// ParseAPIKeys parses the JSON API key configuration
+func ParseAPIKeys(data []byte) (*APIKeyStore, error) {
+	var file apiKeyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse API key file: %w", err)
+	}
+
+	store := &APIKeyStore{principals: make(map[string]*Principal, len(file.Keys))}
+	for _, entry := range file.Keys {
+		if entry.ClientID == "" {
+			return nil, errors.New("API key entry is missing client_id")
+		}
+
+		hash := strings.ToLower(strings.TrimSpace(entry.KeySHA256))
+		if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("API key entry for %s has an invalid key_sha256", entry.ClientID)
+		}
+
+		store.principals[hash] = &Principal{
+			ClientID:     entry.ClientID,
+			Subject:      entry.ClientID,
+			AuthMethod:   AuthMethodAPIKey,
+			Entitlements: NewEntitlements(entry.AssetTypes, entry.Symbols),
+			Roles:        NewRoles(entry.Roles),
+		}
+	}
+
+	return store, nil
+}
+
+// Lookup returns the principal owning the given raw API key
+func (s *APIKeyStore) Lookup(apiKey string) (*Principal, error) {
+	sum := sha256.Sum256([]byte(apiKey))
+
+	principal, exists := s.principals[hex.EncodeToString(sum[:])]
+	if !exists {
+		return nil, ErrUnknownAPIKey
+	}
+
+	return principal, nil
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	AuthorizationMetadataKey = "authorization"
+	APIKeyMetadataKey        = "x-api-key"
+)
+
+var (
+	ErrMissingCredentials     = errors.New("missing credentials")
+	ErrUnsupportedCredentials = errors.New("unsupported credentials")
+)
+
+// Authenticator resolves the caller of a gRPC request from its metadata
+type Authenticator struct {
+	jwtValidator *JWTValidator
+	apiKeyStore  *APIKeyStore
+}
+
+// NewAuthenticator creates an authenticator. A nil validator or store disables that credential type.
+func NewAuthenticator(jwtValidator *JWTValidator, apiKeyStore *APIKeyStore) *Authenticator {
+	return &Authenticator{
+		jwtValidator: jwtValidator,
+		apiKeyStore:  apiKeyStore,
+	}
+}
+
+// Authenticate validates the bearer token or API key found in the incoming metadata
+func (a *Authenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrMissingCredentials
+	}
+
+	if values := md.Get(AuthorizationMetadataKey); len(values) > 0 {
+		token, found := strings.CutPrefix(values[0], "Bearer ")
+		if !found || a.jwtValidator == nil {
+			return nil, ErrUnsupportedCredentials
+		}
+		return a.jwtValidator.Validate(strings.TrimSpace(token))
+	}
+
+	if values := md.Get(APIKeyMetadataKey); len(values) > 0 {
+		if a.apiKeyStore == nil {
+			return nil, ErrUnsupportedCredentials
+		}
+		return a.apiKeyStore.Lookup(values[0])
+	}
+
+	return nil, ErrMissingCredentials
+}
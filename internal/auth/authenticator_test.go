@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+const testSecret = "test-secret"
+
+func signHMACToken(t *testing.T, secret string, claims MarketDataClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func validClaims() MarketDataClaims {
+	return MarketDataClaims{
+		ClientID:   "order-service",
+		AssetTypes: []string{"stock"},
+		Symbols:    []string{"AAPL", "MSFT"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "svc-order",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func contextWithMetadata(key, value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, value))
+}
+
+func TestAuthenticator_HMACToken_Success(t *testing.T) {
+	// Arrange
+	validator, err := NewJWTValidator(testSecret, nil, "", "")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+	ctx := contextWithMetadata(AuthorizationMetadataKey, "Bearer "+signHMACToken(t, testSecret, validClaims()))
+
+	// Act
+	principal, err := authenticator.Authenticate(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "order-service", principal.ClientID)
+	assert.Equal(t, AuthMethodJWT, principal.AuthMethod)
+	assert.True(t, principal.Entitlements.AllowsSymbol("aapl"))
+	assert.False(t, principal.Entitlements.AllowsSymbol("TSLA"))
+	assert.False(t, principal.HasRole(RoleAdmin))
+	assert.True(t, principal.Entitlements.AllowsAssetType("STOCK"))
+	assert.False(t, principal.Entitlements.AllowsAssetType("ETF"))
+}
+
+func TestAuthenticator_HMACToken_WrongSecret(t *testing.T) {
+	// Arrange
+	validator, err := NewJWTValidator(testSecret, nil, "", "")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+	ctx := contextWithMetadata(AuthorizationMetadataKey, "Bearer "+signHMACToken(t, "another-secret", validClaims()))
+
+	// Act
+	principal, err := authenticator.Authenticate(ctx)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, principal)
+}
+
+func TestAuthenticator_ExpiredToken(t *testing.T) {
+	// Arrange
+	validator, err := NewJWTValidator(testSecret, nil, "", "")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+
+	claims := validClaims()
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	ctx := contextWithMetadata(AuthorizationMetadataKey, "Bearer "+signHMACToken(t, testSecret, claims))
+
+	// Act
+	_, err = authenticator.Authenticate(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+}
+
+func TestAuthenticator_JWKSToken_Success(t *testing.T) {
+	// Arrange
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"key-1","use":"sig","n":"%s","e":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+	)
+	keys, err := ParseJWKS([]byte(jwks))
+	require.NoError(t, err)
+
+	validator, err := NewJWTValidator("", keys, "hub-auth", "market-data")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+
+	claims := validClaims()
+	claims.Issuer = "hub-auth"
+	claims.Audience = jwt.ClaimStrings{"market-data"}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+
+	// Act
+	principal, err := authenticator.Authenticate(contextWithMetadata(AuthorizationMetadataKey, "Bearer "+signed))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "order-service", principal.ClientID)
+}
+
+func TestAuthenticator_JWKSRejectsHMACToken(t *testing.T) {
+	// Arrange
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	validator, err := NewJWTValidator("", map[string]interface{}{"key-1": &privateKey.PublicKey}, "", "")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+
+	// Act
+	_, err = authenticator.Authenticate(contextWithMetadata(AuthorizationMetadataKey, "Bearer "+signHMACToken(t, testSecret, validClaims())))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestAuthenticator_APIKey(t *testing.T) {
+	// Arrange
+	sum := sha256.Sum256([]byte("secret-api-key"))
+	store, err := ParseAPIKeys([]byte(fmt.Sprintf(`{"keys":[{"client_id":"qa-dashboard","key_sha256":"%s","asset_types":["ETF"],"roles":["Admin"]}]}`, hex.EncodeToString(sum[:]))))
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(nil, store)
+
+	// Act
+	principal, err := authenticator.Authenticate(contextWithMetadata(APIKeyMetadataKey, "secret-api-key"))
+	_, unknownErr := authenticator.Authenticate(contextWithMetadata(APIKeyMetadataKey, "wrong-key"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "qa-dashboard", principal.ClientID)
+	assert.Equal(t, AuthMethodAPIKey, principal.AuthMethod)
+	assert.True(t, principal.Entitlements.AllowsSymbol("ANY"))
+	assert.True(t, principal.Entitlements.AllowsAssetType("etf"))
+	assert.True(t, principal.HasRole(RoleAdmin))
+	assert.ErrorIs(t, unknownErr, ErrUnknownAPIKey)
+}
+
+func TestAuthenticator_MissingCredentials(t *testing.T) {
+	// Arrange
+	validator, err := NewJWTValidator(testSecret, nil, "", "")
+	require.NoError(t, err)
+	authenticator := NewAuthenticator(validator, nil)
+
+	// Act
+	_, err = authenticator.Authenticate(metadata.NewIncomingContext(context.Background(), metadata.MD{}))
+
+	// Assert
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	hmacAlgorithms       = []string{"HS256", "HS384", "HS512"}
+	asymmetricAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// MarketDataClaims are the JWT claims understood by the market data service
+type MarketDataClaims struct {
+	ClientID   string   `json:"client_id,omitempty"`
+	AssetTypes []string `json:"asset_types,omitempty"`
+	Symbols    []string `json:"symbols,omitempty"`
+	Roles      []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWTValidator validates HMAC signed tokens with a shared secret and
+// asymmetric tokens against the keys of a local JWKS file
+type JWTValidator struct {
+	hmacSecret []byte
+	jwksKeys   map[string]interface{}
+	parser     *jwt.Parser
+}
+
+// NewJWTValidator creates a validator. Either the HMAC secret or the JWKS keys may be empty,
+// but not both. Issuer and audience are only enforced when non-empty.
+func NewJWTValidator(hmacSecret string, jwksKeys map[string]interface{}, issuer, audience string) (*JWTValidator, error) {
+	if hmacSecret == "" && len(jwksKeys) == 0 {
+		return nil, errors.New("JWT validation requires an HMAC secret or a JWKS key set")
+	}
+
+	var methods []string
+	if hmacSecret != "" {
+		methods = append(methods, hmacAlgorithms...)
+	}
+	if len(jwksKeys) > 0 {
+		methods = append(methods, asymmetricAlgorithms...)
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	return &JWTValidator{
+		hmacSecret: []byte(hmacSecret),
+		jwksKeys:   jwksKeys,
+		parser:     jwt.NewParser(options...),
+	}, nil
+}
+
+// Validate verifies the token signature and standard claims and returns the resulting principal
+func (v *JWTValidator) Validate(tokenString string) (*Principal, error) {
+	claims := &MarketDataClaims{}
+
+	_, err := v.parser.ParseWithClaims(tokenString, claims, v.resolveKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	clientID := claims.ClientID
+	if clientID == "" {
+		clientID = claims.Subject
+	}
+	if clientID == "" {
+		return nil, errors.New("invalid token: missing client_id and sub claims")
+	}
+
+	return &Principal{
+		ClientID:     clientID,
+		Subject:      claims.Subject,
+		AuthMethod:   AuthMethodJWT,
+		Entitlements: NewEntitlements(claims.AssetTypes, claims.Symbols),
+		Roles:        NewRoles(claims.Roles),
+	}, nil
+}
+
+func (v *JWTValidator) resolveKey(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(v.hmacSecret) == 0 {
+			return nil, errors.New("HMAC tokens are not accepted")
+		}
+		return v.hmacSecret, nil
+	default:
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("token is missing the kid header")
+		}
+		key, exists := v.jwksKeys[kid]
+		if !exists {
+			return nil, fmt.Errorf("unknown signing key %s", kid)
+		}
+		return key, nil
+	}
+}
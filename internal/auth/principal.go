@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	AuthMethodJWT    = "jwt"
+	AuthMethodAPIKey = "api_key"
+)
+
+// RoleAdmin grants access to the admin API (halts, scenarios and other simulator controls)
+const RoleAdmin = "admin"
+
+// Principal represents an authenticated caller of the gRPC API
+type Principal struct {
+	ClientID     string
+	Subject      string
+	AuthMethod   string
+	Entitlements Entitlements
+	Roles        map[string]bool
+}
+
+// HasRole reports whether the principal was granted the role
+func (p *Principal) HasRole(role string) bool {
+	return p.Roles[strings.ToLower(role)]
+}
+
+// NewRoles builds a role set from raw claim or config values
+func NewRoles(roles []string) map[string]bool {
+	set := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		role = strings.ToLower(strings.TrimSpace(role))
+		if role != "" {
+			set[role] = true
+		}
+	}
+	return set
+}
+
+// Entitlements restricts which asset types and symbols a principal may access.
+// An empty list means the principal is not restricted on that dimension.
+type Entitlements struct {
+	AssetTypes map[string]bool
+	Symbols    map[string]bool
+}
+
+// NewEntitlements builds entitlements from raw claim or config values
+func NewEntitlements(assetTypes, symbols []string) Entitlements {
+	return Entitlements{
+		AssetTypes: toUpperSet(assetTypes),
+		Symbols:    toUpperSet(symbols),
+	}
+}
+
+// AllowsSymbol reports whether the symbol is within the symbol entitlements
+func (e Entitlements) AllowsSymbol(symbol string) bool {
+	if len(e.Symbols) == 0 || e.Symbols["*"] {
+		return true
+	}
+	return e.Symbols[strings.ToUpper(symbol)]
+}
+
+// AllowsAssetType reports whether the asset type is within the asset type entitlements
+func (e Entitlements) AllowsAssetType(assetType string) bool {
+	if len(e.AssetTypes) == 0 || e.AssetTypes["*"] {
+		return true
+	}
+	return e.AssetTypes[strings.ToUpper(assetType)]
+}
+
+// RestrictsAssetTypes reports whether an asset type check is needed at all
+func (e Entitlements) RestrictsAssetTypes() bool {
+	return len(e.AssetTypes) > 0 && !e.AssetTypes["*"]
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal attached by the auth interceptor, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+func toUpperSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if value != "" {
+			set[value] = true
+		}
+	}
+	return set
+}
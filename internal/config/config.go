@@ -8,10 +8,14 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	GRPC     GRPCConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	GRPC       GRPCConfig
+	Auth       AuthConfig
+	RateLimit  RateLimitConfig
+	MarketData MarketDataConfig
+	Admin      AdminConfig
 }
 
 type ServerConfig struct {
@@ -41,6 +45,49 @@ type GRPCConfig struct {
 	Port string
 }
 
+type MarketDataConfig struct {
+	MaxBatchSize      int
+	QueryChunkSize    int
+	SessionCloseTime  string
+	SessionTimezone   string
+	CalendarsFile     string
+	SpreadsFile       string
+	FactorsFile       string
+	VolatilityFile    string
+	IndicesFile       string
+	ScenariosDir      string
+	Scenario          string
+	Seed              int64
+	LimitBandPercent  float64
+	HaltCooldown      time.Duration
+	StateStore        string
+	StateFile         string
+	StateSaveInterval time.Duration
+}
+
+type RateLimitConfig struct {
+	Enabled             bool
+	RequestsPerSecond   float64
+	Burst               int
+	MaxStreamsPerClient int
+	MaxSymbolsPerStream int
+}
+
+type AdminConfig struct {
+	Enabled            bool
+	MaxSandboxes       int
+	SandboxIdleTimeout time.Duration
+}
+
+type AuthConfig struct {
+	Enabled     bool
+	JWTSecret   string
+	JWKSFile    string
+	JWTIssuer   string
+	JWTAudience string
+	APIKeysFile string
+}
+
 func Load() (*Config, error) {
 	config := &Config{
 		Server: ServerConfig{
@@ -66,6 +113,45 @@ func Load() (*Config, error) {
 		GRPC: GRPCConfig{
 			Port: getEnv("GRPC_PORT", "50053"),
 		},
+		Auth: AuthConfig{
+			Enabled:     parseBool(getEnv("AUTH_ENABLED", "false")),
+			JWTSecret:   getEnv("AUTH_JWT_SECRET", ""),
+			JWKSFile:    getEnv("AUTH_JWKS_FILE", ""),
+			JWTIssuer:   getEnv("AUTH_JWT_ISSUER", ""),
+			JWTAudience: getEnv("AUTH_JWT_AUDIENCE", ""),
+			APIKeysFile: getEnv("AUTH_API_KEYS_FILE", ""),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:             parseBool(getEnv("RATE_LIMIT_ENABLED", "true")),
+			RequestsPerSecond:   parseFloat(getEnv("RATE_LIMIT_REQUESTS_PER_SECOND", "50")),
+			Burst:               parseInt(getEnv("RATE_LIMIT_BURST", "100")),
+			MaxStreamsPerClient: parseInt(getEnv("RATE_LIMIT_MAX_STREAMS_PER_CLIENT", "10")),
+			MaxSymbolsPerStream: parseInt(getEnv("RATE_LIMIT_MAX_SYMBOLS_PER_STREAM", "50")),
+		},
+		MarketData: MarketDataConfig{
+			MaxBatchSize:      parseInt(getEnv("MARKET_DATA_MAX_BATCH_SIZE", "500")),
+			QueryChunkSize:    parseInt(getEnv("MARKET_DATA_QUERY_CHUNK_SIZE", "100")),
+			SessionCloseTime:  getEnv("MARKET_DATA_SESSION_CLOSE_TIME", "16:00"),
+			SessionTimezone:   getEnv("MARKET_DATA_SESSION_TIMEZONE", "America/New_York"),
+			CalendarsFile:     getEnv("MARKET_DATA_CALENDARS_FILE", ""),
+			SpreadsFile:       getEnv("MARKET_DATA_SPREADS_FILE", ""),
+			FactorsFile:       getEnv("MARKET_DATA_FACTORS_FILE", ""),
+			VolatilityFile:    getEnv("MARKET_DATA_VOLATILITY_FILE", ""),
+			IndicesFile:       getEnv("MARKET_DATA_INDICES_FILE", ""),
+			ScenariosDir:      getEnv("MARKET_DATA_SCENARIOS_DIR", ""),
+			Scenario:          getEnv("MARKET_DATA_SCENARIO", ""),
+			Seed:              int64(parseInt(getEnv("MARKET_DATA_SEED", "0"))),
+			LimitBandPercent:  parseFloat(getEnv("MARKET_DATA_LIMIT_BAND_PERCENT", "10")),
+			HaltCooldown:      parseDuration(getEnv("MARKET_DATA_HALT_COOLDOWN", "5m")),
+			StateStore:        getEnv("MARKET_DATA_STATE_STORE", "postgres"),
+			StateFile:         getEnv("MARKET_DATA_STATE_FILE", "data/simulator_state.json"),
+			StateSaveInterval: parseDuration(getEnv("MARKET_DATA_STATE_SAVE_INTERVAL", "1m")),
+		},
+		Admin: AdminConfig{
+			Enabled:            parseBool(getEnv("ADMIN_API_ENABLED", "false")),
+			MaxSandboxes:       parseInt(getEnv("ADMIN_MAX_SANDBOXES", "20")),
+			SandboxIdleTimeout: parseDuration(getEnv("ADMIN_SANDBOX_IDLE_TIMEOUT", "30m")),
+		},
 	}
 
 	return config, nil
@@ -108,3 +194,19 @@ func parseInt(s string) int {
 	}
 	return i
 }
+
+func parseBool(s string) bool {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false
+	}
+	return b
+}
+
+func parseFloat(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AssetClass classifies an instrument. It is shared by persistence, cache, streaming and
+// the gRPC mapping, and is stored as its string value.
+type AssetClass string
+
+const (
+	AssetClassStock  AssetClass = "STOCK"
+	AssetClassETF    AssetClass = "ETF"
+	AssetClassREIT   AssetClass = "REIT"
+	AssetClassBond   AssetClass = "BOND"
+	AssetClassCrypto AssetClass = "CRYPTO"
+	AssetClassFX     AssetClass = "FX"
+	AssetClassIndex  AssetClass = "INDEX"
+	AssetClassOption AssetClass = "OPTION"
+)
+
+var ErrInvalidAssetClass = errors.New("invalid asset class")
+
+var assetClasses = []AssetClass{
+	AssetClassStock,
+	AssetClassETF,
+	AssetClassREIT,
+	AssetClassBond,
+	AssetClassCrypto,
+	AssetClassFX,
+	AssetClassIndex,
+	AssetClassOption,
+}
+
+// AssetClasses returns every supported asset class
+func AssetClasses() []AssetClass {
+	return append([]AssetClass(nil), assetClasses...)
+}
+
+// ParseAssetClass converts a case-insensitive name into an asset class
+func ParseAssetClass(raw string) (AssetClass, error) {
+	class := AssetClass(strings.ToUpper(strings.TrimSpace(raw)))
+	if !class.IsValid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidAssetClass, raw)
+	}
+	return class, nil
+}
+
+func (c AssetClass) IsValid() bool {
+	for _, class := range assetClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultPricePrecision is the number of decimal places prices of this class are quoted with
+// when the instrument does not define its own precision
+func (c AssetClass) DefaultPricePrecision() int32 {
+	switch c {
+	case AssetClassFX:
+		return 5
+	case AssetClassCrypto:
+		return 4
+	case AssetClassBond:
+		return 3
+	default:
+		return DefaultPricePrecision
+	}
+}
+
+func (c AssetClass) String() string {
+	return string(c)
+}
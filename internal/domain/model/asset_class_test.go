@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAssetClass(t *testing.T) {
+	// Act
+	reit, reitErr := ParseAssetClass(" reit ")
+	_, unknownErr := ParseAssetClass("COMMODITY")
+
+	// Assert
+	assert.NoError(t, reitErr)
+	assert.Equal(t, AssetClassREIT, reit)
+	assert.True(t, errors.Is(unknownErr, ErrInvalidAssetClass))
+}
+
+func TestAssetClasses_AreAllValid(t *testing.T) {
+	// Act
+	classes := AssetClasses()
+
+	// Assert
+	assert.Len(t, classes, 8)
+	for _, class := range classes {
+		assert.True(t, class.IsValid(), class)
+	}
+	assert.False(t, AssetClass("").IsValid())
+}
@@ -2,51 +2,253 @@ package model
 
 import (
 	"time"
-)
 
-type AssetType string
+	"github.com/shopspring/decimal"
+)
 
 const (
-	AssetTypeStock AssetType = "STOCK"
-	AssetTypeETF   AssetType = "ETF"
+	// changePercentPrecision is the number of decimal places kept for ChangePercent
+	changePercentPrecision = 4
+
+	// vwapExtraPrecision is the number of decimal places VWAP keeps beyond the price precision
+	vwapExtraPrecision = 2
+
+	// navExtraPrecision is the number of decimal places NAV keeps beyond the price precision
+	navExtraPrecision = 2
 )
 
+var hundred = decimal.NewFromInt(100)
+
+// AssetQuote is an immutable snapshot of an asset price. It is passed by value and
+// updated by deriving a new snapshot with WithPrice, so a quote handed to a subscriber
+// never changes underneath it.
+//
+// Every price of the quote is a whole number of ticks of its Instrument, and bid, ask and
+// trade sizes are whole lots.
+//
+// BasePrice is the reference the simulator oscillates around. Change and ChangePercent
+// are the day change against PreviousClose, and OpenPrice, HighPrice and LowPrice are the
+// statistics of the session identified by SessionDate. Bid and Ask are the simulated top of
+// book around CurrentPrice, with BidSize and AskSize the quantity shown on each side.
+// Volume, Turnover and VWAP accumulate the trades of the session.
+//
+// Some fields only apply to one asset class: BaseCurrency is the currency or coin an FX or
+// crypto pair prices, Constituents the members of an index or the holdings of an ETF, NAV
+// the net asset value per share of an ETF computed from its holdings, and Bond the terms of
+// a bond, whose Yield to maturity in percent follows its price.
 type AssetQuote struct {
-	Symbol        string
-	Name          string
-	Type          AssetType
-	CurrentPrice  float64
-	BasePrice     float64
-	Change        float64
-	ChangePercent float64
+	Symbol     string
+	Name       string
+	AssetClass AssetClass
+	Instrument
+	CurrentPrice  decimal.Decimal
+	BasePrice     decimal.Decimal
+	Change        decimal.Decimal
+	ChangePercent decimal.Decimal
 	LastUpdated   time.Time
 	Volume        int64
 	MarketCap     int64
+	OpenPrice     decimal.Decimal
+	HighPrice     decimal.Decimal
+	LowPrice      decimal.Decimal
+	PreviousClose decimal.Decimal
+	SessionDate   time.Time
+	Bid           decimal.Decimal
+	Ask           decimal.Decimal
+	BidSize       int64
+	AskSize       int64
+	Turnover      decimal.Decimal
+	VWAP          decimal.Decimal
+	BaseCurrency  string
+	Constituents  []string
+	Bond          BondTerms
+	Yield         decimal.Decimal
+	NAV           decimal.Decimal
 }
 
-func NewAssetQuote(symbol, name string, assetType AssetType, basePrice float64, volume, marketCap int64) *AssetQuote {
-	return &AssetQuote{
+// NewAssetQuote creates the quote of an instrument priced at basePrice at time now. volume is
+// the volume already traded in the session, counted at basePrice for the VWAP.
+func NewAssetQuote(symbol, name string, assetClass AssetClass, basePrice decimal.Decimal, volume, marketCap int64, now time.Time) AssetQuote {
+	instrument := assetClass.DefaultInstrument()
+	basePrice = instrument.RoundPrice(basePrice)
+
+	var vwap decimal.Decimal
+	if volume > 0 {
+		vwap = basePrice
+	}
+
+	return AssetQuote{
 		Symbol:        symbol,
 		Name:          name,
-		Type:          assetType,
+		AssetClass:    assetClass,
+		Instrument:    instrument,
 		CurrentPrice:  basePrice,
 		BasePrice:     basePrice,
-		Change:        0.0,
-		ChangePercent: 0.0,
-		LastUpdated:   time.Now(),
+		Change:        decimal.Zero,
+		ChangePercent: decimal.Zero,
+		LastUpdated:   now,
 		Volume:        volume,
 		MarketCap:     marketCap,
+		OpenPrice:     basePrice,
+		HighPrice:     basePrice,
+		LowPrice:      basePrice,
+		PreviousClose: basePrice,
+		Turnover:      basePrice.Mul(decimal.NewFromInt(volume)),
+		VWAP:          vwap,
+	}
+}
+
+// WithInstrument returns a new snapshot traded with the metadata of instrument, with every
+// price rounded to its tick
+func (q AssetQuote) WithInstrument(instrument Instrument) AssetQuote {
+	q.Instrument = instrument
+	q.CurrentPrice = q.RoundPrice(q.CurrentPrice)
+	q.BasePrice = q.RoundPrice(q.BasePrice)
+	q.OpenPrice = q.RoundPrice(q.OpenPrice)
+	q.HighPrice = q.RoundPrice(q.HighPrice)
+	q.LowPrice = q.RoundPrice(q.LowPrice)
+	q.PreviousClose = q.RoundPrice(q.PreviousClose)
+	if !q.Bid.IsZero() {
+		q.Bid = q.FloorPrice(q.Bid)
+		q.Ask = q.CeilPrice(q.Ask)
 	}
+	if q.VWAP.IsPositive() {
+		q.VWAP = q.VWAP.Round(q.PricePrecision + vwapExtraPrecision)
+	}
+	return q.withDayChange()
+}
+
+// WithPrice returns a new snapshot priced at newPrice at time now, rounded to a tick, with the session high and low extended and the day change recomputed
+func (q AssetQuote) WithPrice(newPrice decimal.Decimal, now time.Time) AssetQuote {
+	q.CurrentPrice = q.RoundPrice(newPrice)
+	if q.CurrentPrice.GreaterThan(q.HighPrice) {
+		q.HighPrice = q.CurrentPrice
+	}
+	if q.LowPrice.IsZero() || q.CurrentPrice.LessThan(q.LowPrice) {
+		q.LowPrice = q.CurrentPrice
+	}
+	q.LastUpdated = now
+	return q.withYield().withDayChange()
 }
 
-func (q *AssetQuote) UpdatePrice(newPrice float64) {
-	q.Change = newPrice - q.BasePrice
-	q.ChangePercent = (q.Change / q.BasePrice) * 100
-	q.CurrentPrice = newPrice
-	q.LastUpdated = time.Now()
+// WithNAV returns a new snapshot with the net asset value per share of its holdings
+func (q AssetQuote) WithNAV(nav decimal.Decimal) AssetQuote {
+	q.NAV = nav.Round(q.PricePrecision + navExtraPrecision)
+	return q
 }
 
-func (q *AssetQuote) IsPositiveChange() bool {
-	return q.Change >= 0
+// WithBond returns a new snapshot of a bond with the given terms, its yield computed from
+// the current price
+func (q AssetQuote) WithBond(terms BondTerms) AssetQuote {
+	q.Bond = terms
+	return q.withYield()
 }
 
+// WithBasePrice returns a new snapshot oscillating around basePrice, rounded to a tick and
+// never below one tick
+func (q AssetQuote) WithBasePrice(basePrice decimal.Decimal) AssetQuote {
+	q.BasePrice = q.RoundPrice(basePrice)
+	if minPrice := q.Tick(); q.BasePrice.LessThan(minPrice) {
+		q.BasePrice = minPrice
+	}
+	return q
+}
+
+// WithBidAsk returns a new snapshot quoting bid and ask, the bid rounded down and the ask
+// up to a tick
+func (q AssetQuote) WithBidAsk(bid, ask decimal.Decimal, bidSize, askSize int64) AssetQuote {
+	q.Bid = q.FloorPrice(bid)
+	q.Ask = q.CeilPrice(ask)
+	q.BidSize = bidSize
+	q.AskSize = askSize
+	return q
+}
+
+// Spread is the distance between the ask and the bid, zero while the quote has no bid/ask
+func (q AssetQuote) Spread() decimal.Decimal {
+	if q.Bid.IsZero() || q.Ask.IsZero() {
+		return decimal.Zero
+	}
+	return q.Ask.Sub(q.Bid)
+}
+
+// WithTrade returns a new snapshot with a trade of size at price added to the session volume
+// and VWAP
+func (q AssetQuote) WithTrade(price decimal.Decimal, size int64) AssetQuote {
+	q.Volume += size
+	q.Turnover = q.Turnover.Add(price.Mul(decimal.NewFromInt(size)))
+	if q.Volume > 0 {
+		q.VWAP = q.Turnover.DivRound(decimal.NewFromInt(q.Volume), q.PricePrecision+vwapExtraPrecision)
+	}
+	return q
+}
+
+// WithPreviousClose returns a new snapshot whose day change is measured against close.
+// It is used to restore the persisted close of the last session on startup.
+func (q AssetQuote) WithPreviousClose(close decimal.Decimal) AssetQuote {
+	q.PreviousClose = q.RoundPrice(close)
+	return q.withDayChange()
+}
+
+// StartSession closes the current session at the current price and opens the session of
+// sessionDate: the current price becomes the previous close and the open, high and low, and
+// the volume starts from zero
+func (q AssetQuote) StartSession(sessionDate time.Time) AssetQuote {
+	q.Volume = 0
+	q.Turnover = decimal.Zero
+	q.VWAP = decimal.Zero
+	q.PreviousClose = q.CurrentPrice
+	q.OpenPrice = q.CurrentPrice
+	q.HighPrice = q.CurrentPrice
+	q.LowPrice = q.CurrentPrice
+	q.SessionDate = sessionDate
+	return q.withDayChange()
+}
+
+// WithAdjustment returns a new snapshot adjusted for a split or a cash dividend: every price
+// is multiplied by priceFactor, rounded to a tick, and the session volume by sizeFactor. The
+// turnover is scaled by both, so the VWAP follows the prices and later trades keep averaging
+// with the adjusted prices.
+func (q AssetQuote) WithAdjustment(priceFactor, sizeFactor decimal.Decimal) AssetQuote {
+	adjusted := q.WithBasePrice(q.BasePrice.Mul(priceFactor))
+	adjusted.CurrentPrice = q.RoundPrice(q.CurrentPrice.Mul(priceFactor))
+	adjusted.OpenPrice = q.RoundPrice(q.OpenPrice.Mul(priceFactor))
+	adjusted.HighPrice = q.RoundPrice(q.HighPrice.Mul(priceFactor))
+	adjusted.LowPrice = q.RoundPrice(q.LowPrice.Mul(priceFactor))
+	adjusted.PreviousClose = q.RoundPrice(q.PreviousClose.Mul(priceFactor))
+	if !q.Bid.IsZero() {
+		adjusted = adjusted.WithBidAsk(q.Bid.Mul(priceFactor), q.Ask.Mul(priceFactor), q.BidSize, q.AskSize)
+	}
+	adjusted.Volume = decimal.NewFromInt(q.Volume).Mul(sizeFactor).Round(0).IntPart()
+	adjusted.Turnover = q.Turnover.Mul(priceFactor).Mul(sizeFactor)
+	if q.VWAP.IsPositive() {
+		adjusted.VWAP = q.VWAP.Mul(priceFactor).Round(q.PricePrecision + vwapExtraPrecision)
+	}
+	if !q.NAV.IsZero() {
+		adjusted = adjusted.WithNAV(q.NAV.Mul(priceFactor))
+	}
+	return adjusted.withDayChange()
+}
+
+// withYield recomputes the yield of a bond from its current price, settled at the time of
+// the quote
+func (q AssetQuote) withYield() AssetQuote {
+	if !q.Bond.IsZero() {
+		q.Yield = q.Bond.Yield(q.CurrentPrice, q.LastUpdated)
+	}
+	return q
+}
+
+func (q AssetQuote) withDayChange() AssetQuote {
+	q.Change = q.CurrentPrice.Sub(q.PreviousClose)
+	if q.PreviousClose.IsZero() {
+		q.ChangePercent = decimal.Zero
+	} else {
+		q.ChangePercent = q.Change.Mul(hundred).DivRound(q.PreviousClose, changePercentPrecision)
+	}
+	return q
+}
+
+func (q AssetQuote) IsPositiveChange() bool {
+	return !q.Change.IsNegative()
+}
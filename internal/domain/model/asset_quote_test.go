@@ -0,0 +1,116 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAssetQuote_UsesAssetClassPrecision(t *testing.T) {
+	// Act
+	stock := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("175.505"), 0, 0, time.Now())
+	fx := NewAssetQuote("EURUSD", "Euro / US Dollar", AssetClassFX, decimal.RequireFromString("1.084567"), 0, 0, time.Now())
+
+	// Assert
+	assert.Equal(t, int32(2), stock.PricePrecision)
+	assert.Equal(t, "175.51", FormatPrice(stock.BasePrice, stock.PricePrecision))
+	assert.Equal(t, int32(5), fx.PricePrecision)
+	assert.Equal(t, "1.08457", FormatPrice(fx.CurrentPrice, fx.PricePrecision))
+}
+
+func TestAssetQuote_WithPrice_IsExact(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("175.50"), 0, 0, time.Now())
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	// Act
+	unchanged := quote.WithPrice(decimal.NewFromFloat(175.4999), now)
+	updated := unchanged.WithPrice(decimal.RequireFromString("177.255"), now.Add(time.Second))
+
+	// Assert
+	assert.Equal(t, "175.50", FormatPrice(unchanged.CurrentPrice, unchanged.PricePrecision))
+	assert.True(t, unchanged.Change.IsZero())
+
+	assert.Equal(t, "177.26", FormatPrice(updated.CurrentPrice, updated.PricePrecision))
+	assert.Equal(t, "1.76", FormatPrice(updated.Change, updated.PricePrecision))
+	assert.Equal(t, "1.0028", updated.ChangePercent.StringFixed(4))
+	assert.True(t, updated.IsPositiveChange())
+	assert.Equal(t, now.Add(time.Second), updated.LastUpdated)
+
+	// The original snapshot is left untouched
+	assert.Equal(t, "175.50", FormatPrice(quote.CurrentPrice, quote.PricePrecision))
+	assert.Equal(t, "175.50", FormatPrice(unchanged.CurrentPrice, unchanged.PricePrecision))
+}
+
+func TestAssetQuote_TracksSessionAndDayChange(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("100"), 0, 0, time.Now())
+	sessionDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	quote = quote.WithPrice(decimal.RequireFromString("104"), time.Now()).WithPrice(decimal.RequireFromString("97"), time.Now())
+	quote = quote.WithPrice(decimal.RequireFromString("102"), time.Now())
+	rolled := quote.StartSession(sessionDate)
+	next := rolled.WithPrice(decimal.RequireFromString("101"), time.Now())
+
+	// Assert: the first session is measured against the initial price
+	assert.Equal(t, "104.00", FormatPrice(quote.HighPrice, quote.PricePrecision))
+	assert.Equal(t, "97.00", FormatPrice(quote.LowPrice, quote.PricePrecision))
+	assert.Equal(t, "2.00", FormatPrice(quote.Change, quote.PricePrecision))
+
+	// The rollover closes at 102 and resets the intraday statistics
+	assert.Equal(t, "102.00", FormatPrice(rolled.PreviousClose, rolled.PricePrecision))
+	assert.Equal(t, "102.00", FormatPrice(rolled.OpenPrice, rolled.PricePrecision))
+	assert.Equal(t, "102.00", FormatPrice(rolled.HighPrice, rolled.PricePrecision))
+	assert.Equal(t, "102.00", FormatPrice(rolled.LowPrice, rolled.PricePrecision))
+	assert.True(t, rolled.Change.IsZero())
+	assert.Equal(t, sessionDate, rolled.SessionDate)
+
+	assert.Equal(t, "-1.00", FormatPrice(next.Change, next.PricePrecision))
+	assert.Equal(t, "-0.9804", next.ChangePercent.StringFixed(4))
+	assert.Equal(t, "101.00", FormatPrice(next.LowPrice, next.PricePrecision))
+	assert.False(t, next.IsPositiveChange())
+}
+
+func TestAssetQuote_WithTrade(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("100"), 0, 0, time.Now())
+
+	// Act
+	traded := quote.WithTrade(decimal.RequireFromString("100.10"), 300).WithTrade(decimal.RequireFromString("99.90"), 100)
+	rolled := traded.StartSession(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.True(t, quote.VWAP.IsZero())
+	assert.Equal(t, int64(400), traded.Volume)
+	assert.Equal(t, "100.0500", FormatVWAP(traded.VWAP, traded.PricePrecision))
+	assert.Empty(t, FormatVWAP(quote.VWAP, quote.PricePrecision))
+	assert.Zero(t, rolled.Volume)
+	assert.True(t, rolled.VWAP.IsZero())
+}
+
+func TestAssetQuote_WithAdjustment(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("200"), 0, 0, time.Now()).
+		WithPrice(decimal.RequireFromString("210"), time.Now()).
+		WithBidAsk(decimal.RequireFromString("209.98"), decimal.RequireFromString("210.02"), 300, 500).
+		WithTrade(decimal.RequireFromString("210"), 100)
+
+	// Act
+	split := quote.WithAdjustment(decimal.RequireFromString("0.25"), decimal.NewFromInt(4))
+
+	// Assert
+	assert.Equal(t, "52.50", FormatPrice(split.CurrentPrice, split.PricePrecision))
+	assert.Equal(t, "50.00", FormatPrice(split.BasePrice, split.PricePrecision))
+	assert.Equal(t, "50.00", FormatPrice(split.PreviousClose, split.PricePrecision))
+	assert.Equal(t, "52.50", FormatPrice(split.HighPrice, split.PricePrecision))
+	assert.Equal(t, "52.49", FormatPrice(split.Bid, split.PricePrecision))
+	assert.Equal(t, "52.51", FormatPrice(split.Ask, split.PricePrecision))
+	assert.Equal(t, "52.5000", FormatVWAP(split.VWAP, split.PricePrecision))
+	assert.Equal(t, int64(400), split.Volume)
+	assert.True(t, split.Turnover.Equal(quote.Turnover))
+	assert.Equal(t, "5.00", split.ChangePercent.StringFixed(2))
+	assert.True(t, split.Change.Equal(decimal.RequireFromString("2.5")))
+}
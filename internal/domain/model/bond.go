@@ -0,0 +1,114 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// YieldPrecision is the number of decimal places kept for yields, in percent
+	YieldPrecision = 4
+
+	// bondFaceValue is the face value bond prices are quoted against
+	bondFaceValue = 100
+
+	// minBondYield and maxBondYield bound the yield search, in percent
+	minBondYield = -5.0
+	maxBondYield = 100.0
+
+	// bondYieldIterations halves the yield search interval below 1e-12
+	bondYieldIterations = 100
+)
+
+var ErrInvalidBond = errors.New("invalid bond")
+
+// BondTerms are the cash flows of a fixed coupon bond: CouponRate percent of the face value
+// a year, paid CouponFrequency times a year on dates counted back from Maturity, when the
+// face value is repaid. Bonds are quoted by their clean price per 100 of face value and by
+// their yield to maturity.
+type BondTerms struct {
+	CouponRate      decimal.Decimal
+	CouponFrequency int
+	Maturity        time.Time
+}
+
+// IsZero reports whether the instrument is not a bond
+func (b BondTerms) IsZero() bool {
+	return b.Maturity.IsZero()
+}
+
+// Validate checks that the coupon is not negative, that coupons are paid one to twelve times
+// a year on whole months and that the bond has a maturity
+func (b BondTerms) Validate() error {
+	switch {
+	case b.CouponRate.IsNegative():
+		return fmt.Errorf("%w: coupon rate %s must not be negative", ErrInvalidBond, b.CouponRate)
+	case b.CouponFrequency <= 0 || 12%b.CouponFrequency != 0:
+		return fmt.Errorf("%w: coupon frequency %d must divide 12", ErrInvalidBond, b.CouponFrequency)
+	case b.Maturity.IsZero():
+		return fmt.Errorf("%w: maturity is required", ErrInvalidBond)
+	}
+	return nil
+}
+
+// Price returns the clean price per 100 of face value at an annual yield to maturity in
+// percent, compounded at the coupon frequency, for settlement at the given time. A matured
+// bond is priced at its face value.
+func (b BondTerms) Price(yield decimal.Decimal, settlement time.Time) decimal.Decimal {
+	return decimal.NewFromFloat(b.cleanPrice(yield.InexactFloat64(), settlement))
+}
+
+// Yield returns the annual yield to maturity in percent at which the bond is worth price per
+// 100 of face value at settlement, the inverse of Price. A matured bond yields nothing.
+func (b BondTerms) Yield(price decimal.Decimal, settlement time.Time) decimal.Decimal {
+	if !b.Maturity.After(settlement) {
+		return decimal.Zero
+	}
+
+	// The price falls as the yield rises, so the yield is bisected
+	target := price.InexactFloat64()
+	low, high := minBondYield, maxBondYield
+	for i := 0; i < bondYieldIterations; i++ {
+		middle := (low + high) / 2
+		if b.cleanPrice(middle, settlement) > target {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return decimal.NewFromFloat((low + high) / 2).Round(YieldPrecision)
+}
+
+func (b BondTerms) cleanPrice(yield float64, settlement time.Time) float64 {
+	if !b.Maturity.After(settlement) || b.Validate() != nil {
+		return bondFaceValue
+	}
+
+	frequency := float64(b.CouponFrequency)
+	coupon := b.CouponRate.InexactFloat64() / frequency
+	rate := yield / 100 / frequency
+
+	// Coupon dates are counted back from the maturity to the period settlement falls in, with
+	// remaining coupons still to be paid
+	months := 12 / b.CouponFrequency
+	remaining := 1
+	for b.Maturity.AddDate(0, -months*remaining, 0).After(settlement) {
+		remaining++
+	}
+	previous := b.Maturity.AddDate(0, -months*remaining, 0)
+	next := b.Maturity.AddDate(0, -months*(remaining-1), 0)
+	untilNext := next.Sub(settlement).Hours() / next.Sub(previous).Hours()
+
+	var dirty float64
+	for k := 0; k < remaining; k++ {
+		dirty += coupon / math.Pow(1+rate, untilNext+float64(k))
+	}
+	dirty += bondFaceValue / math.Pow(1+rate, untilNext+float64(remaining-1))
+
+	accrued := coupon * (1 - untilNext)
+	return dirty - accrued
+}
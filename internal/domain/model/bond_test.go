@@ -0,0 +1,65 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBondTerms_PriceAndYield(t *testing.T) {
+	// Arrange
+	bond := BondTerms{
+		CouponRate:      decimal.RequireFromString("4.25"),
+		CouponFrequency: 2,
+		Maturity:        time.Date(2035, 11, 15, 0, 0, 0, 0, time.UTC),
+	}
+	couponDate := time.Date(2026, 5, 15, 0, 0, 0, 0, time.UTC)
+	midPeriod := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	atPar := bond.Price(decimal.RequireFromString("4.25"), couponDate)
+	cheaper := bond.Price(decimal.RequireFromString("5.25"), midPeriod)
+	dearer := bond.Price(decimal.RequireFromString("3.25"), midPeriod)
+
+	// Assert
+	assert.Equal(t, "100.0000", atPar.StringFixed(4))
+	assert.True(t, cheaper.LessThan(decimal.NewFromInt(100)))
+	assert.True(t, dearer.GreaterThan(decimal.NewFromInt(100)))
+	assert.Equal(t, "5.2500", bond.Yield(cheaper, midPeriod).StringFixed(4))
+	assert.Equal(t, "3.2500", bond.Yield(dearer, midPeriod).StringFixed(4))
+}
+
+func TestBondTerms_Matured(t *testing.T) {
+	// Arrange
+	bond := BondTerms{CouponRate: decimal.RequireFromString("3"), CouponFrequency: 1, Maturity: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	after := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act & Assert
+	assert.Equal(t, "100", bond.Price(decimal.RequireFromString("5"), after).String())
+	assert.True(t, bond.Yield(decimal.NewFromInt(99), after).IsZero())
+}
+
+func TestBondTerms_Validate(t *testing.T) {
+	maturity := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, BondTerms{CouponRate: decimal.Zero, CouponFrequency: 4, Maturity: maturity}.Validate())
+	assert.ErrorIs(t, BondTerms{CouponRate: decimal.NewFromInt(-1), CouponFrequency: 2, Maturity: maturity}.Validate(), ErrInvalidBond)
+	assert.ErrorIs(t, BondTerms{CouponRate: decimal.NewFromInt(4), CouponFrequency: 5, Maturity: maturity}.Validate(), ErrInvalidBond)
+	assert.ErrorIs(t, BondTerms{CouponRate: decimal.NewFromInt(4), CouponFrequency: 2}.Validate(), ErrInvalidBond)
+}
+
+func TestAssetQuote_WithBond(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+	bond := BondTerms{CouponRate: decimal.RequireFromString("4.25"), CouponFrequency: 2, Maturity: time.Date(2035, 11, 15, 0, 0, 0, 0, time.UTC)}
+	quote := NewAssetQuote("UST10Y", "US Treasury", AssetClassBond, decimal.RequireFromString("100"), 0, 0, now).WithPrice(decimal.RequireFromString("100"), now).WithBond(bond)
+
+	// Act
+	moved := quote.WithPrice(decimal.RequireFromString("98.5"), now)
+
+	// Assert
+	assert.True(t, moved.Yield.GreaterThan(quote.Yield))
+	assert.Equal(t, bond.Yield(decimal.RequireFromString("98.5"), now), moved.Yield)
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CorporateActionType is what a corporate action does to the shares of a symbol
+type CorporateActionType string
+
+const (
+	CorporateActionSplit        CorporateActionType = "SPLIT"
+	CorporateActionCashDividend CorporateActionType = "CASH_DIVIDEND"
+	CorporateActionSymbolChange CorporateActionType = "SYMBOL_CHANGE"
+)
+
+// priceFactorPrecision is the number of decimal places of a price adjustment factor
+const priceFactorPrecision = 12
+
+var ErrInvalidCorporateAction = errors.New("invalid corporate action")
+
+func (t CorporateActionType) String() string {
+	return string(t)
+}
+
+// ParseCorporateActionType converts a case-insensitive name into a corporate action type
+func ParseCorporateActionType(raw string) (CorporateActionType, error) {
+	switch actionType := CorporateActionType(strings.ToUpper(strings.TrimSpace(raw))); actionType {
+	case CorporateActionSplit, CorporateActionCashDividend, CorporateActionSymbolChange:
+		return actionType, nil
+	}
+	return "", fmt.Errorf("%w: unknown type %q", ErrInvalidCorporateAction, raw)
+}
+
+// CorporateAction changes the shares of a symbol from the session of ExDate on. Prices before
+// ExDate are multiplied by PriceFactor so they compare with the prices after it: a 2-for-1
+// split halves them and a cash dividend lowers them by the fraction of the previous close it
+// pays out. A symbol change keeps the prices and moves the history to NewSymbol.
+type CorporateAction struct {
+	ID     int64
+	Symbol string
+	Type   CorporateActionType
+	ExDate time.Time
+	// SplitFrom shares become SplitTo shares: a 4-for-1 split has SplitTo 4 and SplitFrom 1
+	SplitFrom int64
+	SplitTo   int64
+	// DividendAmount is paid per share in the currency of the symbol
+	DividendAmount decimal.Decimal
+	NewSymbol      string
+	// PriceFactor is resolved by WithPriceFactor when the action is applied
+	PriceFactor decimal.Decimal
+}
+
+// Validate checks that the action carries the terms of its type
+func (a CorporateAction) Validate() error {
+	symbol, err := ParseSymbol(a.Symbol)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCorporateAction, err)
+	}
+
+	switch a.Type {
+	case CorporateActionSplit:
+		if a.SplitFrom <= 0 || a.SplitTo <= 0 {
+			return fmt.Errorf("%w: split of %s needs positive share counts, got %d-for-%d", ErrInvalidCorporateAction, a.Symbol, a.SplitTo, a.SplitFrom)
+		}
+		if a.SplitFrom == a.SplitTo {
+			return fmt.Errorf("%w: %d-for-%d split of %s changes nothing", ErrInvalidCorporateAction, a.SplitTo, a.SplitFrom, a.Symbol)
+		}
+	case CorporateActionCashDividend:
+		if !a.DividendAmount.IsPositive() {
+			return fmt.Errorf("%w: dividend of %s must be positive, got %s", ErrInvalidCorporateAction, a.Symbol, a.DividendAmount)
+		}
+	case CorporateActionSymbolChange:
+		newSymbol, err := ParseSymbol(a.NewSymbol)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidCorporateAction, err)
+		}
+		if newSymbol == symbol {
+			return fmt.Errorf("%w: %s is renamed to itself", ErrInvalidCorporateAction, a.Symbol)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidCorporateAction, a.Type)
+	}
+	return nil
+}
+
+// WithPriceFactor returns the action with its price factor resolved against the previous
+// close of the symbol, which a cash dividend is a fraction of
+func (a CorporateAction) WithPriceFactor(previousClose decimal.Decimal) (CorporateAction, error) {
+	if err := a.Validate(); err != nil {
+		return CorporateAction{}, err
+	}
+
+	switch a.Type {
+	case CorporateActionSplit:
+		a.PriceFactor = decimal.NewFromInt(a.SplitFrom).DivRound(decimal.NewFromInt(a.SplitTo), priceFactorPrecision)
+	case CorporateActionCashDividend:
+		if !a.DividendAmount.LessThan(previousClose) {
+			return CorporateAction{}, fmt.Errorf("%w: dividend %s of %s is not below its previous close %s",
+				ErrInvalidCorporateAction, a.DividendAmount, a.Symbol, previousClose)
+		}
+		a.PriceFactor = previousClose.Sub(a.DividendAmount).DivRound(previousClose, priceFactorPrecision)
+	default:
+		a.PriceFactor = decimal.NewFromInt(1)
+	}
+	return a, nil
+}
+
+// SizeFactor multiplies the share quantities before ExDate: the inverse of the split ratio,
+// and one for the other actions
+func (a CorporateAction) SizeFactor() decimal.Decimal {
+	if a.Type != CorporateActionSplit || a.SplitFrom <= 0 {
+		return decimal.NewFromInt(1)
+	}
+	return decimal.NewFromInt(a.SplitTo).DivRound(decimal.NewFromInt(a.SplitFrom), priceFactorPrecision)
+}
+
+// TargetSymbol is the symbol the shares trade under after the action
+func (a CorporateAction) TargetSymbol() string {
+	if a.Type == CorporateActionSymbolChange {
+		return a.NewSymbol
+	}
+	return a.Symbol
+}
+
+// HasCorporateActions reports whether instruments of this class are shares that split, pay
+// dividends and change symbols
+func (c AssetClass) HasCorporateActions() bool {
+	return c == AssetClassStock || c == AssetClassETF
+}
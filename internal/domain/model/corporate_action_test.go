@@ -0,0 +1,71 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorporateAction_WithPriceFactor(t *testing.T) {
+	// Arrange
+	exDate := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+	previousClose := decimal.RequireFromString("200")
+	split := CorporateAction{Symbol: "AAPL", Type: CorporateActionSplit, ExDate: exDate, SplitFrom: 1, SplitTo: 4}
+	reverseSplit := CorporateAction{Symbol: "AAPL", Type: CorporateActionSplit, ExDate: exDate, SplitFrom: 10, SplitTo: 1}
+	dividend := CorporateAction{Symbol: "AAPL", Type: CorporateActionCashDividend, ExDate: exDate, DividendAmount: decimal.RequireFromString("2.50")}
+	rename := CorporateAction{Symbol: "FB", Type: CorporateActionSymbolChange, ExDate: exDate, NewSymbol: "META"}
+
+	// Act
+	splitResolved, splitErr := split.WithPriceFactor(previousClose)
+	reverseResolved, reverseErr := reverseSplit.WithPriceFactor(previousClose)
+	dividendResolved, dividendErr := dividend.WithPriceFactor(previousClose)
+	renameResolved, renameErr := rename.WithPriceFactor(previousClose)
+
+	// Assert
+	require.NoError(t, splitErr)
+	require.NoError(t, reverseErr)
+	require.NoError(t, dividendErr)
+	require.NoError(t, renameErr)
+	assert.Equal(t, "0.25", splitResolved.PriceFactor.String())
+	assert.Equal(t, "4", split.SizeFactor().String())
+	assert.Equal(t, "10", reverseResolved.PriceFactor.String())
+	assert.Equal(t, "0.1", reverseSplit.SizeFactor().String())
+	assert.Equal(t, "0.9875", dividendResolved.PriceFactor.String())
+	assert.Equal(t, "1", dividend.SizeFactor().String())
+	assert.Equal(t, "1", renameResolved.PriceFactor.String())
+	assert.Equal(t, "META", rename.TargetSymbol())
+	assert.Equal(t, "AAPL", split.TargetSymbol())
+}
+
+func TestCorporateAction_Invalid(t *testing.T) {
+	previousClose := decimal.RequireFromString("2")
+	tests := map[string]CorporateAction{
+		"invalid symbol":       {Symbol: "AAPL$", Type: CorporateActionSplit, SplitFrom: 1, SplitTo: 2},
+		"unknown type":         {Symbol: "AAPL", Type: "MERGER"},
+		"no split ratio":       {Symbol: "AAPL", Type: CorporateActionSplit, SplitTo: 2},
+		"one-for-one split":    {Symbol: "AAPL", Type: CorporateActionSplit, SplitFrom: 3, SplitTo: 3},
+		"no dividend":          {Symbol: "AAPL", Type: CorporateActionCashDividend},
+		"dividend above close": {Symbol: "AAPL", Type: CorporateActionCashDividend, DividendAmount: decimal.RequireFromString("2")},
+		"no new symbol":        {Symbol: "AAPL", Type: CorporateActionSymbolChange},
+		"same symbol":          {Symbol: "AAPL", Type: CorporateActionSymbolChange, NewSymbol: "aapl"},
+	}
+
+	for name, action := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := action.WithPriceFactor(previousClose)
+			assert.ErrorIs(t, err, ErrInvalidCorporateAction)
+		})
+	}
+}
+
+func TestParseCorporateActionType(t *testing.T) {
+	actionType, err := ParseCorporateActionType(" cash_dividend ")
+	require.NoError(t, err)
+	assert.Equal(t, CorporateActionCashDividend, actionType)
+
+	_, err = ParseCorporateActionType("MERGER")
+	assert.ErrorIs(t, err, ErrInvalidCorporateAction)
+}
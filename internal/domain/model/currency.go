@@ -0,0 +1,62 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// ParseCurrency converts a case-insensitive ISO 4217 code into its canonical uppercase form
+func ParseCurrency(raw string) (string, error) {
+	currency := strings.ToUpper(strings.TrimSpace(raw))
+	if !currencyPattern.MatchString(currency) {
+		return "", fmt.Errorf("%w: %q must be a three letter code", ErrInvalidCurrency, raw)
+	}
+	return currency, nil
+}
+
+// InCurrency returns the quote with its prices converted into currency at rate, the price of
+// one unit of the quote currency in currency. Converted prices are for display, not tradable
+// increments: they keep the price precision and the tick becomes one unit of its last
+// decimal place.
+func (q AssetQuote) InCurrency(currency string, rate decimal.Decimal) AssetQuote {
+	if currency == q.Currency {
+		return q
+	}
+
+	convert := func(price decimal.Decimal) decimal.Decimal {
+		return price.Mul(rate).Round(q.PricePrecision)
+	}
+
+	q.CurrentPrice = convert(q.CurrentPrice)
+	q.BasePrice = convert(q.BasePrice)
+	q.OpenPrice = convert(q.OpenPrice)
+	q.HighPrice = convert(q.HighPrice)
+	q.LowPrice = convert(q.LowPrice)
+	q.PreviousClose = convert(q.PreviousClose)
+	q.Bid = convert(q.Bid)
+	q.Ask = convert(q.Ask)
+	q.Turnover = q.Turnover.Mul(rate)
+	q.VWAP = q.VWAP.Mul(rate).Round(q.PricePrecision + vwapExtraPrecision)
+	q.NAV = q.NAV.Mul(rate).Round(q.PricePrecision + navExtraPrecision)
+	q.Currency = currency
+	q.TickSize = decimal.New(1, -q.PricePrecision)
+	return q.withDayChange()
+}
+
+// InCurrency returns the market data with its last quote converted into currency at rate,
+// like AssetQuote.InCurrency
+func (m MarketDataModel) InCurrency(currency string, rate decimal.Decimal) MarketDataModel {
+	if currency == m.Currency {
+		return m
+	}
+
+	m.LastQuote = m.LastQuote.Mul(rate).Round(m.PricePrecision)
+	m.Currency = currency
+	m.TickSize = decimal.New(1, -m.PricePrecision)
+	return m
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	// Act
+	currency, err := ParseCurrency(" brl ")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "BRL", currency)
+	for _, raw := range []string{"", "US", "EURO", "U$D"} {
+		_, err := ParseCurrency(raw)
+		assert.ErrorIs(t, err, ErrInvalidCurrency, raw)
+	}
+}
+
+func TestAssetQuote_InCurrency(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("150.00"), 0, 0, time.Now()).
+		WithPrice(decimal.RequireFromString("151.50"), time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)).
+		WithBidAsk(decimal.RequireFromString("151.49"), decimal.RequireFromString("151.51"), 100, 200)
+	rate := decimal.RequireFromString("5.0421")
+
+	// Act
+	converted := quote.InCurrency("BRL", rate)
+
+	// Assert
+	assert.Equal(t, "BRL", converted.Currency)
+	assert.Equal(t, "763.88", converted.FormatPrice(converted.CurrentPrice))
+	assert.Equal(t, "763.83", converted.FormatPrice(converted.Bid))
+	assert.Equal(t, "763.93", converted.FormatPrice(converted.Ask))
+	assert.Equal(t, "0.01", converted.Tick().String())
+	assert.Equal(t, converted.CurrentPrice.Sub(converted.PreviousClose).String(), converted.Change.String())
+	assert.Equal(t, "USD", quote.Currency)
+	assert.Equal(t, quote, quote.InCurrency("USD", rate))
+}
+
+func TestMarketDataModel_InCurrency(t *testing.T) {
+	// Arrange
+	data := MarketDataModel{Symbol: "AAPL", LastQuote: decimal.RequireFromString("150.25"), AssetClass: AssetClassStock, Instrument: AssetClassStock.DefaultInstrument()}
+
+	// Act
+	converted := data.InCurrency("EUR", decimal.RequireFromString("0.9221"))
+
+	// Assert
+	assert.Equal(t, "EUR", converted.Currency)
+	assert.Equal(t, "138.55", converted.FormatPrice(converted.LastQuote))
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	calendarDateLayout = "2006-01-02"
+	fullDay            = 24 * time.Hour
+
+	// maxCalendarLookahead bounds the search for the next status change, enough to cross
+	// a weekend next to a long holiday break
+	maxCalendarLookahead = 14
+)
+
+// TradingWindow is a daily session expressed as offsets from local midnight. The zero
+// window means the exchange has no such session.
+type TradingWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseTradingWindow parses "HH:MM-HH:MM" in exchange local time. An empty string is the
+// zero window and "24:00" may be used as the end of the day.
+func ParseTradingWindow(raw string) (TradingWindow, error) {
+	if strings.TrimSpace(raw) == "" {
+		return TradingWindow{}, nil
+	}
+
+	rawStart, rawEnd, found := strings.Cut(raw, "-")
+	if !found {
+		return TradingWindow{}, fmt.Errorf("invalid trading window %q, expected HH:MM-HH:MM", raw)
+	}
+
+	start, err := parseTimeOfDay(rawStart)
+	if err != nil {
+		return TradingWindow{}, fmt.Errorf("invalid trading window %q: %w", raw, err)
+	}
+	end, err := parseTimeOfDay(rawEnd)
+	if err != nil {
+		return TradingWindow{}, fmt.Errorf("invalid trading window %q: %w", raw, err)
+	}
+	if end <= start {
+		return TradingWindow{}, fmt.Errorf("invalid trading window %q: end must be after start", raw)
+	}
+
+	return TradingWindow{Start: start, End: end}, nil
+}
+
+func parseTimeOfDay(raw string) (time.Duration, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "24:00" {
+		return fullDay, nil
+	}
+
+	parsed, err := time.Parse("15:04", raw)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a HH:MM time", raw)
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+func (w TradingWindow) IsZero() bool {
+	return w.End == 0
+}
+
+func (w TradingWindow) contains(offset time.Duration) bool {
+	return !w.IsZero() && offset >= w.Start && offset < w.End
+}
+
+// ExchangeCalendar holds the trading hours of one exchange: its time zone, the pre-market,
+// regular and post-market windows, the weekdays it trades on and its full-day holidays
+type ExchangeCalendar struct {
+	Code        string
+	Name        string
+	Location    *time.Location
+	PreMarket   TradingWindow
+	Regular     TradingWindow
+	PostMarket  TradingWindow
+	TradingDays map[time.Weekday]bool
+	Holidays    map[string]bool
+}
+
+// IsTradingDay reports whether the exchange trades on the local date of t
+func (c ExchangeCalendar) IsTradingDay(t time.Time) bool {
+	local := t.In(c.Location)
+	return c.TradingDays[local.Weekday()] && !c.Holidays[local.Format(calendarDateLayout)]
+}
+
+// StatusAt returns the status of the exchange at t
+func (c ExchangeCalendar) StatusAt(t time.Time) MarketStatus {
+	local := t.In(c.Location)
+	if !c.IsTradingDay(local) {
+		return MarketStatusClosed
+	}
+
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Location)
+	offset := local.Sub(midnight)
+
+	switch {
+	case c.Regular.contains(offset):
+		return MarketStatusOpen
+	case c.PreMarket.contains(offset):
+		return MarketStatusPreMarket
+	case c.PostMarket.contains(offset):
+		return MarketStatusPostMarket
+	default:
+		return MarketStatusClosed
+	}
+}
+
+// NextStatusChange returns the first session boundary after t at which the status differs
+// from the status at t, or the zero time when none is found within two weeks (an exchange
+// that trades around the clock)
+func (c ExchangeCalendar) NextStatusChange(t time.Time) time.Time {
+	current := c.StatusAt(t)
+	local := t.In(c.Location)
+
+	for day := 0; day <= maxCalendarLookahead; day++ {
+		midnight := time.Date(local.Year(), local.Month(), local.Day()+day, 0, 0, 0, 0, c.Location)
+
+		for _, boundary := range c.boundaries() {
+			at := midnight.Add(boundary)
+			if at.After(t) && c.StatusAt(at) != current {
+				return at
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+// boundaries returns the offsets at which any window starts or ends, in order. Midnight is
+// included so that day changes into and out of holidays are found.
+func (c ExchangeCalendar) boundaries() []time.Duration {
+	offsets := []time.Duration{0}
+	for _, window := range []TradingWindow{c.PreMarket, c.Regular, c.PostMarket} {
+		if !window.IsZero() {
+			offsets = append(offsets, window.Start, window.End)
+		}
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets
+}
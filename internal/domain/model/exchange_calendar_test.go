@@ -0,0 +1,117 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUSCalendar(t *testing.T) ExchangeCalendar {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	preMarket, err := ParseTradingWindow("04:00-09:30")
+	require.NoError(t, err)
+	regular, err := ParseTradingWindow("09:30-16:00")
+	require.NoError(t, err)
+	postMarket, err := ParseTradingWindow("16:00-20:00")
+	require.NoError(t, err)
+
+	return ExchangeCalendar{
+		Code:       "US",
+		Location:   location,
+		PreMarket:  preMarket,
+		Regular:    regular,
+		PostMarket: postMarket,
+		TradingDays: map[time.Weekday]bool{
+			time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true,
+		},
+		Holidays: map[string]bool{"2026-07-03": true},
+	}
+}
+
+func TestParseTradingWindow(t *testing.T) {
+	window, err := ParseTradingWindow("09:30-16:00")
+	require.NoError(t, err)
+	assert.Equal(t, 9*time.Hour+30*time.Minute, window.Start)
+	assert.Equal(t, 16*time.Hour, window.End)
+
+	allDay, err := ParseTradingWindow("00:00-24:00")
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, allDay.End)
+
+	none, err := ParseTradingWindow("")
+	require.NoError(t, err)
+	assert.True(t, none.IsZero())
+
+	for _, raw := range []string{"09:30", "16:00-09:30", "9h-16h"} {
+		_, err := ParseTradingWindow(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestExchangeCalendar_StatusAt(t *testing.T) {
+	// Arrange
+	calendar := newTestUSCalendar(t)
+	newYork := calendar.Location
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected MarketStatus
+	}{
+		{"overnight", time.Date(2026, 3, 10, 3, 0, 0, 0, newYork), MarketStatusClosed},
+		{"pre-market", time.Date(2026, 3, 10, 8, 0, 0, 0, newYork), MarketStatusPreMarket},
+		{"regular open", time.Date(2026, 3, 10, 9, 30, 0, 0, newYork), MarketStatusOpen},
+		{"post-market", time.Date(2026, 3, 10, 16, 0, 0, 0, newYork), MarketStatusPostMarket},
+		{"after hours", time.Date(2026, 3, 10, 20, 0, 0, 0, newYork), MarketStatusClosed},
+		{"weekend", time.Date(2026, 3, 14, 12, 0, 0, 0, newYork), MarketStatusClosed},
+		{"holiday", time.Date(2026, 7, 3, 12, 0, 0, 0, newYork), MarketStatusClosed},
+		{"UTC instant during the regular session", time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC), MarketStatusOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act & Assert
+			assert.Equal(t, tt.expected, calendar.StatusAt(tt.at))
+		})
+	}
+}
+
+func TestExchangeCalendar_NextStatusChange(t *testing.T) {
+	// Arrange
+	calendar := newTestUSCalendar(t)
+	newYork := calendar.Location
+
+	// Act
+	duringSession := calendar.NextStatusChange(time.Date(2026, 3, 10, 11, 0, 0, 0, newYork))
+	fridayNight := calendar.NextStatusChange(time.Date(2026, 3, 13, 21, 0, 0, 0, newYork))
+	beforeHoliday := calendar.NextStatusChange(time.Date(2026, 7, 2, 21, 0, 0, 0, newYork))
+
+	// Assert
+	assert.True(t, time.Date(2026, 3, 10, 16, 0, 0, 0, newYork).Equal(duringSession))
+	assert.True(t, time.Date(2026, 3, 16, 4, 0, 0, 0, newYork).Equal(fridayNight))
+	assert.True(t, time.Date(2026, 7, 6, 4, 0, 0, 0, newYork).Equal(beforeHoliday))
+}
+
+func TestExchangeCalendar_NextStatusChange_AlwaysOpen(t *testing.T) {
+	// Arrange
+	regular, err := ParseTradingWindow("00:00-24:00")
+	require.NoError(t, err)
+
+	calendar := ExchangeCalendar{
+		Code:     "CRYPTO",
+		Location: time.UTC,
+		Regular:  regular,
+		TradingDays: map[time.Weekday]bool{
+			time.Sunday: true, time.Monday: true, time.Tuesday: true, time.Wednesday: true,
+			time.Thursday: true, time.Friday: true, time.Saturday: true,
+		},
+	}
+
+	// Act & Assert
+	assert.Equal(t, MarketStatusOpen, calendar.StatusAt(time.Date(2026, 3, 14, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, calendar.NextStatusChange(time.Now()).IsZero())
+}
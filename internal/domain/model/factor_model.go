@@ -0,0 +1,186 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MarketFactor is the broad market factor of the default factor model
+const MarketFactor = "MARKET"
+
+// correlationTolerance is how far a correlation matrix may be from symmetric with a unit
+// diagonal, to allow for rounding in configuration files
+const correlationTolerance = 1e-9
+
+var ErrInvalidFactorModel = errors.New("invalid factor model")
+
+// Factor is a common driver of prices. Volatility is the standard deviation of its level as
+// a fraction of the price, e.g. 0.004 for 0.4%.
+type Factor struct {
+	Name       string
+	Volatility float64
+}
+
+// FactorExposure describes how an instrument moves: Betas to factors by name plus an
+// independent move with a standard deviation of IdiosyncraticVolatility
+type FactorExposure struct {
+	Betas                   map[string]float64
+	IdiosyncraticVolatility float64
+}
+
+// FactorModel correlates the simulated prices of instruments through common factors.
+// Correlation is the correlation matrix of the factors in the order of Factors and
+// Persistence, from 0 up to but excluding 1, is the share of its level a factor keeps from
+// one tick to the next. Instruments use the exposure of their symbol, falling back to the
+// exposure of their asset class.
+type FactorModel struct {
+	Factors      []Factor
+	Correlation  [][]float64
+	Persistence  float64
+	AssetClasses map[AssetClass]FactorExposure
+	Symbols      map[string]FactorExposure
+}
+
+// DefaultFactorModel moves every instrument with a single market factor, with betas and
+// idiosyncratic volatilities by asset class. Bond deviations move their yield, so yields rise
+// and bond prices fall with the market.
+func DefaultFactorModel() FactorModel {
+	market := func(beta, idiosyncraticVolatility float64) FactorExposure {
+		return FactorExposure{
+			Betas:                   map[string]float64{MarketFactor: beta},
+			IdiosyncraticVolatility: idiosyncraticVolatility,
+		}
+	}
+
+	return FactorModel{
+		Factors:     []Factor{{Name: MarketFactor, Volatility: 0.004}},
+		Correlation: [][]float64{{1}},
+		Persistence: 0.9,
+		AssetClasses: map[AssetClass]FactorExposure{
+			AssetClassStock:  market(1, 0.004),
+			AssetClassETF:    market(1, 0.001),
+			AssetClassREIT:   market(0.8, 0.004),
+			AssetClassBond:   market(0.2, 0.002),
+			AssetClassCrypto: market(1.5, 0.01),
+			AssetClassFX:     market(0, 0.002),
+			AssetClassIndex:  market(1, 0.0005),
+			AssetClassOption: market(1, 0.004),
+		},
+	}
+}
+
+// ExposureOf returns the exposure the quote is simulated with. A symbol exposure without
+// betas uses the betas of the asset class, and one without an idiosyncratic volatility the
+// volatility of the asset class.
+func (m FactorModel) ExposureOf(quote AssetQuote) FactorExposure {
+	exposure := m.AssetClasses[quote.AssetClass]
+
+	override, exists := m.Symbols[quote.Symbol]
+	if !exists {
+		return exposure
+	}
+	if len(override.Betas) > 0 {
+		exposure.Betas = override.Betas
+	}
+	if override.IdiosyncraticVolatility > 0 {
+		exposure.IdiosyncraticVolatility = override.IdiosyncraticVolatility
+	}
+	return exposure
+}
+
+// Validate checks the factors, the correlation matrix and that every beta refers to a factor
+func (m FactorModel) Validate() error {
+	if m.Persistence < 0 || m.Persistence >= 1 {
+		return fmt.Errorf("%w: persistence %v must be at least 0 and less than 1", ErrInvalidFactorModel, m.Persistence)
+	}
+
+	factors := make(map[string]bool, len(m.Factors))
+	for _, factor := range m.Factors {
+		if factor.Name == "" {
+			return fmt.Errorf("%w: factor without a name", ErrInvalidFactorModel)
+		}
+		if factors[factor.Name] {
+			return fmt.Errorf("%w: duplicate factor %s", ErrInvalidFactorModel, factor.Name)
+		}
+		if factor.Volatility < 0 {
+			return fmt.Errorf("%w: factor %s has a negative volatility", ErrInvalidFactorModel, factor.Name)
+		}
+		factors[factor.Name] = true
+	}
+
+	if _, err := m.Cholesky(); err != nil {
+		return err
+	}
+
+	validateExposure := func(owner string, exposure FactorExposure) error {
+		if exposure.IdiosyncraticVolatility < 0 {
+			return fmt.Errorf("%w: %s has a negative idiosyncratic volatility", ErrInvalidFactorModel, owner)
+		}
+		for factor := range exposure.Betas {
+			if !factors[factor] {
+				return fmt.Errorf("%w: %s has a beta to unknown factor %s", ErrInvalidFactorModel, owner, factor)
+			}
+		}
+		return nil
+	}
+	for assetClass, exposure := range m.AssetClasses {
+		if err := validateExposure(fmt.Sprintf("asset class %s", assetClass), exposure); err != nil {
+			return err
+		}
+	}
+	for symbol, exposure := range m.Symbols {
+		if err := validateExposure(fmt.Sprintf("symbol %s", symbol), exposure); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Cholesky returns the lower triangular matrix L with L·Lᵀ equal to the correlation matrix,
+// which turns independent standard normal draws into draws with that correlation. It fails
+// unless the matrix is a valid, positive definite correlation matrix of the factors.
+func (m FactorModel) Cholesky() ([][]float64, error) {
+	n := len(m.Factors)
+	if len(m.Correlation) != n {
+		return nil, fmt.Errorf("%w: correlation matrix has %d rows for %d factors", ErrInvalidFactorModel, len(m.Correlation), n)
+	}
+
+	for i, row := range m.Correlation {
+		if len(row) != n {
+			return nil, fmt.Errorf("%w: correlation row %d has %d columns for %d factors", ErrInvalidFactorModel, i, len(row), n)
+		}
+		if math.Abs(row[i]-1) > correlationTolerance {
+			return nil, fmt.Errorf("%w: correlation of %s with itself must be 1", ErrInvalidFactorModel, m.Factors[i].Name)
+		}
+		for j, correlation := range row {
+			if math.Abs(correlation) > 1 || math.Abs(correlation-m.Correlation[j][i]) > correlationTolerance {
+				return nil, fmt.Errorf("%w: correlation of %s and %s must be symmetric and between -1 and 1",
+					ErrInvalidFactorModel, m.Factors[i].Name, m.Factors[j].Name)
+			}
+		}
+	}
+
+	lower := make([][]float64, n)
+	for i := range lower {
+		lower[i] = make([]float64, n)
+		for j := 0; j <= i; j++ {
+			sum := m.Correlation[i][j]
+			for k := 0; k < j; k++ {
+				sum -= lower[i][k] * lower[j][k]
+			}
+
+			if i != j {
+				lower[i][j] = sum / lower[j][j]
+				continue
+			}
+			if sum <= 0 {
+				return nil, fmt.Errorf("%w: correlation matrix is not positive definite", ErrInvalidFactorModel)
+			}
+			lower[i][i] = math.Sqrt(sum)
+		}
+	}
+
+	return lower, nil
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactorModel_Cholesky(t *testing.T) {
+	// Arrange
+	factorModel := FactorModel{
+		Factors:     []Factor{{Name: "A"}, {Name: "B"}},
+		Correlation: [][]float64{{1, 0.6}, {0.6, 1}},
+	}
+
+	// Act
+	lower, err := factorModel.Cholesky()
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 1, lower[0][0], 1e-12)
+	assert.InDelta(t, 0, lower[0][1], 1e-12)
+	assert.InDelta(t, 0.6, lower[1][0], 1e-12)
+	assert.InDelta(t, 0.8, lower[1][1], 1e-12)
+}
+
+func TestFactorModel_Validate(t *testing.T) {
+	valid := DefaultFactorModel()
+	tests := map[string]func(FactorModel) FactorModel{
+		"persistence of 1": func(m FactorModel) FactorModel {
+			m.Persistence = 1
+			return m
+		},
+		"negative volatility": func(m FactorModel) FactorModel {
+			m.Factors = []Factor{{Name: MarketFactor, Volatility: -1}}
+			return m
+		},
+		"asymmetric correlation": func(m FactorModel) FactorModel {
+			m.Factors = []Factor{{Name: MarketFactor}, {Name: "TECH"}}
+			m.Correlation = [][]float64{{1, 0.5}, {0.4, 1}}
+			return m
+		},
+		"diagonal not 1": func(m FactorModel) FactorModel {
+			m.Correlation = [][]float64{{0.5}}
+			return m
+		},
+		"unknown factor": func(m FactorModel) FactorModel {
+			m.Symbols = map[string]FactorExposure{"AAPL": {Betas: map[string]float64{"TECH": 1}}}
+			return m
+		},
+	}
+
+	assert.NoError(t, valid.Validate())
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			err := mutate(DefaultFactorModel()).Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidFactorModel)
+		})
+	}
+}
+
+func TestFactorModel_ExposureOf(t *testing.T) {
+	// Arrange
+	factorModel := DefaultFactorModel()
+	factorModel.Symbols = map[string]FactorExposure{
+		"TSLA": {IdiosyncraticVolatility: 0.01},
+		"XLK":  {Betas: map[string]float64{MarketFactor: 1.2}},
+	}
+	quote := func(symbol string, assetClass AssetClass) AssetQuote {
+		return NewAssetQuote(symbol, symbol, assetClass, decimal.NewFromInt(100), 0, 0, time.Now())
+	}
+
+	// Act
+	apple := factorModel.ExposureOf(quote("AAPL", AssetClassStock))
+	tesla := factorModel.ExposureOf(quote("TSLA", AssetClassStock))
+	tech := factorModel.ExposureOf(quote("XLK", AssetClassETF))
+
+	// Assert
+	assert.Equal(t, factorModel.AssetClasses[AssetClassStock], apple)
+	assert.Equal(t, apple.Betas, tesla.Betas)
+	assert.Equal(t, 0.01, tesla.IdiosyncraticVolatility)
+	assert.Equal(t, 1.2, tech.Betas[MarketFactor])
+	assert.Equal(t, factorModel.AssetClasses[AssetClassETF].IdiosyncraticVolatility, tech.IdiosyncraticVolatility)
+}
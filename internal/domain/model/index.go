@@ -0,0 +1,168 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// indexUnitsPrecision is the number of decimal places kept for units converted from percent
+// weights
+const indexUnitsPrecision = 10
+
+var ErrInvalidIndex = errors.New("invalid index")
+
+// IndexConstituent is a member of an index and the number of units of it the index holds
+type IndexConstituent struct {
+	Symbol Symbol
+	Weight decimal.Decimal
+}
+
+// IndexDefinition computes the level of an index from the prices of its constituents: the sum
+// of each constituent price times its weight, divided by Divisor. The divisor scales the sum
+// to the published level, so the level moves by the same percentage as the basket. The
+// holdings of an ETF are defined the same way, with its net asset value per share as level.
+type IndexDefinition struct {
+	Symbol       Symbol
+	Name         string
+	Constituents []IndexConstituent
+	Divisor      decimal.Decimal
+}
+
+// Validate checks that the index has constituents, each listed once with a positive weight,
+// and a positive divisor
+func (d IndexDefinition) Validate() error {
+	if len(d.Constituents) == 0 {
+		return fmt.Errorf("%w: %s has no constituents", ErrInvalidIndex, d.Symbol)
+	}
+
+	seen := make(map[Symbol]bool, len(d.Constituents))
+	for _, constituent := range d.Constituents {
+		switch {
+		case constituent.Symbol == d.Symbol:
+			return fmt.Errorf("%w: %s cannot be its own constituent", ErrInvalidIndex, d.Symbol)
+		case seen[constituent.Symbol]:
+			return fmt.Errorf("%w: %s lists %s twice", ErrInvalidIndex, d.Symbol, constituent.Symbol)
+		case !constituent.Weight.IsPositive():
+			return fmt.Errorf("%w: %s weight of %s must be positive", ErrInvalidIndex, d.Symbol, constituent.Symbol)
+		}
+		seen[constituent.Symbol] = true
+	}
+
+	if !d.Divisor.IsPositive() {
+		return fmt.Errorf("%w: %s divisor must be positive", ErrInvalidIndex, d.Symbol)
+	}
+	return nil
+}
+
+// Symbols returns the constituent symbols in definition order
+func (d IndexDefinition) Symbols() []Symbol {
+	symbols := make([]Symbol, len(d.Constituents))
+	for i, constituent := range d.Constituents {
+		symbols[i] = constituent.Symbol
+	}
+	return symbols
+}
+
+// Level computes the level of the index from the constituent prices returned by priceOf
+func (d IndexDefinition) Level(priceOf func(Symbol) (decimal.Decimal, bool)) (decimal.Decimal, error) {
+	if !d.Divisor.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%w: %s divisor must be positive", ErrInvalidIndex, d.Symbol)
+	}
+
+	basket, err := d.basketValue(priceOf)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return basket.Div(d.Divisor), nil
+}
+
+// WithBaseLevel returns the definition with the divisor that puts the index at baseLevel at
+// the constituent prices returned by priceOf
+func (d IndexDefinition) WithBaseLevel(baseLevel decimal.Decimal, priceOf func(Symbol) (decimal.Decimal, bool)) (IndexDefinition, error) {
+	if !baseLevel.IsPositive() {
+		return d, fmt.Errorf("%w: %s base level must be positive", ErrInvalidIndex, d.Symbol)
+	}
+
+	basket, err := d.basketValue(priceOf)
+	if err != nil {
+		return d, err
+	}
+	d.Divisor = basket.Div(baseLevel)
+	return d, nil
+}
+
+func (d IndexDefinition) basketValue(priceOf func(Symbol) (decimal.Decimal, bool)) (decimal.Decimal, error) {
+	basket := decimal.Zero
+	for _, constituent := range d.Constituents {
+		price, exists := priceOf(constituent.Symbol)
+		if !exists {
+			return decimal.Zero, fmt.Errorf("%w: %s has no price for constituent %s", ErrInvalidIndex, d.Symbol, constituent.Symbol)
+		}
+		basket = basket.Add(price.Mul(constituent.Weight))
+	}
+	return basket, nil
+}
+
+// IndexWeighting is how the weights of an IndexSpec are read
+type IndexWeighting string
+
+const (
+	// IndexWeightingUnits weights are the units of each constituent the basket holds
+	IndexWeightingUnits IndexWeighting = "UNITS"
+	// IndexWeightingPercent weights are the shares of the basket value each constituent makes
+	// up at the base level, in percent
+	IndexWeightingPercent IndexWeighting = "PERCENT"
+)
+
+// ParseIndexWeighting converts a case-insensitive name into a weighting; empty means units
+func ParseIndexWeighting(raw string) (IndexWeighting, error) {
+	switch weighting := IndexWeighting(strings.ToUpper(strings.TrimSpace(raw))); weighting {
+	case "":
+		return IndexWeightingUnits, nil
+	case IndexWeightingUnits, IndexWeightingPercent:
+		return weighting, nil
+	}
+	return "", fmt.Errorf("%w: unknown weighting %q", ErrInvalidIndex, raw)
+}
+
+// IndexSpec configures an index, or the holdings of an ETF, before its divisor is known. The
+// index starts at BaseLevel; the holdings of an ETF without one start at its current price.
+type IndexSpec struct {
+	Definition IndexDefinition
+	Weighting  IndexWeighting
+	BaseLevel  decimal.Decimal
+}
+
+// Resolve returns the definition of the index, starting at BaseLevel at the constituent
+// prices returned by priceOf. Percent weights become the units of each constituent they buy
+// at those prices.
+func (s IndexSpec) Resolve(priceOf func(Symbol) (decimal.Decimal, bool)) (IndexDefinition, error) {
+	definition := s.Definition
+	definition.Divisor = decimal.NewFromInt(1)
+	if err := definition.Validate(); err != nil {
+		return IndexDefinition{}, err
+	}
+
+	if s.Weighting == IndexWeightingPercent {
+		total := decimal.Zero
+		for _, constituent := range definition.Constituents {
+			total = total.Add(constituent.Weight)
+		}
+
+		constituents := make([]IndexConstituent, len(definition.Constituents))
+		for i, constituent := range definition.Constituents {
+			price, exists := priceOf(constituent.Symbol)
+			if !exists || !price.IsPositive() {
+				return IndexDefinition{}, fmt.Errorf("%w: %s has no price for constituent %s", ErrInvalidIndex, definition.Symbol, constituent.Symbol)
+			}
+			value := s.BaseLevel.Mul(constituent.Weight).Div(total)
+			constituents[i] = IndexConstituent{Symbol: constituent.Symbol, Weight: value.DivRound(price, indexUnitsPrecision)}
+		}
+		definition.Constituents = constituents
+	}
+
+	return definition.WithBaseLevel(s.BaseLevel, priceOf)
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexDefinition_Level(t *testing.T) {
+	// Arrange
+	prices := map[Symbol]decimal.Decimal{"AAPL": decimal.RequireFromString("200"), "MSFT": decimal.RequireFromString("400")}
+	priceOf := func(symbol Symbol) (decimal.Decimal, bool) {
+		price, exists := prices[symbol]
+		return price, exists
+	}
+	definition := IndexDefinition{
+		Symbol: "TECH2",
+		Constituents: []IndexConstituent{
+			{Symbol: "AAPL", Weight: decimal.NewFromInt(2)},
+			{Symbol: "MSFT", Weight: decimal.NewFromInt(1)},
+		},
+	}
+
+	// Act
+	definition, err := definition.WithBaseLevel(decimal.NewFromInt(1000), priceOf)
+	require.NoError(t, err)
+	prices["AAPL"] = decimal.RequireFromString("220")
+	level, err := definition.Level(priceOf)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NoError(t, definition.Validate())
+	assert.Equal(t, "1050", level.String())
+	assert.Equal(t, []Symbol{"AAPL", "MSFT"}, definition.Symbols())
+
+	delete(prices, "MSFT")
+	_, err = definition.Level(priceOf)
+	assert.ErrorIs(t, err, ErrInvalidIndex)
+}
+
+func TestIndexDefinition_Validate(t *testing.T) {
+	one := decimal.NewFromInt(1)
+	tests := map[string]IndexDefinition{
+		"no constituents":  {Symbol: "IDX", Divisor: one},
+		"self constituent": {Symbol: "IDX", Divisor: one, Constituents: []IndexConstituent{{Symbol: "IDX", Weight: one}}},
+		"duplicate":        {Symbol: "IDX", Divisor: one, Constituents: []IndexConstituent{{Symbol: "A", Weight: one}, {Symbol: "A", Weight: one}}},
+		"zero weight":      {Symbol: "IDX", Divisor: one, Constituents: []IndexConstituent{{Symbol: "A", Weight: decimal.Zero}}},
+		"no divisor":       {Symbol: "IDX", Constituents: []IndexConstituent{{Symbol: "A", Weight: one}}},
+	}
+
+	for name, definition := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, definition.Validate(), ErrInvalidIndex)
+		})
+	}
+}
+
+func TestIndexSpec_ResolvePercentWeights(t *testing.T) {
+	// Arrange
+	prices := map[Symbol]decimal.Decimal{"AAPL": decimal.RequireFromString("200"), "MSFT": decimal.RequireFromString("400")}
+	priceOf := func(symbol Symbol) (decimal.Decimal, bool) {
+		price, exists := prices[symbol]
+		return price, exists
+	}
+	spec := IndexSpec{
+		Definition: IndexDefinition{
+			Symbol: "TECH2",
+			Constituents: []IndexConstituent{
+				{Symbol: "AAPL", Weight: decimal.NewFromInt(75)},
+				{Symbol: "MSFT", Weight: decimal.NewFromInt(25)},
+			},
+		},
+		Weighting: IndexWeightingPercent,
+		BaseLevel: decimal.NewFromInt(80),
+	}
+
+	// Act
+	definition, err := spec.Resolve(priceOf)
+	require.NoError(t, err)
+	prices["AAPL"] = decimal.RequireFromString("220")
+	level, err := definition.Level(priceOf)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "0.3", definition.Constituents[0].Weight.String())
+	assert.Equal(t, "0.05", definition.Constituents[1].Weight.String())
+	assert.Equal(t, "86", level.String())
+}
+
+func TestParseIndexWeighting(t *testing.T) {
+	units, unitsErr := ParseIndexWeighting("")
+	percent, percentErr := ParseIndexWeighting(" percent ")
+	_, invalidErr := ParseIndexWeighting("market_cap")
+
+	assert.NoError(t, unitsErr)
+	assert.Equal(t, IndexWeightingUnits, units)
+	assert.NoError(t, percentErr)
+	assert.Equal(t, IndexWeightingPercent, percent)
+	assert.ErrorIs(t, invalidErr, ErrInvalidIndex)
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of instruments that do not define their own
+const DefaultCurrency = "USD"
+
+var ErrInvalidInstrument = errors.New("invalid instrument")
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Instrument is the trading metadata of a symbol. Prices are whole multiples of TickSize,
+// quoted with PricePrecision decimal places, in Currency. Quantities are whole multiples of
+// LotSize.
+type Instrument struct {
+	TickSize       decimal.Decimal
+	PricePrecision int32
+	LotSize        int64
+	Currency       string
+}
+
+// DefaultInstrument is the metadata of instruments of this class that do not define their
+// own: one tick is one unit of the last decimal place
+func (c AssetClass) DefaultInstrument() Instrument {
+	precision := c.DefaultPricePrecision()
+
+	lotSize := int64(100)
+	switch c {
+	case AssetClassBond:
+		lotSize = 10
+	case AssetClassCrypto, AssetClassIndex, AssetClassOption:
+		lotSize = 1
+	case AssetClassFX:
+		lotSize = 100000
+	}
+
+	return Instrument{
+		TickSize:       decimal.New(1, -precision),
+		PricePrecision: precision,
+		LotSize:        lotSize,
+		Currency:       DefaultCurrency,
+	}
+}
+
+// Validate checks that the tick size is positive and can be quoted with the price
+// precision, that the lot size is positive and that the currency is an ISO 4217 code
+func (i Instrument) Validate() error {
+	switch {
+	case i.PricePrecision < 0 || i.PricePrecision > MaxPricePrecision:
+		return fmt.Errorf("%w: price precision %d must be between 0 and %d", ErrInvalidInstrument, i.PricePrecision, MaxPricePrecision)
+	case !i.TickSize.IsPositive():
+		return fmt.Errorf("%w: tick size %s must be positive", ErrInvalidInstrument, i.TickSize)
+	case !i.TickSize.Equal(i.TickSize.Round(i.PricePrecision)):
+		return fmt.Errorf("%w: tick size %s has more than %d decimal places", ErrInvalidInstrument, i.TickSize, i.PricePrecision)
+	case i.LotSize <= 0:
+		return fmt.Errorf("%w: lot size %d must be positive", ErrInvalidInstrument, i.LotSize)
+	case !currencyPattern.MatchString(i.Currency):
+		return fmt.Errorf("%w: currency %q must be a three letter code", ErrInvalidInstrument, i.Currency)
+	}
+	return nil
+}
+
+// Tick is the smallest price increment, one unit of the last decimal place when the tick
+// size is unset
+func (i Instrument) Tick() decimal.Decimal {
+	if i.TickSize.IsPositive() {
+		return i.TickSize
+	}
+	return decimal.New(1, -i.PricePrecision)
+}
+
+// RoundPrice rounds a price half away from zero to the nearest tick
+func (i Instrument) RoundPrice(price decimal.Decimal) decimal.Decimal {
+	tick := i.Tick()
+	return price.DivRound(tick, 0).Mul(tick).Round(i.PricePrecision)
+}
+
+// FloorPrice rounds a price down to a tick, as a bid is quoted
+func (i Instrument) FloorPrice(price decimal.Decimal) decimal.Decimal {
+	tick := i.Tick()
+	return price.Div(tick).Floor().Mul(tick).Round(i.PricePrecision)
+}
+
+// CeilPrice rounds a price up to a tick, as an ask is quoted
+func (i Instrument) CeilPrice(price decimal.Decimal) decimal.Decimal {
+	tick := i.Tick()
+	return price.Div(tick).Ceil().Mul(tick).Round(i.PricePrecision)
+}
+
+// FormatPrice renders a price rounded to a tick with exactly the price precision decimal
+// places, so a rendered price is always a tradable increment
+func (i Instrument) FormatPrice(price decimal.Decimal) string {
+	return FormatPrice(i.RoundPrice(price), i.PricePrecision)
+}
+
+// Quantity is the size of the given number of lots
+func (i Instrument) Quantity(lots int64) int64 {
+	return lots * max(i.LotSize, 1)
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetClass_DefaultInstrument(t *testing.T) {
+	// Act
+	stock := AssetClassStock.DefaultInstrument()
+	fx := AssetClassFX.DefaultInstrument()
+
+	// Assert
+	assert.Equal(t, "0.01", stock.TickSize.String())
+	assert.Equal(t, int64(100), stock.LotSize)
+	assert.Equal(t, "0.00001", fx.TickSize.String())
+	assert.Equal(t, int64(100000), fx.LotSize)
+	for _, assetClass := range AssetClasses() {
+		assert.NoError(t, assetClass.DefaultInstrument().Validate(), assetClass)
+	}
+}
+
+func TestInstrument_RoundsToTick(t *testing.T) {
+	// Arrange
+	instrument := Instrument{TickSize: decimal.RequireFromString("0.05"), PricePrecision: 2, LotSize: 100, Currency: "USD"}
+
+	// Act & Assert
+	assert.Equal(t, "100.05", instrument.FormatPrice(decimal.RequireFromString("100.03")))
+	assert.Equal(t, "100.00", instrument.FormatPrice(decimal.RequireFromString("100.024")))
+	assert.Equal(t, "100.00", instrument.FloorPrice(decimal.RequireFromString("100.04")).StringFixed(2))
+	assert.Equal(t, "100.05", instrument.CeilPrice(decimal.RequireFromString("100.01")).StringFixed(2))
+	assert.Equal(t, "100.00", instrument.CeilPrice(decimal.RequireFromString("100.00")).StringFixed(2))
+	assert.Equal(t, int64(300), instrument.Quantity(3))
+}
+
+func TestInstrument_Validate(t *testing.T) {
+	valid := Instrument{TickSize: decimal.RequireFromString("0.25"), PricePrecision: 2, LotSize: 1, Currency: "EUR"}
+	tests := map[string]func(Instrument) Instrument{
+		"zero tick":         func(i Instrument) Instrument { i.TickSize = decimal.Zero; return i },
+		"tick too precise":  func(i Instrument) Instrument { i.TickSize = decimal.RequireFromString("0.001"); return i },
+		"negative lot size": func(i Instrument) Instrument { i.LotSize = -1; return i },
+		"bad currency":      func(i Instrument) Instrument { i.Currency = "usd"; return i },
+		"bad precision":     func(i Instrument) Instrument { i.PricePrecision = 9; return i },
+	}
+
+	assert.NoError(t, valid.Validate())
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			err := mutate(valid).Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidInstrument)
+		})
+	}
+}
+
+func TestAssetQuote_WithInstrument(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("175.53"), 0, 0, time.Now())
+	instrument := Instrument{TickSize: decimal.RequireFromString("0.05"), PricePrecision: 2, LotSize: 10, Currency: "USD"}
+
+	// Act
+	traded := quote.WithInstrument(instrument)
+	moved := traded.WithPrice(decimal.RequireFromString("175.61"), traded.LastUpdated)
+
+	// Assert
+	assert.Equal(t, "175.55", FormatPrice(traded.CurrentPrice, traded.PricePrecision))
+	assert.Equal(t, "175.55", FormatPrice(traded.PreviousClose, traded.PricePrecision))
+	assert.Equal(t, "175.60", FormatPrice(moved.CurrentPrice, moved.PricePrecision))
+	assert.Equal(t, int64(10), moved.LotSize)
+}
@@ -1,8 +1,11 @@
 package model
 
+import "github.com/shopspring/decimal"
+
 type MarketDataModel struct {
-	Symbol    string
-	Name      string
-	LastQuote float32
-	Category  int //TODO: criar enum pra esse cara
+	Symbol     string
+	Name       string
+	LastQuote  decimal.Decimal
+	AssetClass AssetClass
+	Instrument
 }
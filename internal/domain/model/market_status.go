@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// MarketStatus is the trading state of an exchange or of a single symbol
+type MarketStatus string
+
+const (
+	MarketStatusOpen       MarketStatus = "OPEN"
+	MarketStatusClosed     MarketStatus = "CLOSED"
+	MarketStatusPreMarket  MarketStatus = "PRE_MARKET"
+	MarketStatusPostMarket MarketStatus = "POST_MARKET"
+	MarketStatusHalted     MarketStatus = "HALTED"
+)
+
+func (s MarketStatus) String() string {
+	return string(s)
+}
+
+// IsTrading reports whether prices move in this status. Extended-hours sessions trade.
+func (s MarketStatus) IsTrading() bool {
+	return s == MarketStatusOpen || s == MarketStatusPreMarket || s == MarketStatusPostMarket
+}
+
+// SymbolMarketStatus is the trading state of a symbol on its exchange. NextChange is when
+// the exchange calendar moves to another status, or zero when it never does. Halt is set
+// while the symbol is HALTED, and NextChange is then the automatic resume time, if any.
+type SymbolMarketStatus struct {
+	Symbol     string
+	Exchange   string
+	Status     MarketStatus
+	NextChange time.Time
+	Halt       *TradingHalt
+}
+
+// ExchangeMarketStatus is the calendar status of an exchange
+type ExchangeMarketStatus struct {
+	Exchange   string
+	Name       string
+	Timezone   string
+	Status     MarketStatus
+	NextChange time.Time
+}
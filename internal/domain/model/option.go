@@ -0,0 +1,292 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// minImpliedVolatility and maxImpliedVolatility bound the implied volatility search
+	minImpliedVolatility = 0.0001
+	maxImpliedVolatility = 5.0
+
+	// impliedVolatilityIterations halves the volatility search interval below 1e-12
+	impliedVolatilityIterations = 100
+
+	// minSurfaceVolatility is the floor of the volatility surface, so deep wings stay priced
+	minSurfaceVolatility = 0.01
+
+	daysPerYear  = 365.0
+	hoursPerYear = daysPerYear * 24
+)
+
+var (
+	ErrInvalidVolatilitySurface = errors.New("invalid volatility surface")
+	ErrNoImpliedVolatility      = errors.New("no implied volatility")
+)
+
+// OptionType is the right an option gives its holder: to buy the underlying at the strike,
+// a call, or to sell it, a put
+type OptionType string
+
+const (
+	OptionTypeCall OptionType = "CALL"
+	OptionTypePut  OptionType = "PUT"
+)
+
+func (t OptionType) String() string {
+	return string(t)
+}
+
+// OptionContract is a European option on Underlying. It expires at the end of its Expiry
+// date in UTC.
+type OptionContract struct {
+	Underlying Symbol
+	Type       OptionType
+	Strike     decimal.Decimal
+	Expiry     time.Time
+}
+
+// Symbol is the OCC symbol of the contract: the underlying, the expiry as YYMMDD, C or P and
+// the strike in thousandths padded to eight digits, e.g. AAPL260417C00180000
+func (c OptionContract) Symbol() string {
+	return fmt.Sprintf("%s%s%s%08d",
+		c.Underlying, c.Expiry.Format("060102"), string(c.Type)[:1], c.Strike.Shift(3).Round(0).IntPart())
+}
+
+// YearsToExpiry is the time left until the contract expires, in years of 365 days. An
+// expired contract has no time left.
+func (c OptionContract) YearsToExpiry(at time.Time) float64 {
+	expiresAt := time.Date(c.Expiry.Year(), c.Expiry.Month(), c.Expiry.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	return max(expiresAt.Sub(at).Hours()/hoursPerYear, 0)
+}
+
+// OptionValuation is the Black-Scholes value of a contract and its sensitivities. Delta and
+// Gamma are per unit of the underlying price, Theta is the change in value per calendar day
+// and Vega the change in value per volatility point.
+type OptionValuation struct {
+	Price float64
+	Delta float64
+	Gamma float64
+	Theta float64
+	Vega  float64
+}
+
+// Value prices the contract with Black-Scholes at the spot price of the underlying, an
+// annual continuously compounded risk free rate and an annual volatility, both as fractions.
+// An expired contract is worth its intrinsic value.
+func (c OptionContract) Value(spot, rate, volatility float64, at time.Time) OptionValuation {
+	strike := c.Strike.InexactFloat64()
+	years := c.YearsToExpiry(at)
+
+	if years <= 0 || volatility <= 0 || spot <= 0 || strike <= 0 {
+		return c.intrinsicValuation(spot, strike)
+	}
+
+	sqrtYears := math.Sqrt(years)
+	d1 := (math.Log(spot/strike) + (rate+volatility*volatility/2)*years) / (volatility * sqrtYears)
+	d2 := d1 - volatility*sqrtYears
+	discount := math.Exp(-rate * years)
+	density := normalDensity(d1)
+
+	valuation := OptionValuation{
+		Gamma: density / (spot * volatility * sqrtYears),
+		Vega:  spot * density * sqrtYears / 100,
+	}
+	decay := -spot * density * volatility / (2 * sqrtYears)
+
+	if c.Type == OptionTypePut {
+		valuation.Price = strike*discount*normalCDF(-d2) - spot*normalCDF(-d1)
+		valuation.Delta = normalCDF(d1) - 1
+		valuation.Theta = (decay + rate*strike*discount*normalCDF(-d2)) / daysPerYear
+	} else {
+		valuation.Price = spot*normalCDF(d1) - strike*discount*normalCDF(d2)
+		valuation.Delta = normalCDF(d1)
+		valuation.Theta = (decay - rate*strike*discount*normalCDF(d2)) / daysPerYear
+	}
+	return valuation
+}
+
+// ImpliedVolatility returns the volatility at which Value prices the contract at premium,
+// the inverse of Value. A premium outside the no-arbitrage bounds has no implied volatility.
+func (c OptionContract) ImpliedVolatility(premium, spot, rate float64, at time.Time) (float64, error) {
+	low, high := minImpliedVolatility, maxImpliedVolatility
+	if c.YearsToExpiry(at) <= 0 ||
+		premium < c.Value(spot, rate, low, at).Price || premium > c.Value(spot, rate, high, at).Price {
+		return 0, fmt.Errorf("%w: %s at %g", ErrNoImpliedVolatility, c.Symbol(), premium)
+	}
+
+	// The value rises with the volatility, so the volatility is bisected
+	for i := 0; i < impliedVolatilityIterations; i++ {
+		middle := (low + high) / 2
+		if c.Value(spot, rate, middle, at).Price < premium {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return (low + high) / 2, nil
+}
+
+func (c OptionContract) intrinsicValuation(spot, strike float64) OptionValuation {
+	if c.Type == OptionTypePut {
+		if spot < strike {
+			return OptionValuation{Price: strike - spot, Delta: -1}
+		}
+		return OptionValuation{}
+	}
+	if spot > strike {
+		return OptionValuation{Price: spot - strike, Delta: 1}
+	}
+	return OptionValuation{}
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normalDensity(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+// VolatilitySurface is the annual volatility options are priced with, as a fraction. An
+// underlying has its at-the-money volatility, or DefaultVolatility without one, which moves
+// by Skew per unit of log-moneyness ln(strike/spot) and by TermSlope per year to expiry.
+// A negative skew prices puts below spot richer than calls above it, as equity options trade.
+type VolatilitySurface struct {
+	DefaultVolatility float64
+	Underlyings       map[string]float64
+	Skew              float64
+	TermSlope         float64
+	RiskFreeRate      float64
+}
+
+// DefaultVolatilitySurface prices every underlying at 30% at the money with a downward skew
+// and volatility rising slightly with the expiry
+func DefaultVolatilitySurface() VolatilitySurface {
+	return VolatilitySurface{
+		DefaultVolatility: 0.30,
+		Underlyings:       map[string]float64{},
+		Skew:              -0.15,
+		TermSlope:         0.02,
+		RiskFreeRate:      0.04,
+	}
+}
+
+// Validate checks that every at-the-money volatility is positive
+func (s VolatilitySurface) Validate() error {
+	if s.DefaultVolatility <= 0 {
+		return fmt.Errorf("%w: default volatility %g must be positive", ErrInvalidVolatilitySurface, s.DefaultVolatility)
+	}
+	for symbol, volatility := range s.Underlyings {
+		if volatility <= 0 {
+			return fmt.Errorf("%w: volatility %g of %s must be positive", ErrInvalidVolatilitySurface, volatility, symbol)
+		}
+	}
+	return nil
+}
+
+// Volatility returns the volatility of a strike of underlying expiring in the given years,
+// at the spot price of the underlying
+func (s VolatilitySurface) Volatility(underlying Symbol, spot, strike, years float64) float64 {
+	volatility, exists := s.Underlyings[underlying.String()]
+	if !exists {
+		volatility = s.DefaultVolatility
+	}
+	if spot > 0 && strike > 0 {
+		volatility += s.Skew * math.Log(strike/spot)
+	}
+	volatility += s.TermSlope * years
+	return max(volatility, minSurfaceVolatility)
+}
+
+// OptionExpiries returns the next count monthly expiries from the day of from on: the third
+// Friday of each month
+func OptionExpiries(from time.Time, count int) []time.Time {
+	expiries := make([]time.Time, 0, count)
+	month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for len(expiries) < count {
+		expiry := thirdFriday(month)
+		if !expiry.Before(time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)) {
+			expiries = append(expiries, expiry)
+		}
+		month = month.AddDate(0, 1, 0)
+	}
+	return expiries
+}
+
+func thirdFriday(month time.Time) time.Time {
+	daysUntilFriday := (int(time.Friday) - int(month.Weekday()) + 7) % 7
+	return month.AddDate(0, 0, daysUntilFriday+14)
+}
+
+// StrikeInterval is the distance between listed strikes of an underlying trading at spot
+func StrikeInterval(spot decimal.Decimal) decimal.Decimal {
+	switch {
+	case spot.LessThan(decimal.NewFromInt(25)):
+		return decimal.RequireFromString("0.5")
+	case spot.LessThan(decimal.NewFromInt(100)):
+		return decimal.NewFromInt(1)
+	case spot.LessThan(decimal.NewFromInt(250)):
+		return decimal.RequireFromString("2.5")
+	case spot.LessThan(decimal.NewFromInt(1000)):
+		return decimal.NewFromInt(5)
+	default:
+		return decimal.NewFromInt(10)
+	}
+}
+
+// OptionStrikes returns the strike closest to spot and up to perSide strikes on either side
+// of it, in ascending order. Strikes are positive.
+func OptionStrikes(spot decimal.Decimal, perSide int) []decimal.Decimal {
+	interval := StrikeInterval(spot)
+	atTheMoney := spot.DivRound(interval, 0).Mul(interval)
+
+	strikes := make([]decimal.Decimal, 0, 2*perSide+1)
+	for i := -perSide; i <= perSide; i++ {
+		strike := atTheMoney.Add(interval.Mul(decimal.NewFromInt(int64(i))))
+		if strike.IsPositive() {
+			strikes = append(strikes, strike)
+		}
+	}
+	return strikes
+}
+
+// HasOptions reports whether options are listed on instruments of this class
+func (c AssetClass) HasOptions() bool {
+	return c == AssetClassStock || c == AssetClassETF
+}
+
+// OptionQuote is a simulated quote of a contract: its theoretical price, a bid and ask around
+// it, the sensitivities of the price and the volatility implied by the quoted price
+type OptionQuote struct {
+	Contract          OptionContract
+	Price             decimal.Decimal
+	Bid               decimal.Decimal
+	Ask               decimal.Decimal
+	BidSize           int64
+	AskSize           int64
+	Delta             float64
+	Gamma             float64
+	Theta             float64
+	Vega              float64
+	ImpliedVolatility float64
+}
+
+// OptionChain is the calls and puts of one expiry of an underlying, quoted at the same
+// underlying price and risk free rate. Expiries lists every expiry the underlying has a
+// chain for.
+type OptionChain struct {
+	Underlying      Symbol
+	UnderlyingPrice decimal.Decimal
+	RiskFreeRate    float64
+	Expiry          time.Time
+	Expiries        []time.Time
+	Calls           []OptionQuote
+	Puts            []OptionQuote
+	Timestamp       time.Time
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionContract_Symbol(t *testing.T) {
+	// Arrange
+	contract := OptionContract{
+		Underlying: "AAPL",
+		Type:       OptionTypeCall,
+		Strike:     decimal.RequireFromString("182.5"),
+		Expiry:     time.Date(2026, 4, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act & Assert
+	assert.Equal(t, "AAPL260417C00182500", contract.Symbol())
+}
+
+func TestOptionContract_Value(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	expiry := now.AddDate(1, 0, -1) // expires at the end of the day, exactly a year away
+	call := OptionContract{Underlying: "AAPL", Type: OptionTypeCall, Strike: decimal.NewFromInt(100), Expiry: expiry}
+	put := OptionContract{Underlying: "AAPL", Type: OptionTypePut, Strike: decimal.NewFromInt(100), Expiry: expiry}
+
+	// Act
+	callValue := call.Value(100, 0.05, 0.2, now)
+	putValue := put.Value(100, 0.05, 0.2, now)
+
+	// Assert
+	assert.InDelta(t, 10.4506, callValue.Price, 1e-4)
+	assert.InDelta(t, 5.5735, putValue.Price, 1e-4)
+	assert.InDelta(t, 0.6368, callValue.Delta, 1e-4)
+	assert.InDelta(t, -0.3632, putValue.Delta, 1e-4)
+	assert.InDelta(t, 0.018762, callValue.Gamma, 1e-6)
+	assert.InDelta(t, callValue.Gamma, putValue.Gamma, 1e-12)
+	assert.InDelta(t, 0.3752, callValue.Vega, 1e-4)
+	assert.InDelta(t, -6.4140/365, callValue.Theta, 1e-5)
+	assert.InDelta(t, -1.6579/365, putValue.Theta, 1e-5)
+}
+
+func TestOptionContract_ValueExpired(t *testing.T) {
+	// Arrange
+	expiry := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	after := expiry.AddDate(0, 0, 2)
+	call := OptionContract{Underlying: "AAPL", Type: OptionTypeCall, Strike: decimal.NewFromInt(100), Expiry: expiry}
+	put := OptionContract{Underlying: "AAPL", Type: OptionTypePut, Strike: decimal.NewFromInt(100), Expiry: expiry}
+
+	// Act & Assert
+	assert.Equal(t, OptionValuation{Price: 5, Delta: 1}, call.Value(105, 0.05, 0.2, after))
+	assert.Equal(t, OptionValuation{}, put.Value(105, 0.05, 0.2, after))
+}
+
+func TestOptionContract_ImpliedVolatility(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	put := OptionContract{Underlying: "TSLA", Type: OptionTypePut, Strike: decimal.NewFromInt(240), Expiry: time.Date(2026, 6, 19, 0, 0, 0, 0, time.UTC)}
+	premium := put.Value(250, 0.04, 0.55, now).Price
+
+	// Act
+	volatility, err := put.ImpliedVolatility(premium, 250, 0.04, now)
+	_, belowIntrinsicErr := put.ImpliedVolatility(0, 200, 0.04, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0.55, volatility, 1e-9)
+	assert.ErrorIs(t, belowIntrinsicErr, ErrNoImpliedVolatility)
+}
+
+func TestVolatilitySurface_Volatility(t *testing.T) {
+	// Arrange
+	surface := VolatilitySurface{
+		DefaultVolatility: 0.3,
+		Underlyings:       map[string]float64{"TSLA": 0.55},
+		Skew:              -0.2,
+		TermSlope:         0.05,
+	}
+
+	// Act & Assert
+	assert.InDelta(t, 0.3, surface.Volatility("AAPL", 100, 100, 0), 1e-12)
+	assert.InDelta(t, 0.55, surface.Volatility("TSLA", 100, 100, 0), 1e-12)
+	assert.Greater(t, surface.Volatility("AAPL", 100, 90, 0), surface.Volatility("AAPL", 100, 110, 0))
+	assert.InDelta(t, 0.35, surface.Volatility("AAPL", 100, 100, 1), 1e-12)
+	assert.InDelta(t, minSurfaceVolatility, surface.Volatility("AAPL", 100, 10000, 0), 1e-12)
+	assert.NoError(t, surface.Validate())
+	assert.ErrorIs(t, VolatilitySurface{DefaultVolatility: 0.3, Underlyings: map[string]float64{"TSLA": 0}}.Validate(), ErrInvalidVolatilitySurface)
+}
+
+func TestOptionExpiries(t *testing.T) {
+	// Act
+	expiries := OptionExpiries(time.Date(2026, 3, 20, 15, 0, 0, 0, time.UTC), 3)
+	afterExpiry := OptionExpiries(time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC), 1)
+
+	// Assert
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 17, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 15, 0, 0, 0, 0, time.UTC),
+	}, expiries)
+	assert.Equal(t, []time.Time{time.Date(2026, 4, 17, 0, 0, 0, 0, time.UTC)}, afterExpiry)
+}
+
+func TestOptionStrikes(t *testing.T) {
+	// Act
+	strikes := OptionStrikes(decimal.RequireFromString("178.35"), 2)
+	lowPriced := OptionStrikes(decimal.RequireFromString("0.80"), 2)
+
+	// Assert
+	assert.Equal(t, []string{"172.5", "175", "177.5", "180", "182.5"}, decimalStrings(strikes))
+	assert.Equal(t, []string{"0.5", "1", "1.5", "2"}, decimalStrings(lowPriced))
+}
+
+func decimalStrings(values []decimal.Decimal) []string {
+	strings := make([]string, len(values))
+	for i, value := range values {
+		strings[i] = value.String()
+	}
+	return strings
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceLevel is the total quantity resting at one price of an order book side
+type PriceLevel struct {
+	Price decimal.Decimal
+	Size  int64
+}
+
+// OrderBook is an immutable snapshot of the simulated depth of a symbol. Bids are ordered
+// from the best (highest) price down and asks from the best (lowest) price up. Sequence
+// increases every time the book of the symbol changes. Level slices are never modified
+// after the snapshot is built, so snapshots can be shared between goroutines. Level prices
+// are ticks and level sizes lots of the Instrument.
+type OrderBook struct {
+	Symbol    string
+	Sequence  uint64
+	Bids      []PriceLevel
+	Asks      []PriceLevel
+	UpdatedAt time.Time
+	Instrument
+}
+
+// Top returns the book limited to its best levels on each side
+func (b OrderBook) Top(levels int) OrderBook {
+	if levels > 0 && len(b.Bids) > levels {
+		b.Bids = b.Bids[:levels]
+	}
+	if levels > 0 && len(b.Asks) > levels {
+		b.Asks = b.Asks[:levels]
+	}
+	return b
+}
+
+// IsEmpty reports whether the book has no level on either side
+func (b OrderBook) IsEmpty() bool {
+	return len(b.Bids) == 0 && len(b.Asks) == 0
+}
+
+// OrderBookDelta is an incremental depth update: the levels that changed since the previous
+// book. A level with a zero size was removed.
+type OrderBookDelta struct {
+	Symbol    string
+	Sequence  uint64
+	Bids      []PriceLevel
+	Asks      []PriceLevel
+	UpdatedAt time.Time
+}
+
+func (d OrderBookDelta) IsEmpty() bool {
+	return len(d.Bids) == 0 && len(d.Asks) == 0
+}
+
+// DeltaFrom returns the levels to apply to previous to obtain b
+func (b OrderBook) DeltaFrom(previous OrderBook) OrderBookDelta {
+	return OrderBookDelta{
+		Symbol:    b.Symbol,
+		Sequence:  b.Sequence,
+		Bids:      diffLevels(previous.Bids, b.Bids),
+		Asks:      diffLevels(previous.Asks, b.Asks),
+		UpdatedAt: b.UpdatedAt,
+	}
+}
+
+func diffLevels(previous, current []PriceLevel) []PriceLevel {
+	previousSizes := make(map[string]int64, len(previous))
+	for _, level := range previous {
+		previousSizes[level.Price.String()] = level.Size
+	}
+
+	var changes []PriceLevel
+	for _, level := range current {
+		key := level.Price.String()
+		if size, exists := previousSizes[key]; !exists || size != level.Size {
+			changes = append(changes, level)
+		}
+		delete(previousSizes, key)
+	}
+
+	for _, level := range previous {
+		if _, removed := previousSizes[level.Price.String()]; removed {
+			changes = append(changes, PriceLevel{Price: level.Price})
+		}
+	}
+
+	return changes
+}
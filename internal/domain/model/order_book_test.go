@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func level(price string, size int64) PriceLevel {
+	return PriceLevel{Price: decimal.RequireFromString(price), Size: size}
+}
+
+func TestOrderBook_Top(t *testing.T) {
+	// Arrange
+	book := OrderBook{
+		Symbol: "AAPL",
+		Bids:   []PriceLevel{level("99.99", 100), level("99.98", 200), level("99.97", 300)},
+		Asks:   []PriceLevel{level("100.01", 100)},
+	}
+
+	// Act
+	top := book.Top(2)
+
+	// Assert
+	assert.Equal(t, []PriceLevel{level("99.99", 100), level("99.98", 200)}, top.Bids)
+	assert.Equal(t, book.Asks, top.Asks)
+	assert.Len(t, book.Bids, 3)
+	assert.Len(t, book.Top(0).Bids, 3)
+}
+
+func TestOrderBook_DeltaFrom(t *testing.T) {
+	// Arrange
+	previous := OrderBook{
+		Symbol:   "AAPL",
+		Sequence: 1,
+		Bids:     []PriceLevel{level("99.99", 100), level("99.98", 200)},
+		Asks:     []PriceLevel{level("100.01", 100), level("100.02", 200)},
+	}
+	current := OrderBook{
+		Symbol:   "AAPL",
+		Sequence: 2,
+		Bids:     []PriceLevel{level("99.98", 200), level("99.97", 300)},
+		Asks:     []PriceLevel{level("100.01", 400), level("100.02", 200)},
+	}
+
+	// Act
+	delta := current.DeltaFrom(previous)
+	unchanged := current.DeltaFrom(current)
+	snapshot := current.DeltaFrom(OrderBook{})
+
+	// Assert
+	assert.Equal(t, uint64(2), delta.Sequence)
+	assert.Equal(t, []PriceLevel{level("99.97", 300), {Price: decimal.RequireFromString("99.99")}}, delta.Bids)
+	assert.Equal(t, []PriceLevel{level("100.01", 400)}, delta.Asks)
+	assert.True(t, unchanged.IsEmpty())
+	assert.Equal(t, current.Bids, snapshot.Bids)
+	assert.Equal(t, current.Asks, snapshot.Asks)
+}
@@ -0,0 +1,39 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// DefaultPricePrecision is the number of decimal places used for prices of equities and
+// any instrument whose precision is unknown
+const DefaultPricePrecision int32 = 2
+
+// MaxPricePrecision bounds the precision accepted from storage, matching the scale of
+// the last_quote column
+const MaxPricePrecision int32 = 8
+
+// RoundPrice rounds a price half away from zero to the given number of decimal places
+func RoundPrice(price decimal.Decimal, precision int32) decimal.Decimal {
+	return price.Round(precision)
+}
+
+// FormatPrice renders a price with exactly precision decimal places, e.g. "175.50"
+func FormatPrice(price decimal.Decimal, precision int32) string {
+	return price.StringFixed(precision)
+}
+
+// FormatVWAP renders a VWAP of a price with the given precision, with vwapExtraPrecision
+// more decimal places. A zero VWAP, before the first trade, renders empty.
+func FormatVWAP(vwap decimal.Decimal, precision int32) string {
+	if vwap.IsZero() {
+		return ""
+	}
+	return vwap.StringFixed(precision + vwapExtraPrecision)
+}
+
+// FormatNAV renders the net asset value of an ETF priced with the given precision, with
+// navExtraPrecision more decimal places. Instruments without holdings render empty.
+func FormatNAV(nav decimal.Decimal, precision int32) string {
+	if nav.IsZero() {
+		return ""
+	}
+	return nav.StringFixed(precision + navExtraPrecision)
+}
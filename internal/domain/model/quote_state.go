@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// QuoteState is the part of a quote the simulator persists, so that a restart resumes from
+// the last simulated prices and session statistics instead of the initial prices
+type QuoteState struct {
+	Symbol        string
+	SessionDate   time.Time
+	CurrentPrice  decimal.Decimal
+	BasePrice     decimal.Decimal
+	OpenPrice     decimal.Decimal
+	HighPrice     decimal.Decimal
+	LowPrice      decimal.Decimal
+	PreviousClose decimal.Decimal
+	Volume        int64
+	Turnover      decimal.Decimal
+	VWAP          decimal.Decimal
+	UpdatedAt     time.Time
+}
+
+// State returns the persisted state of the quote
+func (q AssetQuote) State() QuoteState {
+	return QuoteState{
+		Symbol:        q.Symbol,
+		SessionDate:   q.SessionDate,
+		CurrentPrice:  q.CurrentPrice,
+		BasePrice:     q.BasePrice,
+		OpenPrice:     q.OpenPrice,
+		HighPrice:     q.HighPrice,
+		LowPrice:      q.LowPrice,
+		PreviousClose: q.PreviousClose,
+		Volume:        q.Volume,
+		Turnover:      q.Turnover,
+		VWAP:          q.VWAP,
+		UpdatedAt:     q.LastUpdated,
+	}
+}
+
+// WithState returns a new snapshot resumed from state, rounded to its ticks. The
+// session statistics are only resumed when state belongs to the session of the quote; a
+// state of an earlier session is closed at its last price and a new session is opened.
+func (q AssetQuote) WithState(state QuoteState) AssetQuote {
+	q.CurrentPrice = q.RoundPrice(state.CurrentPrice)
+	q = q.WithBasePrice(state.BasePrice)
+	q.LastUpdated = state.UpdatedAt
+
+	if !q.SessionDate.IsZero() && !q.SessionDate.Equal(state.SessionDate) {
+		return q.withYield().StartSession(q.SessionDate)
+	}
+
+	q.SessionDate = state.SessionDate
+	q.OpenPrice = q.RoundPrice(state.OpenPrice)
+	q.HighPrice = q.RoundPrice(state.HighPrice)
+	q.LowPrice = q.RoundPrice(state.LowPrice)
+	q.PreviousClose = q.RoundPrice(state.PreviousClose)
+	q.Volume = state.Volume
+	q.Turnover = state.Turnover
+	q.VWAP = state.VWAP.Round(q.PricePrecision + vwapExtraPrecision)
+	return q.withYield().withDayChange()
+}
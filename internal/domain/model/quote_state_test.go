@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetQuote_WithState_SameSession(t *testing.T) {
+	// Arrange
+	sessionDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC)
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("100"), 0, 0, time.Now()).StartSession(sessionDate)
+	state := QuoteState{
+		Symbol: "AAPL", SessionDate: sessionDate, UpdatedAt: updatedAt,
+		CurrentPrice: decimal.RequireFromString("104.123"), BasePrice: decimal.RequireFromString("103"),
+		OpenPrice: decimal.RequireFromString("101"), HighPrice: decimal.RequireFromString("105"),
+		LowPrice: decimal.RequireFromString("99.5"), PreviousClose: decimal.RequireFromString("100"),
+		Volume: 2000, Turnover: decimal.RequireFromString("204000"), VWAP: decimal.RequireFromString("102"),
+	}
+
+	// Act
+	restored := quote.WithState(state)
+
+	// Assert
+	assert.Equal(t, "104.12", FormatPrice(restored.CurrentPrice, restored.PricePrecision))
+	assert.Equal(t, "103.00", FormatPrice(restored.BasePrice, restored.PricePrecision))
+	assert.Equal(t, "101.00", FormatPrice(restored.OpenPrice, restored.PricePrecision))
+	assert.Equal(t, "105.00", FormatPrice(restored.HighPrice, restored.PricePrecision))
+	assert.Equal(t, "99.50", FormatPrice(restored.LowPrice, restored.PricePrecision))
+	assert.Equal(t, int64(2000), restored.Volume)
+	assert.Equal(t, "4.12", FormatPrice(restored.Change, restored.PricePrecision))
+	assert.Equal(t, updatedAt, restored.LastUpdated)
+	assert.Equal(t, sessionDate, restored.State().SessionDate)
+	assert.True(t, restored.State().Turnover.Equal(state.Turnover))
+}
+
+func TestAssetQuote_WithState_EarlierSessionStartsNewSession(t *testing.T) {
+	// Arrange
+	sessionDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("100"), 0, 0, time.Now()).StartSession(sessionDate)
+	state := QuoteState{
+		Symbol: "AAPL", SessionDate: sessionDate.AddDate(0, 0, -1),
+		CurrentPrice: decimal.RequireFromString("104"), BasePrice: decimal.RequireFromString("104"),
+		OpenPrice: decimal.RequireFromString("101"), HighPrice: decimal.RequireFromString("105"),
+		LowPrice: decimal.RequireFromString("99"), PreviousClose: decimal.RequireFromString("100"),
+		Volume: 2000, Turnover: decimal.RequireFromString("204000"), VWAP: decimal.RequireFromString("102"),
+	}
+
+	// Act
+	restored := quote.WithState(state)
+
+	// Assert: the saved session closed at its last price
+	assert.Equal(t, "104.00", FormatPrice(restored.CurrentPrice, restored.PricePrecision))
+	assert.Equal(t, "104.00", FormatPrice(restored.PreviousClose, restored.PricePrecision))
+	assert.Equal(t, "104.00", FormatPrice(restored.HighPrice, restored.PricePrecision))
+	assert.Equal(t, int64(0), restored.Volume)
+	assert.True(t, restored.Change.IsZero())
+	assert.Equal(t, sessionDate, restored.SessionDate)
+}
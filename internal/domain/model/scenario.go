@@ -0,0 +1,144 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+// ScenarioEventType is what a scenario event does to the simulated market
+type ScenarioEventType string
+
+const (
+	// ScenarioEventShock moves the base price of the targets by Percent at once
+	ScenarioEventShock ScenarioEventType = "SHOCK"
+	// ScenarioEventDrift moves the base price of the targets by Percent over Duration
+	ScenarioEventDrift ScenarioEventType = "DRIFT"
+	// ScenarioEventVolatility scales the simulated moves of the targets by Multiplier for
+	// Duration, or until the scenario is stopped when Duration is zero
+	ScenarioEventVolatility ScenarioEventType = "VOLATILITY"
+	// ScenarioEventHalt halts trading in the targets
+	ScenarioEventHalt ScenarioEventType = "HALT"
+	// ScenarioEventResume resumes trading in the targets
+	ScenarioEventResume ScenarioEventType = "RESUME"
+)
+
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// ScenarioTarget selects the instruments an event applies to: listed symbols, every symbol
+// of the listed asset classes, or every symbol exposed to a factor, weighted by its beta.
+// An empty target selects every symbol.
+type ScenarioTarget struct {
+	Symbols      []string
+	AssetClasses []AssetClass
+	Factor       string
+}
+
+// Weight is how strongly the event applies to the quote, 0 when it does not apply
+func (t ScenarioTarget) Weight(quote AssetQuote, exposure FactorExposure) float64 {
+	switch {
+	case len(t.Symbols) > 0:
+		return weightOf(slices.Contains(t.Symbols, quote.Symbol))
+	case len(t.AssetClasses) > 0:
+		return weightOf(slices.Contains(t.AssetClasses, quote.AssetClass))
+	case t.Factor != "":
+		return exposure.Betas[t.Factor]
+	default:
+		return 1
+	}
+}
+
+func weightOf(selected bool) float64 {
+	if selected {
+		return 1
+	}
+	return 0
+}
+
+// ScenarioEvent is one step of a scenario, applied At after the scenario starts
+type ScenarioEvent struct {
+	At         time.Duration
+	Type       ScenarioEventType
+	Target     ScenarioTarget
+	Percent    float64
+	Duration   time.Duration
+	Multiplier float64
+	Message    string
+}
+
+// Scenario is a scripted timeline of market events used to reproduce market conditions
+type Scenario struct {
+	Name        string
+	Description string
+	Events      []ScenarioEvent
+}
+
+// Validate checks every event and orders the events by time, keeping the file order of
+// events at the same time
+func (s *Scenario) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: scenario without a name", ErrInvalidScenario)
+	}
+	if len(s.Events) == 0 {
+		return fmt.Errorf("%w: scenario %s has no events", ErrInvalidScenario, s.Name)
+	}
+
+	for i, event := range s.Events {
+		if err := event.validate(); err != nil {
+			return fmt.Errorf("%w: scenario %s event %d: %v", ErrInvalidScenario, s.Name, i+1, err)
+		}
+	}
+
+	sort.SliceStable(s.Events, func(i, j int) bool { return s.Events[i].At < s.Events[j].At })
+	return nil
+}
+
+// Length is the time from the start of the scenario to the end of its last event
+func (s Scenario) Length() time.Duration {
+	var length time.Duration
+	for _, event := range s.Events {
+		length = max(length, event.At+event.Duration)
+	}
+	return length
+}
+
+func (e ScenarioEvent) validate() error {
+	if e.At < 0 || e.Duration < 0 {
+		return errors.New("at and duration must not be negative")
+	}
+
+	selectors := 0
+	for _, selected := range []bool{len(e.Target.Symbols) > 0, len(e.Target.AssetClasses) > 0, e.Target.Factor != ""} {
+		if selected {
+			selectors++
+		}
+	}
+	if selectors > 1 {
+		return errors.New("target only one of symbols, asset_classes or factor")
+	}
+
+	switch e.Type {
+	case ScenarioEventShock:
+		if e.Percent <= -100 {
+			return fmt.Errorf("shock of %v%% would make prices negative", e.Percent)
+		}
+	case ScenarioEventDrift:
+		if e.Percent <= -100 || e.Duration == 0 {
+			return errors.New("drift needs a duration and a percent above -100")
+		}
+	case ScenarioEventVolatility:
+		if e.Multiplier <= 0 {
+			return errors.New("volatility needs a positive multiplier")
+		}
+	case ScenarioEventHalt, ScenarioEventResume:
+		if e.Target.Factor != "" {
+			return fmt.Errorf("%s targets symbols or asset classes, not a factor", e.Type)
+		}
+	default:
+		return fmt.Errorf("unknown event type %q", e.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioTarget_Weight(t *testing.T) {
+	// Arrange
+	apple := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.NewFromInt(100), 0, 0, time.Now())
+	exposure := FactorExposure{Betas: map[string]float64{MarketFactor: 1.2}}
+
+	// Act & Assert
+	assert.Equal(t, 1.0, ScenarioTarget{}.Weight(apple, exposure))
+	assert.Equal(t, 1.0, ScenarioTarget{Symbols: []string{"MSFT", "AAPL"}}.Weight(apple, exposure))
+	assert.Zero(t, ScenarioTarget{Symbols: []string{"MSFT"}}.Weight(apple, exposure))
+	assert.Equal(t, 1.0, ScenarioTarget{AssetClasses: []AssetClass{AssetClassStock}}.Weight(apple, exposure))
+	assert.Zero(t, ScenarioTarget{AssetClasses: []AssetClass{AssetClassETF}}.Weight(apple, exposure))
+	assert.Equal(t, 1.2, ScenarioTarget{Factor: MarketFactor}.Weight(apple, exposure))
+	assert.Zero(t, ScenarioTarget{Factor: "TECH"}.Weight(apple, exposure))
+}
+
+func TestScenario_Validate(t *testing.T) {
+	// Arrange
+	scenario := Scenario{
+		Name: "crash",
+		Events: []ScenarioEvent{
+			{At: time.Minute, Type: ScenarioEventDrift, Percent: -3, Duration: 5 * time.Minute},
+			{At: 0, Type: ScenarioEventShock, Percent: -5},
+			{At: time.Minute, Type: ScenarioEventVolatility, Multiplier: 2},
+		},
+	}
+
+	// Act
+	err := scenario.Validate()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ScenarioEventShock, scenario.Events[0].Type)
+	assert.Equal(t, ScenarioEventDrift, scenario.Events[1].Type)
+	assert.Equal(t, ScenarioEventVolatility, scenario.Events[2].Type)
+	assert.Equal(t, 6*time.Minute, scenario.Length())
+
+	invalid := Scenario{Name: "bad", Events: []ScenarioEvent{{Type: ScenarioEventDrift, Percent: 1}}}
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidScenario)
+}
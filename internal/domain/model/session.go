@@ -0,0 +1,65 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SessionClose is the closing price of a symbol for one trading session, with the bid and
+// ask quoted at the close
+type SessionClose struct {
+	Symbol      string
+	SessionDate time.Time
+	ClosePrice  decimal.Decimal
+	Bid         decimal.Decimal
+	Ask         decimal.Decimal
+	BidSize     int64
+	AskSize     int64
+}
+
+// SessionSchedule describes when the trading day rolls over: every day at CloseHour:CloseMinute
+// in Location. The session that closes at that instant is identified by its local date.
+type SessionSchedule struct {
+	CloseHour   int
+	CloseMinute int
+	Location    *time.Location
+}
+
+// ParseSessionSchedule builds a schedule from a "15:04" close time and an IANA time zone name
+func ParseSessionSchedule(closeTime, timezone string) (SessionSchedule, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return SessionSchedule{}, fmt.Errorf("invalid session timezone %q: %w", timezone, err)
+	}
+
+	parsed, err := time.Parse("15:04", closeTime)
+	if err != nil {
+		return SessionSchedule{}, fmt.Errorf("invalid session close time %q, expected HH:MM: %w", closeTime, err)
+	}
+
+	return SessionSchedule{CloseHour: parsed.Hour(), CloseMinute: parsed.Minute(), Location: location}, nil
+}
+
+// LastCloseAt returns the most recent close instant at or before t
+func (s SessionSchedule) LastCloseAt(t time.Time) time.Time {
+	local := t.In(s.Location)
+	closeAt := time.Date(local.Year(), local.Month(), local.Day(), s.CloseHour, s.CloseMinute, 0, 0, s.Location)
+	if closeAt.After(local) {
+		closeAt = time.Date(local.Year(), local.Month(), local.Day()-1, s.CloseHour, s.CloseMinute, 0, 0, s.Location)
+	}
+	return closeAt
+}
+
+// SessionDateAt returns the date of the session in progress at t: sessions opened after a
+// close belong to the following calendar day
+func (s SessionSchedule) SessionDateAt(t time.Time) time.Time {
+	lastClose := s.LastCloseAt(t)
+	return SessionDateOf(lastClose.AddDate(0, 0, 1))
+}
+
+// SessionDateOf returns the calendar date of t in its own location, as midnight UTC
+func SessionDateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSessionSchedule_Invalid(t *testing.T) {
+	_, err := ParseSessionSchedule("4pm", "America/New_York")
+	assert.Error(t, err)
+
+	_, err = ParseSessionSchedule("16:00", "Mars/Olympus")
+	assert.Error(t, err)
+}
+
+func TestSessionSchedule_LastCloseAndSessionDate(t *testing.T) {
+	// Arrange
+	schedule, err := ParseSessionSchedule("16:00", "America/New_York")
+	require.NoError(t, err)
+	newYork := schedule.Location
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		lastClose   time.Time
+		sessionDate string
+	}{
+		{
+			name:        "before the close",
+			now:         time.Date(2026, 3, 10, 15, 59, 0, 0, newYork),
+			lastClose:   time.Date(2026, 3, 9, 16, 0, 0, 0, newYork),
+			sessionDate: "2026-03-10",
+		},
+		{
+			name:        "at the close",
+			now:         time.Date(2026, 3, 10, 16, 0, 0, 0, newYork),
+			lastClose:   time.Date(2026, 3, 10, 16, 0, 0, 0, newYork),
+			sessionDate: "2026-03-11",
+		},
+		{
+			name:        "UTC instant after the New York close",
+			now:         time.Date(2026, 3, 10, 21, 30, 0, 0, time.UTC),
+			lastClose:   time.Date(2026, 3, 10, 16, 0, 0, 0, newYork),
+			sessionDate: "2026-03-11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			lastClose := schedule.LastCloseAt(tt.now)
+			sessionDate := schedule.SessionDateAt(tt.now)
+
+			// Assert
+			assert.True(t, tt.lastClose.Equal(lastClose), "got %s", lastClose)
+			assert.Equal(t, tt.sessionDate, sessionDate.Format("2006-01-02"))
+		})
+	}
+}
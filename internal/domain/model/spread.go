@@ -0,0 +1,105 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+var basisPoints = decimal.NewFromInt(10000)
+
+// SpreadModel describes how the simulator quotes the bid and ask of an instrument around
+// its price. Zero fields are unset, so a model can be used as a partial override with Merge.
+// Ticks and lots are those of the Instrument being quoted.
+type SpreadModel struct {
+	// SpreadBps is the typical distance between bid and ask in basis points of the price
+	SpreadBps decimal.Decimal
+	// MinTicks is the narrowest spread in price increments of the instrument
+	MinTicks int64
+	// MaxWidening is how much wider than SpreadBps a quote may randomly get, 0.5 is 50%
+	MaxWidening decimal.Decimal
+	// MaxLots is the largest number of lots shown on either side
+	MaxLots int64
+}
+
+// DefaultSpreadModel is the spread model of instruments of this class without an override
+func (c AssetClass) DefaultSpreadModel() SpreadModel {
+	model := SpreadModel{
+		SpreadBps:   decimal.NewFromInt(2),
+		MinTicks:    1,
+		MaxWidening: decimal.RequireFromString("0.5"),
+		MaxLots:     20,
+	}
+
+	switch c {
+	case AssetClassETF:
+		model.SpreadBps = decimal.NewFromInt(1)
+	case AssetClassREIT:
+		model.SpreadBps = decimal.NewFromInt(5)
+	case AssetClassBond:
+		model.SpreadBps = decimal.NewFromInt(10)
+		model.MaxLots = 50
+	case AssetClassCrypto:
+		model.SpreadBps = decimal.NewFromInt(5)
+		model.MaxWidening = decimal.NewFromInt(1)
+		model.MaxLots = 25
+	case AssetClassFX:
+		model.SpreadBps = decimal.RequireFromString("0.5")
+		model.MaxLots = 50
+	case AssetClassOption:
+		model.SpreadBps = decimal.NewFromInt(50)
+		model.MaxLots = 50
+	}
+
+	return model
+}
+
+// Merge returns the model with every field set in override replacing its own
+func (m SpreadModel) Merge(override SpreadModel) SpreadModel {
+	if !override.SpreadBps.IsZero() {
+		m.SpreadBps = override.SpreadBps
+	}
+	if override.MinTicks != 0 {
+		m.MinTicks = override.MinTicks
+	}
+	if !override.MaxWidening.IsZero() {
+		m.MaxWidening = override.MaxWidening
+	}
+	if override.MaxLots != 0 {
+		m.MaxLots = override.MaxLots
+	}
+	return m
+}
+
+// BidAsk quotes a bid and an ask of instrument around price. widening, between 0 and 1,
+// scales the spread from SpreadBps up to SpreadBps*(1+MaxWidening). The bid is rounded down
+// and the ask up to a tick, and they are at least MinTicks apart.
+func (m SpreadModel) BidAsk(price decimal.Decimal, instrument Instrument, widening decimal.Decimal) (bid, ask decimal.Decimal) {
+	tick := instrument.Tick()
+
+	spread := price.Mul(m.SpreadBps).Div(basisPoints).Mul(decimal.NewFromInt(1).Add(m.MaxWidening.Mul(widening)))
+	minSpread := tick.Mul(decimal.NewFromInt(m.MinTicks))
+	if spread.LessThan(minSpread) {
+		spread = minSpread
+	}
+
+	halfSpread := spread.Div(decimal.NewFromInt(2))
+	bid = instrument.FloorPrice(price.Sub(halfSpread))
+	if bid.LessThan(tick) {
+		bid = tick
+	}
+
+	ask = instrument.CeilPrice(price.Add(halfSpread))
+	if ask.Sub(bid).LessThan(minSpread) {
+		ask = bid.Add(minSpread)
+	}
+
+	return bid, ask
+}
+
+// Size is the quantity of instrument shown for the given number of lots, capped at MaxLots
+func (m SpreadModel) Size(lots int64, instrument Instrument) int64 {
+	if lots < 1 {
+		lots = 1
+	}
+	if m.MaxLots > 0 && lots > m.MaxLots {
+		lots = m.MaxLots
+	}
+	return instrument.Quantity(lots)
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadModel_BidAsk(t *testing.T) {
+	// Arrange
+	spreadModel := SpreadModel{
+		SpreadBps:   decimal.NewFromInt(10),
+		MinTicks:    1,
+		MaxWidening: decimal.NewFromInt(1),
+	}
+	price := decimal.RequireFromString("100.00")
+	instrument := AssetClassStock.DefaultInstrument()
+
+	// Act
+	bid, ask := spreadModel.BidAsk(price, instrument, decimal.Zero)
+	wideBid, wideAsk := spreadModel.BidAsk(price, instrument, decimal.NewFromInt(1))
+
+	// Assert
+	assert.Equal(t, "99.95", bid.String())
+	assert.Equal(t, "100.05", ask.String())
+	assert.Equal(t, "99.9", wideBid.String())
+	assert.Equal(t, "100.1", wideAsk.String())
+}
+
+func TestSpreadModel_BidAsk_MinimumSpread(t *testing.T) {
+	// Arrange
+	spreadModel := SpreadModel{SpreadBps: decimal.RequireFromString("0.1"), MinTicks: 2}
+	instrument := AssetClassStock.DefaultInstrument()
+
+	// Act
+	bid, ask := spreadModel.BidAsk(decimal.RequireFromString("10.00"), instrument, decimal.Zero)
+	pennyBid, pennyAsk := spreadModel.BidAsk(decimal.RequireFromString("0.01"), instrument, decimal.Zero)
+
+	// Assert
+	assert.Equal(t, "0.02", ask.Sub(bid).String())
+	assert.Equal(t, "0.01", pennyBid.String())
+	assert.Equal(t, "0.03", pennyAsk.String())
+}
+
+func TestSpreadModel_MergeAndSize(t *testing.T) {
+	// Arrange
+	base := AssetClassStock.DefaultSpreadModel()
+
+	// Act
+	merged := base.Merge(SpreadModel{SpreadBps: decimal.NewFromInt(7), MaxLots: 3})
+
+	// Assert
+	assert.Equal(t, "7", merged.SpreadBps.String())
+	assert.Equal(t, base.MaxWidening, merged.MaxWidening)
+	assert.Equal(t, base.MinTicks, merged.MinTicks)
+	assert.Equal(t, int64(100), merged.Size(0, AssetClassStock.DefaultInstrument()))
+	assert.Equal(t, int64(300), merged.Size(10, AssetClassStock.DefaultInstrument()))
+	assert.Equal(t, int64(300000), merged.Size(10, AssetClassFX.DefaultInstrument()))
+}
+
+func TestAssetQuote_WithBidAsk(t *testing.T) {
+	// Arrange
+	quote := NewAssetQuote("AAPL", "Apple Inc.", AssetClassStock, decimal.RequireFromString("175.50"), 0, 0, time.Now())
+
+	// Act
+	quoted := quote.WithBidAsk(decimal.RequireFromString("175.4812"), decimal.RequireFromString("175.52"), 300, 500)
+
+	// Assert
+	assert.True(t, quote.Spread().IsZero())
+	assert.Equal(t, "175.48", FormatPrice(quoted.Bid, quoted.PricePrecision))
+	assert.Equal(t, "0.04", quoted.Spread().String())
+	assert.Equal(t, int64(300), quoted.BidSize)
+	assert.Equal(t, int64(500), quoted.AskSize)
+}
+
+func TestSpreadModel_BidAsk_TickSize(t *testing.T) {
+	// Arrange
+	spreadModel := SpreadModel{SpreadBps: decimal.NewFromInt(10), MinTicks: 1}
+	instrument := Instrument{TickSize: decimal.RequireFromString("0.05"), PricePrecision: 2, LotSize: 100, Currency: "USD"}
+
+	// Act
+	bid, ask := spreadModel.BidAsk(decimal.RequireFromString("100.02"), instrument, decimal.Zero)
+
+	// Assert
+	assert.Equal(t, "99.95", bid.String())
+	assert.Equal(t, "100.1", ask.String())
+}
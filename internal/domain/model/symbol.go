@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	MaxSymbolLength         = 20
+	maxSymbolBaseLength     = 12
+	maxExchangeSuffixLength = 4
+)
+
+var ErrInvalidSymbol = errors.New("invalid symbol")
+
+// Symbol is a normalized ticker: an uppercase base code made of letters, digits and dashes
+// (AAPL, BRK-B, BTC-USD), optionally followed by a dot and an exchange suffix (PETR4.SA).
+// Values are only built through ParseSymbol, so two symbols naming the same instrument
+// always compare equal regardless of how the client spelled them.
+type Symbol string
+
+// ParseSymbol trims and uppercases raw and validates it against the symbol rules
+func ParseSymbol(raw string) (Symbol, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(raw))
+
+	if normalized == "" {
+		return "", fmt.Errorf("%w: symbol is empty", ErrInvalidSymbol)
+	}
+	if len(normalized) > MaxSymbolLength {
+		return "", fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidSymbol, raw, MaxSymbolLength)
+	}
+
+	base, exchange, hasExchange := strings.Cut(normalized, ".")
+	if err := validateSymbolBase(base); err != nil {
+		return "", fmt.Errorf("%w: %q %s", ErrInvalidSymbol, raw, err.Error())
+	}
+	if hasExchange {
+		if err := validateExchangeSuffix(exchange); err != nil {
+			return "", fmt.Errorf("%w: %q %s", ErrInvalidSymbol, raw, err.Error())
+		}
+	}
+
+	return Symbol(normalized), nil
+}
+
+// ParseSymbols parses every raw symbol, dropping duplicates while keeping the first occurrence
+func ParseSymbols(raw []string) ([]Symbol, error) {
+	symbols := make([]Symbol, 0, len(raw))
+	seen := make(map[Symbol]bool, len(raw))
+
+	for _, value := range raw {
+		symbol, err := ParseSymbol(value)
+		if err != nil {
+			return nil, err
+		}
+		if seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols, nil
+}
+
+func (s Symbol) String() string {
+	return string(s)
+}
+
+// Base returns the ticker without the exchange suffix
+func (s Symbol) Base() string {
+	base, _, _ := strings.Cut(string(s), ".")
+	return base
+}
+
+// Exchange returns the exchange suffix, or an empty string for symbols without one
+func (s Symbol) Exchange() string {
+	_, exchange, _ := strings.Cut(string(s), ".")
+	return exchange
+}
+
+func validateSymbolBase(base string) error {
+	if base == "" {
+		return errors.New("has an empty ticker")
+	}
+	if len(base) > maxSymbolBaseLength {
+		return fmt.Errorf("has a ticker longer than %d characters", maxSymbolBaseLength)
+	}
+	if base[0] == '-' || base[len(base)-1] == '-' {
+		return errors.New("cannot start or end with a dash")
+	}
+
+	for _, r := range base {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return fmt.Errorf("contains invalid character %q", r)
+		}
+	}
+
+	return nil
+}
+
+func validateExchangeSuffix(exchange string) error {
+	if exchange == "" || len(exchange) > maxExchangeSuffixLength {
+		return fmt.Errorf("must have an exchange suffix of 1 to %d letters", maxExchangeSuffixLength)
+	}
+
+	for _, r := range exchange {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("has invalid exchange suffix character %q", r)
+		}
+	}
+
+	return nil
+}
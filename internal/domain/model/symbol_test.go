@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSymbol(t *testing.T) {
+	testCases := []struct {
+		name             string
+		raw              string
+		expectedSymbol   Symbol
+		expectedBase     string
+		expectedExchange string
+		expectError      bool
+	}{
+		{name: "plain ticker", raw: "AAPL", expectedSymbol: "AAPL", expectedBase: "AAPL"},
+		{name: "lowercase with spaces", raw: "  aapl ", expectedSymbol: "AAPL", expectedBase: "AAPL"},
+		{name: "exchange suffix", raw: "petr4.sa", expectedSymbol: "PETR4.SA", expectedBase: "PETR4", expectedExchange: "SA"},
+		{name: "share class with dash", raw: "BRK-B", expectedSymbol: "BRK-B", expectedBase: "BRK-B"},
+		{name: "empty", raw: "   ", expectError: true},
+		{name: "too long", raw: "ABCDEFGHIJKLMNOPQRSTU", expectError: true},
+		{name: "ticker too long", raw: "ABCDEFGHIJKLM.SA", expectError: true},
+		{name: "invalid character", raw: "AAPL$", expectError: true},
+		{name: "underscore", raw: "INVALID_SYMBOL", expectError: true},
+		{name: "leading dash", raw: "-AAPL", expectError: true},
+		{name: "empty ticker", raw: ".SA", expectError: true},
+		{name: "empty exchange", raw: "PETR4.", expectError: true},
+		{name: "numeric exchange", raw: "PETR4.S1", expectError: true},
+		{name: "two exchanges", raw: "PETR4.SA.X", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			symbol, err := ParseSymbol(tc.raw)
+
+			// Assert
+			if tc.expectError {
+				assert.True(t, errors.Is(err, ErrInvalidSymbol))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSymbol, symbol)
+			assert.Equal(t, tc.expectedBase, symbol.Base())
+			assert.Equal(t, tc.expectedExchange, symbol.Exchange())
+		})
+	}
+}
+
+func TestParseSymbols_DeduplicatesNormalizedSymbols(t *testing.T) {
+	// Act
+	symbols, err := ParseSymbols([]string{"aapl", "MSFT", "AAPL ", "msft"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []Symbol{"AAPL", "MSFT"}, symbols)
+}
+
+func TestParseSymbols_FailsOnFirstInvalidSymbol(t *testing.T) {
+	// Act
+	symbols, err := ParseSymbols([]string{"AAPL", "BAD SYMBOL"})
+
+	// Assert
+	assert.Nil(t, symbols)
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
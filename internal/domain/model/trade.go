@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TradeSide is the aggressor side of a trade: a buyer lifting the ask or a seller hitting
+// the bid
+type TradeSide string
+
+const (
+	TradeSideBuy  TradeSide = "BUY"
+	TradeSideSell TradeSide = "SELL"
+)
+
+// Trade is a simulated trade print of a whole number of lots of its Instrument. Volume and
+// VWAP are the cumulative session statistics of the symbol including this trade.
+type Trade struct {
+	ID        uint64
+	Symbol    string
+	Price     decimal.Decimal
+	Size      int64
+	Side      TradeSide
+	Timestamp time.Time
+	Volume    int64
+	VWAP      decimal.Decimal
+	Instrument
+}
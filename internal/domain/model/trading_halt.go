@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// HaltReason tells why trading in a symbol was stopped
+type HaltReason string
+
+const (
+	HaltReasonAdmin     HaltReason = "ADMIN"
+	HaltReasonLimitUp   HaltReason = "LIMIT_UP"
+	HaltReasonLimitDown HaltReason = "LIMIT_DOWN"
+)
+
+func (r HaltReason) String() string {
+	return string(r)
+}
+
+// TradingHalt stops price updates for a symbol. Limit band halts end automatically at
+// ResumeAt; admin halts have a zero ResumeAt and last until they are resumed.
+type TradingHalt struct {
+	Symbol         string
+	Reason         HaltReason
+	Message        string
+	HaltedAt       time.Time
+	ResumeAt       time.Time
+	ReferencePrice decimal.Decimal
+	LimitPrice     decimal.Decimal
+}
+
+// IsExpired reports whether an automatic halt has reached its resume time
+func (h TradingHalt) IsExpired(now time.Time) bool {
+	return !h.ResumeAt.IsZero() && !now.Before(h.ResumeAt)
+}
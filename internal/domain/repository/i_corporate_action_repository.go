@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+)
+
+type ICorporateActionRepository interface {
+	GetPendingCorporateActions(through time.Time) ([]model.CorporateAction, error)
+	GetAppliedSymbolChanges() ([]model.CorporateAction, error)
+	ApplyCorporateAction(action model.CorporateAction, appliedAt time.Time) error
+}
@@ -3,5 +3,5 @@ package repository
 import "github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
 
 type IMarketDataRepository interface {
-	GetMarketData(symbols []string) ([]model.MarketDataModel, error)
+	GetMarketData(symbols []model.Symbol) ([]model.MarketDataModel, error)
 }
@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+
+type IQuoteStateRepository interface {
+	SaveQuoteStates(states []model.QuoteState) error
+	GetQuoteStates() ([]model.QuoteState, error)
+}
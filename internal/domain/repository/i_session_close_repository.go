@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+
+type ISessionCloseRepository interface {
+	SaveSessionCloses(closes []model.SessionClose) error
+	GetLatestSessionCloses() ([]model.SessionClose, error)
+}
@@ -1,133 +1,579 @@
 package service
 
 import (
-	"math/rand/v2"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
 )
 
+// AssetDataService is the registry of simulated assets. It is safe for concurrent use:
+// quotes are stored and returned by value, and Update replaces a quote atomically. Indices
+// and ETFs with holdings are registered with the definition their level or net asset value
+// is computed from.
 type AssetDataService struct {
-	assets map[string]*model.AssetQuote
+	mu      sync.RWMutex
+	assets  map[string]model.AssetQuote
+	indices map[string]model.IndexDefinition
 }
 
-func NewAssetDataService() *AssetDataService {
+// NewAssetDataService creates the simulated assets quoted at time now, the time bonds are
+// priced from their yields at
+func NewAssetDataService(now time.Time) *AssetDataService {
 	service := &AssetDataService{
-		assets: make(map[string]*model.AssetQuote),
+		assets:  make(map[string]model.AssetQuote),
+		indices: make(map[string]model.IndexDefinition),
 	}
-	service.initializeAssets()
+	service.initializeAssets(now)
 	return service
 }
 
-func (s *AssetDataService) initializeAssets() {
+func (s *AssetDataService) initializeAssets(now time.Time) {
 	stocks := []struct {
 		symbol    string
 		name      string
-		basePrice float64
+		basePrice string
 		volume    int64
 		marketCap int64
 	}{
-		{"AAPL", "Apple Inc.", 175.50, 50000000, 2800000000000},
-		{"MSFT", "Microsoft Corporation", 420.25, 25000000, 3100000000000},
-		{"GOOGL", "Alphabet Inc.", 140.80, 20000000, 1800000000000},
-		{"AMZN", "Amazon.com Inc.", 155.30, 35000000, 1600000000000},
-		{"TSLA", "Tesla Inc.", 248.75, 80000000, 790000000000},
-		{"NVDA", "NVIDIA Corporation", 875.20, 45000000, 2200000000000},
-		{"META", "Meta Platforms Inc.", 485.60, 15000000, 1200000000000},
-		{"NFLX", "Netflix Inc.", 485.90, 8000000, 210000000000},
-		{"JPM", "JPMorgan Chase & Co.", 185.40, 12000000, 540000000000},
-		{"V", "Visa Inc.", 275.80, 6000000, 580000000000},
+		{"AAPL", "Apple Inc.", "175.50", 50000000, 2800000000000},
+		{"MSFT", "Microsoft Corporation", "420.25", 25000000, 3100000000000},
+		{"GOOGL", "Alphabet Inc.", "140.80", 20000000, 1800000000000},
+		{"AMZN", "Amazon.com Inc.", "155.30", 35000000, 1600000000000},
+		{"TSLA", "Tesla Inc.", "248.75", 80000000, 790000000000},
+		{"NVDA", "NVIDIA Corporation", "875.20", 45000000, 2200000000000},
+		{"META", "Meta Platforms Inc.", "485.60", 15000000, 1200000000000},
+		{"NFLX", "Netflix Inc.", "485.90", 8000000, 210000000000},
+		{"JPM", "JPMorgan Chase & Co.", "185.40", 12000000, 540000000000},
+		{"V", "Visa Inc.", "275.80", 6000000, 580000000000},
 	}
 
 	etfs := []struct {
 		symbol    string
 		name      string
-		basePrice float64
+		basePrice string
 		volume    int64
 	}{
-		{"SPY", "SPDR S&P 500 ETF Trust", 485.20, 40000000},
-		{"QQQ", "Invesco QQQ Trust", 395.75, 35000000},
-		{"VTI", "Vanguard Total Stock Market ETF", 245.30, 25000000},
-		{"IWM", "iShares Russell 2000 ETF", 195.85, 20000000},
-		{"EFA", "iShares MSCI EAFE ETF", 78.90, 15000000},
-		{"GLD", "SPDR Gold Shares", 185.45, 10000000},
-		{"TLT", "iShares 20+ Year Treasury Bond ETF", 92.30, 8000000},
-		{"VNQ", "Vanguard Real Estate ETF", 85.75, 5000000},
-		{"XLF", "Financial Select Sector SPDR Fund", 38.20, 18000000},
-		{"XLK", "Technology Select Sector SPDR Fund", 195.60, 12000000},
+		{"SPY", "SPDR S&P 500 ETF Trust", "485.20", 40000000},
+		{"QQQ", "Invesco QQQ Trust", "395.75", 35000000},
+		{"VTI", "Vanguard Total Stock Market ETF", "245.30", 25000000},
+		{"IWM", "iShares Russell 2000 ETF", "195.85", 20000000},
+		{"EFA", "iShares MSCI EAFE ETF", "78.90", 15000000},
+		{"GLD", "SPDR Gold Shares", "185.45", 10000000},
+		{"TLT", "iShares 20+ Year Treasury Bond ETF", "92.30", 8000000},
+		{"VNQ", "Vanguard Real Estate ETF", "85.75", 5000000},
+		{"XLF", "Financial Select Sector SPDR Fund", "38.20", 18000000},
+		{"XLK", "Technology Select Sector SPDR Fund", "195.60", 12000000},
+	}
+
+	// Stocks listed on B3, traded in whole lots of 100 shares and priced in reais
+	brazilianStocks := []struct {
+		symbol    string
+		name      string
+		basePrice string
+		volume    int64
+		marketCap int64
+	}{
+		{"PETR4.SA", "Petróleo Brasileiro S.A. - Petrobras", "38.45", 40000000, 500000000000},
+		{"VALE3.SA", "Vale S.A.", "62.10", 20000000, 280000000000},
+	}
+
+	// FX pairs are priced in their quote currency: EURUSD is the price of one euro in dollars.
+	// They are also the rates prices are converted with.
+	fxPairs := []struct {
+		symbol    string
+		name      string
+		rate      string
+		precision int32
+	}{
+		{"EURUSD", "Euro / US Dollar", "1.08450", 5},
+		{"GBPUSD", "British Pound / US Dollar", "1.27120", 5},
+		{"USDJPY", "US Dollar / Japanese Yen", "151.230", 3},
+		{"USDBRL", "US Dollar / Brazilian Real", "5.0421", 4},
+	}
+
+	// Crypto pairs are priced in dollars and trade around the clock
+	cryptoPairs := []struct {
+		symbol    string
+		name      string
+		price     string
+		precision int32
+		volume    int64
+		marketCap int64
+	}{
+		{"BTC-USD", "Bitcoin / US Dollar", "67250.00", 2, 25000, 1320000000000},
+		{"ETH-USD", "Ethereum / US Dollar", "3450.00", 2, 300000, 415000000000},
+		{"SOL-USD", "Solana / US Dollar", "145.2500", 4, 2000000, 67000000000},
+	}
+
+	// Bonds are quoted per 100 of face value and priced from their yield, in percent
+	bonds := []struct {
+		symbol     string
+		name       string
+		couponRate string
+		maturity   time.Time
+		yield      string
+	}{
+		{"UST2Y", "US Treasury 3.875% 03/2028", "3.875", time.Date(2028, 3, 31, 0, 0, 0, 0, time.UTC), "3.95"},
+		{"UST10Y", "US Treasury 4.25% 11/2035", "4.25", time.Date(2035, 11, 15, 0, 0, 0, 0, time.UTC), "4.30"},
+		{"UST30Y", "US Treasury 4.625% 11/2055", "4.625", time.Date(2055, 11, 15, 0, 0, 0, 0, time.UTC), "4.75"},
+	}
+
+	// Indices hold one share of each constituent and start at their base level
+	indices := []struct {
+		symbol       string
+		name         string
+		baseLevel    string
+		constituents []string
+	}{
+		{"MEGA7", "Mega Cap Technology Index", "1000.00", []string{"AAPL", "MSFT", "GOOGL", "AMZN", "NVDA", "META", "TSLA"}},
+		{"US10", "US Large Cap 10 Index", "5000.00", []string{"AAPL", "MSFT", "GOOGL", "AMZN", "TSLA", "NVDA", "META", "NFLX", "JPM", "V"}},
+	}
+
+	// ETF holdings are percentages of their net asset value at their base price, limited to the
+	// simulated stocks
+	etfHoldings := []struct {
+		symbol   string
+		holdings map[string]string
+	}{
+		{"QQQ", map[string]string{"AAPL": "18", "MSFT": "18", "NVDA": "16", "AMZN": "12", "META": "10", "GOOGL": "10", "TSLA": "8", "NFLX": "8"}},
+		{"XLK", map[string]string{"AAPL": "35", "MSFT": "35", "NVDA": "30"}},
+		{"XLF", map[string]string{"JPM": "55", "V": "45"}},
 	}
 
 	for _, stock := range stocks {
 		quote := model.NewAssetQuote(
 			stock.symbol,
 			stock.name,
-			model.AssetTypeStock,
-			stock.basePrice,
+			model.AssetClassStock,
+			decimal.RequireFromString(stock.basePrice),
 			stock.volume,
 			stock.marketCap,
+			now,
 		)
 		s.assets[stock.symbol] = quote
 	}
 
+	for _, stock := range brazilianStocks {
+		quote := model.NewAssetQuote(
+			stock.symbol,
+			stock.name,
+			model.AssetClassStock,
+			decimal.RequireFromString(stock.basePrice),
+			stock.volume,
+			stock.marketCap,
+			now,
+		)
+		instrument := quote.Instrument
+		instrument.Currency = "BRL"
+		s.assets[stock.symbol] = quote.WithInstrument(instrument)
+	}
+
+	for _, pair := range fxPairs {
+		quote := model.NewAssetQuote(pair.symbol, pair.name, model.AssetClassFX, decimal.RequireFromString(pair.rate), 0, 0, now)
+		instrument := quote.Instrument
+		instrument.PricePrecision = pair.precision
+		instrument.TickSize = decimal.New(1, -pair.precision)
+		instrument.Currency = pair.symbol[3:]
+		quote = quote.WithInstrument(instrument)
+		quote.BaseCurrency = pair.symbol[:3]
+		s.assets[pair.symbol] = quote
+	}
+
+	for _, pair := range cryptoPairs {
+		quote := model.NewAssetQuote(pair.symbol, pair.name, model.AssetClassCrypto, decimal.RequireFromString(pair.price), pair.volume, pair.marketCap, now)
+		instrument := quote.Instrument
+		instrument.PricePrecision = pair.precision
+		instrument.TickSize = decimal.New(1, -pair.precision)
+		quote = quote.WithInstrument(instrument)
+		quote.BaseCurrency = pair.symbol[:3]
+		s.assets[pair.symbol] = quote
+	}
+
+	for _, bond := range bonds {
+		terms := model.BondTerms{
+			CouponRate:      decimal.RequireFromString(bond.couponRate),
+			CouponFrequency: 2,
+			Maturity:        bond.maturity,
+		}
+		price := terms.Price(decimal.RequireFromString(bond.yield), now)
+		quote := model.NewAssetQuote(bond.symbol, bond.name, model.AssetClassBond, price, 0, 0, now)
+		s.assets[bond.symbol] = quote.WithBond(terms)
+	}
+
 	for _, etf := range etfs {
 		quote := model.NewAssetQuote(
 			etf.symbol,
 			etf.name,
-			model.AssetTypeETF,
-			etf.basePrice,
+			model.AssetClassETF,
+			decimal.RequireFromString(etf.basePrice),
 			etf.volume,
 			0,
+			now,
 		)
 		s.assets[etf.symbol] = quote
 	}
+
+	for _, index := range indices {
+		spec := model.IndexSpec{
+			Definition: model.IndexDefinition{Symbol: model.Symbol(index.symbol), Name: index.name},
+			Weighting:  model.IndexWeightingUnits,
+			BaseLevel:  decimal.RequireFromString(index.baseLevel),
+		}
+		for _, symbol := range index.constituents {
+			spec.Definition.Constituents = append(spec.Definition.Constituents, model.IndexConstituent{
+				Symbol: model.Symbol(symbol),
+				Weight: decimal.NewFromInt(1),
+			})
+		}
+		if err := s.registerIndexLocked(spec); err != nil {
+			log.Printf("Skipping index %s: %v", index.symbol, err)
+		}
+	}
+
+	for _, etf := range etfHoldings {
+		spec := model.IndexSpec{
+			Definition: model.IndexDefinition{Symbol: model.Symbol(etf.symbol)},
+			Weighting:  model.IndexWeightingPercent,
+		}
+		symbols := make([]string, 0, len(etf.holdings))
+		for symbol := range etf.holdings {
+			symbols = append(symbols, symbol)
+		}
+		sort.Strings(symbols)
+		for _, symbol := range symbols {
+			spec.Definition.Constituents = append(spec.Definition.Constituents, model.IndexConstituent{
+				Symbol: model.Symbol(symbol),
+				Weight: decimal.RequireFromString(etf.holdings[symbol]),
+			})
+		}
+		if err := s.registerIndexLocked(spec); err != nil {
+			log.Printf("Skipping holdings of %s: %v", etf.symbol, err)
+		}
+	}
 }
 
-func (s *AssetDataService) GetAllAssets() map[string]*model.AssetQuote {
-	result := make(map[string]*model.AssetQuote)
-	for symbol, quote := range s.assets {
-		result[symbol] = quote
+// RegisterIndex adds the index of spec, or replaces the index with its symbol, quoted at its
+// base level at the current prices of its constituents and stamped with the latest of them.
+// When spec names an ETF it defines the holdings of the ETF instead, which then trades at
+// their net asset value per share. Constituents must be simulated in one currency and cannot
+// be indices or ETFs with holdings themselves.
+func (s *AssetDataService) RegisterIndex(spec model.IndexSpec) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.registerIndexLocked(spec)
+}
+
+func (s *AssetDataService) registerIndexLocked(spec model.IndexSpec) error {
+	symbol := spec.Definition.Symbol.String()
+
+	var currency string
+	var quotedAt time.Time
+	for _, constituent := range spec.Definition.Constituents {
+		quote, exists := s.assets[constituent.Symbol.String()]
+		switch {
+		case !exists:
+			return fmt.Errorf("%w: %s constituent %s is not simulated", model.ErrInvalidIndex, symbol, constituent.Symbol)
+		case s.isBasketLocked(constituent.Symbol.String()):
+			return fmt.Errorf("%w: %s constituent %s is itself computed from constituents", model.ErrInvalidIndex, symbol, constituent.Symbol)
+		case currency != "" && quote.Currency != currency:
+			return fmt.Errorf("%w: %s mixes %s and %s constituents", model.ErrInvalidIndex, symbol, currency, quote.Currency)
+		}
+		currency = quote.Currency
+		if quote.LastUpdated.After(quotedAt) {
+			quotedAt = quote.LastUpdated
+		}
 	}
-	return result
+
+	existing, exists := s.assets[symbol]
+	if exists && existing.AssetClass == model.AssetClassETF {
+		return s.registerHoldingsLocked(existing, spec)
+	}
+	if exists && existing.AssetClass != model.AssetClassIndex {
+		return fmt.Errorf("%w: %s is a %s", model.ErrInvalidIndex, symbol, existing.AssetClass)
+	}
+
+	definition, err := spec.Resolve(s.currentPriceLocked)
+	if err != nil {
+		return err
+	}
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+
+	quote := model.NewAssetQuote(symbol, definition.Name, model.AssetClassIndex, spec.BaseLevel, 0, 0, quotedAt)
+	instrument := quote.Instrument
+	instrument.Currency = currency
+	quote = quote.WithInstrument(instrument)
+	quote.Constituents = constituentSymbols(definition)
+	s.assets[symbol] = quote
+	s.indices[symbol] = definition
+	return nil
+}
+
+// registerHoldingsLocked defines the holdings of etf, valued at its current price unless the
+// spec sets a base level
+func (s *AssetDataService) registerHoldingsLocked(etf model.AssetQuote, spec model.IndexSpec) error {
+	if spec.BaseLevel.IsZero() {
+		spec.BaseLevel = etf.CurrentPrice
+	}
+	if spec.Definition.Name == "" {
+		spec.Definition.Name = etf.Name
+	}
+
+	definition, err := spec.Resolve(s.currentPriceLocked)
+	if err != nil {
+		return err
+	}
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+
+	nav, err := definition.Level(s.currentPriceLocked)
+	if err != nil {
+		return err
+	}
+
+	etf.Constituents = constituentSymbols(definition)
+	s.assets[etf.Symbol] = etf.WithNAV(nav)
+	s.indices[etf.Symbol] = definition
+	return nil
+}
+
+func (s *AssetDataService) isBasketLocked(symbol string) bool {
+	_, exists := s.indices[symbol]
+	return exists
+}
+
+func constituentSymbols(definition model.IndexDefinition) []string {
+	symbols := make([]string, len(definition.Constituents))
+	for i, constituent := range definition.Constituents {
+		symbols[i] = constituent.Symbol.String()
+	}
+	return symbols
+}
+
+// currentPriceLocked returns the current price of symbol; the caller holds the lock
+func (s *AssetDataService) currentPriceLocked(symbol model.Symbol) (decimal.Decimal, bool) {
+	quote, exists := s.assets[symbol.String()]
+	return quote.CurrentPrice, exists
+}
+
+// GetIndex returns the definition of an index or of the holdings of an ETF, or false when
+// symbol is computed from no constituents
+func (s *AssetDataService) GetIndex(symbol string) (model.IndexDefinition, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	definition, exists := s.indices[symbol]
+	return definition, exists
+}
+
+// GetIndices returns the definition of every index and ETF with holdings ordered by symbol
+func (s *AssetDataService) GetIndices() []model.IndexDefinition {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	indices := make([]model.IndexDefinition, 0, len(s.indices))
+	for _, definition := range s.indices {
+		indices = append(indices, definition)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i].Symbol < indices[j].Symbol })
+	return indices
+}
+
+// IndexLevel computes the current level of an index, or the net asset value per share of an
+// ETF, from the prices of its constituents
+func (s *AssetDataService) IndexLevel(symbol string) (decimal.Decimal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	definition, exists := s.indices[symbol]
+	if !exists {
+		return decimal.Zero, fmt.Errorf("%w: %s is not an index", model.ErrInvalidIndex, symbol)
+	}
+	return definition.Level(s.currentPriceLocked)
 }
 
-func (s *AssetDataService) GetRandomAssets(count int) map[string]*model.AssetQuote {
-	result := make(map[string]*model.AssetQuote)
-	symbols := make([]string, 0, count)
+// ResolveCorporateAction checks that action can be applied to the simulated assets and
+// returns it with its symbols normalized and its price factor resolved against the previous
+// close of its symbol. Nothing changes until it is applied with ApplyCorporateAction.
+func (s *AssetDataService) ResolveCorporateAction(action model.CorporateAction) (model.CorporateAction, error) {
+	if err := action.Validate(); err != nil {
+		return model.CorporateAction{}, err
+	}
+	symbol, _ := model.ParseSymbol(action.Symbol)
+	action.Symbol = symbol.String()
+	if action.Type == model.CorporateActionSymbolChange {
+		newSymbol, _ := model.ParseSymbol(action.NewSymbol)
+		action.NewSymbol = newSymbol.String()
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	for symbol := range s.assets {
-		symbols = append(symbols, symbol)
+	quote, err := s.corporateActionQuoteLocked(action)
+	if err != nil {
+		return model.CorporateAction{}, err
 	}
+	return action.WithPriceFactor(quote.PreviousClose)
+}
 
-	rand.Shuffle(len(symbols), func(i, j int) {
-		symbols[i], symbols[j] = symbols[j], symbols[i]
-	})
+// ApplyCorporateAction adjusts the quote of a resolved action by its price and size factors and moves
+// it to its new symbol on a symbol change. The baskets follow: the holdings of an ETF are
+// rescaled with its price, a split scales the units of the symbol held by indices and ETFs so
+// their levels do not jump, and a symbol change renames the constituent.
+func (s *AssetDataService) ApplyCorporateAction(action model.CorporateAction) (model.AssetQuote, error) {
+	if !action.PriceFactor.IsPositive() {
+		return model.AssetQuote{}, fmt.Errorf("%w: %s %s has no price factor", model.ErrInvalidCorporateAction, action.Type, action.Symbol)
+	}
 
-	for _, symbol := range symbols[:count] {
-		result[symbol] = s.assets[symbol]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quote, err := s.corporateActionQuoteLocked(action)
+	if err != nil {
+		return model.AssetQuote{}, err
+	}
+	quote = quote.WithAdjustment(action.PriceFactor, action.SizeFactor())
+
+	if definition, isBasket := s.indices[action.Symbol]; isBasket {
+		definition.Divisor = definition.Divisor.Div(action.PriceFactor)
+		s.indices[action.Symbol] = definition
+	}
+
+	switch action.Type {
+	case model.CorporateActionSplit:
+		sizeFactor := action.SizeFactor()
+		s.updateConstituentLocked(action.Symbol, func(constituent model.IndexConstituent) model.IndexConstituent {
+			constituent.Weight = constituent.Weight.Mul(sizeFactor)
+			return constituent
+		})
+	case model.CorporateActionSymbolChange:
+		s.updateConstituentLocked(action.Symbol, func(constituent model.IndexConstituent) model.IndexConstituent {
+			constituent.Symbol = model.Symbol(action.NewSymbol)
+			return constituent
+		})
+		if definition, isBasket := s.indices[action.Symbol]; isBasket {
+			delete(s.indices, action.Symbol)
+			definition.Symbol = model.Symbol(action.NewSymbol)
+			s.indices[action.NewSymbol] = definition
+		}
+		delete(s.assets, action.Symbol)
+		quote.Symbol = action.NewSymbol
+	}
+
+	s.assets[quote.Symbol] = quote
+	return quote, nil
+}
+
+// corporateActionQuoteLocked returns the quote a corporate action applies to, checking that
+// its class issues shares and that a new symbol is free
+func (s *AssetDataService) corporateActionQuoteLocked(action model.CorporateAction) (model.AssetQuote, error) {
+	quote, exists := s.assets[action.Symbol]
+	switch {
+	case !exists:
+		return model.AssetQuote{}, fmt.Errorf("%w: %s is not simulated", model.ErrInvalidCorporateAction, action.Symbol)
+	case !quote.AssetClass.HasCorporateActions():
+		return model.AssetQuote{}, fmt.Errorf("%w: %s is a %s", model.ErrInvalidCorporateAction, action.Symbol, quote.AssetClass)
+	}
+
+	if action.Type == model.CorporateActionSymbolChange {
+		if _, taken := s.assets[action.NewSymbol]; taken {
+			return model.AssetQuote{}, fmt.Errorf("%w: %s is already simulated", model.ErrInvalidCorporateAction, action.NewSymbol)
+		}
+	}
+	return quote, nil
+}
+
+// updateConstituentLocked replaces symbol in every basket holding it with the constituent
+// returned by fn. Definitions are copied, so those already returned by GetIndex do not change.
+func (s *AssetDataService) updateConstituentLocked(symbol string, fn func(model.IndexConstituent) model.IndexConstituent) {
+	for basket, definition := range s.indices {
+		constituents := make([]model.IndexConstituent, len(definition.Constituents))
+		held := false
+		for i, constituent := range definition.Constituents {
+			if constituent.Symbol.String() == symbol {
+				constituent = fn(constituent)
+				held = true
+			}
+			constituents[i] = constituent
+		}
+		if !held {
+			continue
+		}
+
+		definition.Constituents = constituents
+		s.indices[basket] = definition
+		quote := s.assets[basket]
+		quote.Constituents = constituentSymbols(definition)
+		s.assets[basket] = quote
+	}
+}
+
+func (s *AssetDataService) GetAllAssets() map[string]model.AssetQuote {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(map[string]model.AssetQuote, len(s.assets))
+	for symbol, quote := range s.assets {
+		result[symbol] = quote
 	}
 	return result
 }
 
-func (s *AssetDataService) GetAssetBySymbol(symbol string) (*model.AssetQuote, bool) {
-	quote, exists := s.assets[symbol]
+// GetAssetBySymbol looks up an asset by any spelling of its symbol; malformed symbols are never found
+func (s *AssetDataService) GetAssetBySymbol(symbol string) (model.AssetQuote, bool) {
+	parsed, err := model.ParseSymbol(symbol)
+	if err != nil {
+		return model.AssetQuote{}, false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	quote, exists := s.assets[parsed.String()]
 	return quote, exists
 }
 
-func (s *AssetDataService) GetStocks() []*model.AssetQuote {
-	var stocks []*model.AssetQuote
+func (s *AssetDataService) GetAssetsByClass(assetClass model.AssetClass) []model.AssetQuote {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var assets []model.AssetQuote
 	for _, quote := range s.assets {
-		if quote.Type == model.AssetTypeStock {
-			stocks = append(stocks, quote)
+		if quote.AssetClass == assetClass {
+			assets = append(assets, quote)
 		}
 	}
-	return stocks
+	return assets
 }
 
-func (s *AssetDataService) GetETFs() []*model.AssetQuote {
-	var etfs []*model.AssetQuote
-	for _, quote := range s.assets {
-		if quote.Type == model.AssetTypeETF {
-			etfs = append(etfs, quote)
-		}
+func (s *AssetDataService) GetStocks() []model.AssetQuote {
+	return s.GetAssetsByClass(model.AssetClassStock)
+}
+
+func (s *AssetDataService) GetETFs() []model.AssetQuote {
+	return s.GetAssetsByClass(model.AssetClassETF)
+}
+
+// Update atomically replaces the quote of symbol with the snapshot returned by fn.
+// It returns the new snapshot, or false when the symbol is not registered.
+func (s *AssetDataService) Update(symbol string, fn func(model.AssetQuote) model.AssetQuote) (model.AssetQuote, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quote, exists := s.assets[symbol]
+	if !exists {
+		return model.AssetQuote{}, false
 	}
-	return etfs
+
+	updated := fn(quote)
+	s.assets[symbol] = updated
+	return updated, true
 }
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetDataService_GetAssetBySymbol_NormalizesSymbol(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+
+	// Act
+	quote, exists := service.GetAssetBySymbol(" aapl ")
+	_, invalidExists := service.GetAssetBySymbol("AAPL$")
+
+	// Assert
+	assert.True(t, exists)
+	assert.Equal(t, "AAPL", quote.Symbol)
+	assert.False(t, invalidExists)
+}
+
+func TestAssetDataService_ReturnedQuotesAreSnapshots(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	before, _ := service.GetAssetBySymbol("AAPL")
+
+	// Act
+	_, updated := service.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(quote.BasePrice.Add(decimal.NewFromInt(10)), time.Now())
+	})
+	after, _ := service.GetAssetBySymbol("AAPL")
+
+	// Assert
+	assert.True(t, updated)
+	assert.True(t, before.CurrentPrice.Equal(before.BasePrice))
+	assert.True(t, after.CurrentPrice.Equal(before.BasePrice.Add(decimal.NewFromInt(10))))
+}
+
+func TestAssetDataService_AssetClasses(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+
+	// Act
+	bitcoin, _ := service.GetAssetBySymbol("BTC-USD")
+	euro, _ := service.GetAssetBySymbol("EURUSD")
+	treasury, _ := service.GetAssetBySymbol("UST10Y")
+	index, _ := service.GetAssetBySymbol("MEGA7")
+
+	// Assert
+	assert.Equal(t, model.AssetClassCrypto, bitcoin.AssetClass)
+	assert.Equal(t, "BTC", bitcoin.BaseCurrency)
+	assert.Equal(t, "EUR", euro.BaseCurrency)
+	assert.Equal(t, "USD", euro.Currency)
+	assert.Equal(t, model.AssetClassBond, treasury.AssetClass)
+	assert.False(t, treasury.Bond.IsZero())
+	assert.Equal(t, "4.3", treasury.Yield.Round(2).String())
+	assert.Equal(t, model.AssetClassIndex, index.AssetClass)
+	assert.Equal(t, []string{"AAPL", "MSFT", "GOOGL", "AMZN", "NVDA", "META", "TSLA"}, index.Constituents)
+}
+
+func TestAssetDataService_IndexLevel(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	index, _ := service.GetAssetBySymbol("MEGA7")
+	definition, exists := service.GetIndex("MEGA7")
+	require.True(t, exists)
+
+	// Act
+	base, err := service.IndexLevel("MEGA7")
+	require.NoError(t, err)
+	service.Update("AAPL", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(quote.CurrentPrice.Add(decimal.NewFromInt(7)), time.Now())
+	})
+	moved, err := service.IndexLevel("MEGA7")
+	require.NoError(t, err)
+	_, err = service.IndexLevel("AAPL")
+
+	// Assert
+	assert.True(t, base.Round(2).Equal(index.CurrentPrice))
+	assert.True(t, moved.Sub(base).Equal(decimal.NewFromInt(7).Div(definition.Divisor)))
+	assert.ErrorIs(t, err, model.ErrInvalidIndex)
+	assert.Len(t, service.GetIndices(), 5)
+}
+
+func TestAssetDataService_ETFHoldings(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	etf, _ := service.GetAssetBySymbol("XLK")
+
+	// Act
+	nav, err := service.IndexLevel("XLK")
+	require.NoError(t, err)
+	service.Update("NVDA", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(quote.CurrentPrice.Mul(decimal.RequireFromString("1.10")), time.Now())
+	})
+	moved, err := service.IndexLevel("XLK")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, model.AssetClassETF, etf.AssetClass)
+	assert.Equal(t, []string{"AAPL", "MSFT", "NVDA"}, etf.Constituents)
+	assert.Equal(t, etf.CurrentPrice.StringFixed(4), etf.NAV.StringFixed(4))
+	assert.Equal(t, etf.CurrentPrice.StringFixed(4), nav.StringFixed(4))
+	// NVDA is 30% of the holdings, so a 10% move in NVDA moves the NAV by 3%
+	assert.Equal(t, etf.CurrentPrice.Mul(decimal.RequireFromString("1.03")).StringFixed(2), moved.StringFixed(2))
+}
+
+func TestAssetDataService_RegisterIndex(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	spec := model.IndexSpec{
+		Definition: model.IndexDefinition{
+			Symbol: "CHIPS",
+			Name:   "Chip Makers",
+			Constituents: []model.IndexConstituent{
+				{Symbol: "NVDA", Weight: decimal.NewFromInt(60)},
+				{Symbol: "AAPL", Weight: decimal.NewFromInt(40)},
+			},
+		},
+		Weighting: model.IndexWeightingPercent,
+		BaseLevel: decimal.NewFromInt(100),
+	}
+
+	// Act
+	err := service.RegisterIndex(spec)
+
+	// Assert
+	require.NoError(t, err)
+	quote, exists := service.GetAssetBySymbol("CHIPS")
+	require.True(t, exists)
+	assert.Equal(t, model.AssetClassIndex, quote.AssetClass)
+	assert.Equal(t, "100", quote.CurrentPrice.String())
+	assert.Equal(t, []string{"NVDA", "AAPL"}, quote.Constituents)
+	level, err := service.IndexLevel("CHIPS")
+	require.NoError(t, err)
+	assert.Equal(t, "100.0000", level.StringFixed(4))
+}
+
+func TestAssetDataService_RegisterIndex_Invalid(t *testing.T) {
+	constituents := func(symbols ...model.Symbol) []model.IndexConstituent {
+		result := make([]model.IndexConstituent, len(symbols))
+		for i, symbol := range symbols {
+			result[i] = model.IndexConstituent{Symbol: symbol, Weight: decimal.NewFromInt(1)}
+		}
+		return result
+	}
+
+	tests := map[string]model.IndexDefinition{
+		"unknown constituent": {Symbol: "CUSTOM", Constituents: constituents("AAPL", "ZZZZ")},
+		"nested basket":       {Symbol: "CUSTOM", Constituents: constituents("AAPL", "QQQ")},
+		"mixed currencies":    {Symbol: "CUSTOM", Constituents: constituents("AAPL", "PETR4.SA")},
+		"stock symbol":        {Symbol: "MSFT", Constituents: constituents("AAPL")},
+	}
+
+	for name, definition := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			service := NewAssetDataService(time.Now())
+
+			// Act
+			err := service.RegisterIndex(model.IndexSpec{Definition: definition, Weighting: model.IndexWeightingUnits, BaseLevel: decimal.NewFromInt(100)})
+
+			// Assert
+			assert.ErrorIs(t, err, model.ErrInvalidIndex)
+		})
+	}
+}
+
+func TestAssetDataService_ApplyCorporateAction(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	apple, _ := service.GetAssetBySymbol("AAPL")
+	etf, _ := service.GetAssetBySymbol("XLK")
+	index, _ := service.GetIndex("MEGA7")
+	megaLevel, _ := service.IndexLevel("MEGA7")
+
+	resolve := func(action model.CorporateAction) model.CorporateAction {
+		resolved, err := service.ResolveCorporateAction(action)
+		require.NoError(t, err)
+		return resolved
+	}
+	appleSplit := resolve(model.CorporateAction{ID: 1, Symbol: "aapl", Type: model.CorporateActionSplit, SplitFrom: 1, SplitTo: 4})
+	etfSplit := resolve(model.CorporateAction{ID: 2, Symbol: "XLK", Type: model.CorporateActionSplit, SplitFrom: 1, SplitTo: 2})
+	rename := resolve(model.CorporateAction{ID: 3, Symbol: "NVDA", Type: model.CorporateActionSymbolChange, NewSymbol: "nvdx"})
+
+	// Act
+	splitApple, appleErr := service.ApplyCorporateAction(appleSplit)
+	splitETF, etfErr := service.ApplyCorporateAction(etfSplit)
+	renamed, renameErr := service.ApplyCorporateAction(rename)
+
+	// Assert
+	require.NoError(t, appleErr)
+	require.NoError(t, etfErr)
+	require.NoError(t, renameErr)
+	assert.Equal(t, "AAPL", appleSplit.Symbol)
+	assert.Equal(t, apple.CurrentPrice.Div(decimal.NewFromInt(4)).StringFixed(2), splitApple.CurrentPrice.StringFixed(2))
+	assert.Equal(t, apple.PreviousClose.Div(decimal.NewFromInt(4)).StringFixed(2), splitApple.PreviousClose.StringFixed(2))
+	assert.Equal(t, apple.Volume*4, splitApple.Volume)
+
+	// Indices hold four times the units of AAPL, so their levels do not jump
+	movedIndex, _ := service.GetIndex("MEGA7")
+	assert.True(t, index.Constituents[0].Weight.Mul(decimal.NewFromInt(4)).Equal(movedIndex.Constituents[0].Weight))
+	level, err := service.IndexLevel("MEGA7")
+	require.NoError(t, err)
+	assert.InDelta(t, megaLevel.InexactFloat64(), level.InexactFloat64(), 0.01)
+
+	// The ETF and its net asset value per share halve with its split
+	nav, err := service.IndexLevel("XLK")
+	require.NoError(t, err)
+	assert.Equal(t, etf.CurrentPrice.Div(decimal.NewFromInt(2)).StringFixed(2), splitETF.CurrentPrice.StringFixed(2))
+	assert.InDelta(t, splitETF.CurrentPrice.InexactFloat64(), nav.InexactFloat64(), 0.01)
+
+	assert.Equal(t, "NVDX", renamed.Symbol)
+	_, oldExists := service.GetAssetBySymbol("NVDA")
+	assert.False(t, oldExists)
+	renamedETF, _ := service.GetAssetBySymbol("XLK")
+	assert.Equal(t, []string{"AAPL", "MSFT", "NVDX"}, renamedETF.Constituents)
+	renamedNAV, err := service.IndexLevel("XLK")
+	require.NoError(t, err)
+	assert.True(t, nav.Equal(renamedNAV))
+}
+
+func TestAssetDataService_ResolveCorporateAction_Invalid(t *testing.T) {
+	tests := map[string]model.CorporateAction{
+		"unknown symbol": {Symbol: "ZZZZ", Type: model.CorporateActionSplit, SplitFrom: 1, SplitTo: 2},
+		"index":          {Symbol: "MEGA7", Type: model.CorporateActionSplit, SplitFrom: 1, SplitTo: 2},
+		"currency pair":  {Symbol: "EURUSD", Type: model.CorporateActionCashDividend, DividendAmount: decimal.NewFromInt(1)},
+		"symbol taken":   {Symbol: "AAPL", Type: model.CorporateActionSymbolChange, NewSymbol: "MSFT"},
+		"invalid terms":  {Symbol: "AAPL", Type: model.CorporateActionSplit},
+	}
+
+	for name, action := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			service := NewAssetDataService(time.Now())
+
+			// Act
+			_, err := service.ResolveCorporateAction(action)
+
+			// Assert
+			assert.ErrorIs(t, err, model.ErrInvalidCorporateAction)
+		})
+	}
+}
+
+func TestAssetDataService_Update_UnknownSymbol(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+
+	// Act
+	_, updated := service.Update("UNKNOWN", func(quote model.AssetQuote) model.AssetQuote {
+		t.Fatal("update function must not be called for unknown symbols")
+		return quote
+	})
+
+	// Assert
+	assert.False(t, updated)
+}
+
+// TestAssetDataService_ConcurrentUpdates is meant to run with -race: concurrent writers
+// increment the same quote while readers take snapshots, and no increment may be lost
+func TestAssetDataService_ConcurrentUpdates(t *testing.T) {
+	// Arrange
+	service := NewAssetDataService(time.Now())
+	start, exists := service.GetAssetBySymbol("MSFT")
+	require.True(t, exists)
+
+	const writers = 50
+	const updatesPerWriter = 200
+	increment := decimal.RequireFromString("0.01")
+
+	var wg sync.WaitGroup
+
+	// Act
+	for w := 0; w < writers; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < updatesPerWriter; i++ {
+				service.Update("MSFT", func(quote model.AssetQuote) model.AssetQuote {
+					return quote.WithPrice(quote.CurrentPrice.Add(increment), time.Now())
+				})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < updatesPerWriter; i++ {
+				for _, quote := range service.GetAllAssets() {
+					_ = quote.CurrentPrice.String()
+				}
+				_ = service.GetStocks()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	final, _ := service.GetAssetBySymbol("MSFT")
+	expected := start.CurrentPrice.Add(increment.Mul(decimal.NewFromInt(writers * updatesPerWriter)))
+	assert.Equal(t, expected.StringFixed(2), final.CurrentPrice.StringFixed(2))
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+)
+
+// DefaultExchangeCode is the exchange of symbols without an exchange suffix
+const DefaultExchangeCode = "US"
+
+const (
+	// CryptoExchangeCode is the calendar crypto pairs trade on by default, around the clock
+	CryptoExchangeCode = "CRYPTO"
+	// FXExchangeCode is the calendar FX pairs trade on by default, around the clock on weekdays
+	FXExchangeCode = "FX"
+)
+
+// MarketHoursService resolves the exchange calendar of a symbol: symbols with an exchange
+// suffix (PETR4.SA) trade on the calendar with that code, symbols of an asset class with
+// its own calendar (crypto, FX) on that calendar, and every other symbol on the default
+// exchange
+type MarketHoursService struct {
+	calendars           map[string]model.ExchangeCalendar
+	defaultExchange     string
+	assetClassExchanges map[model.AssetClass]string
+}
+
+func NewMarketHoursService(
+	calendars []model.ExchangeCalendar,
+	defaultExchange string,
+	assetClassExchanges map[model.AssetClass]string,
+) (*MarketHoursService, error) {
+	service := &MarketHoursService{
+		calendars:           make(map[string]model.ExchangeCalendar, len(calendars)),
+		defaultExchange:     defaultExchange,
+		assetClassExchanges: make(map[model.AssetClass]string, len(assetClassExchanges)),
+	}
+	for _, calendar := range calendars {
+		service.calendars[calendar.Code] = calendar
+	}
+
+	if _, exists := service.calendars[defaultExchange]; !exists {
+		return nil, fmt.Errorf("default exchange %s has no calendar", defaultExchange)
+	}
+
+	for assetClass, exchange := range assetClassExchanges {
+		if _, exists := service.calendars[exchange]; !exists {
+			return nil, fmt.Errorf("exchange %s of asset class %s has no calendar", exchange, assetClass)
+		}
+		service.assetClassExchanges[assetClass] = exchange
+	}
+
+	return service, nil
+}
+
+// NewDefaultMarketHoursService uses the US equity calendar (04:00-09:30 pre-market,
+// 09:30-16:00 regular, 16:00-20:00 post-market, New York time) without holidays, with crypto
+// trading around the clock and FX around the clock on weekdays
+func NewDefaultMarketHoursService() *MarketHoursService {
+	service, _ := NewMarketHoursService(
+		[]model.ExchangeCalendar{DefaultUSCalendar(), DefaultCryptoCalendar(), DefaultFXCalendar()},
+		DefaultExchangeCode,
+		map[model.AssetClass]string{
+			model.AssetClassCrypto: CryptoExchangeCode,
+			model.AssetClassFX:     FXExchangeCode,
+		},
+	)
+	return service
+}
+
+func DefaultUSCalendar() model.ExchangeCalendar {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		location = time.UTC
+	}
+
+	return model.ExchangeCalendar{
+		Code:       DefaultExchangeCode,
+		Name:       "US Equities",
+		Location:   location,
+		PreMarket:  model.TradingWindow{Start: 4 * time.Hour, End: 9*time.Hour + 30*time.Minute},
+		Regular:    model.TradingWindow{Start: 9*time.Hour + 30*time.Minute, End: 16 * time.Hour},
+		PostMarket: model.TradingWindow{Start: 16 * time.Hour, End: 20 * time.Hour},
+		TradingDays: map[time.Weekday]bool{
+			time.Monday:    true,
+			time.Tuesday:   true,
+			time.Wednesday: true,
+			time.Thursday:  true,
+			time.Friday:    true,
+		},
+		Holidays: map[string]bool{},
+	}
+}
+
+// DefaultCryptoCalendar trades every day around the clock
+func DefaultCryptoCalendar() model.ExchangeCalendar {
+	return model.ExchangeCalendar{
+		Code:        CryptoExchangeCode,
+		Name:        "Crypto (24/7)",
+		Location:    time.UTC,
+		Regular:     model.TradingWindow{Start: 0, End: 24 * time.Hour},
+		TradingDays: weekdaySet(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday),
+		Holidays:    map[string]bool{},
+	}
+}
+
+// DefaultFXCalendar trades around the clock from Monday to Friday, UTC
+func DefaultFXCalendar() model.ExchangeCalendar {
+	return model.ExchangeCalendar{
+		Code:        FXExchangeCode,
+		Name:        "FX (24/5)",
+		Location:    time.UTC,
+		Regular:     model.TradingWindow{Start: 0, End: 24 * time.Hour},
+		TradingDays: weekdaySet(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday),
+		Holidays:    map[string]bool{},
+	}
+}
+
+func weekdaySet(days ...time.Weekday) map[time.Weekday]bool {
+	tradingDays := make(map[time.Weekday]bool, len(days))
+	for _, day := range days {
+		tradingDays[day] = true
+	}
+	return tradingDays
+}
+
+// CalendarFor returns the calendar the symbol of the given asset class trades on. Unknown
+// exchange suffixes fall back to the calendar of the asset class, then to the default
+// exchange.
+func (s *MarketHoursService) CalendarFor(symbol model.Symbol, assetClass model.AssetClass) model.ExchangeCalendar {
+	if calendar, exists := s.calendars[symbol.Exchange()]; exists {
+		return calendar
+	}
+	if exchange, exists := s.assetClassExchanges[assetClass]; exists {
+		return s.calendars[exchange]
+	}
+	return s.calendars[s.defaultExchange]
+}
+
+// StatusAt returns the calendar status of the symbol's exchange at t
+func (s *MarketHoursService) StatusAt(symbol model.Symbol, assetClass model.AssetClass, t time.Time) model.SymbolMarketStatus {
+	calendar := s.CalendarFor(symbol, assetClass)
+
+	return model.SymbolMarketStatus{
+		Symbol:     symbol.String(),
+		Exchange:   calendar.Code,
+		Status:     calendar.StatusAt(t),
+		NextChange: calendar.NextStatusChange(t),
+	}
+}
+
+// Calendars returns every configured calendar ordered by code
+func (s *MarketHoursService) Calendars() []model.ExchangeCalendar {
+	calendars := make([]model.ExchangeCalendar, 0, len(s.calendars))
+	for _, calendar := range s.calendars {
+		calendars = append(calendars, calendar)
+	}
+
+	sort.Slice(calendars, func(i, j int) bool { return calendars[i].Code < calendars[j].Code })
+	return calendars
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketHoursService_CalendarFor(t *testing.T) {
+	// Arrange
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	b3 := model.ExchangeCalendar{
+		Code:        "SA",
+		Location:    saoPaulo,
+		Regular:     model.TradingWindow{Start: 10 * time.Hour, End: 17 * time.Hour},
+		TradingDays: map[time.Weekday]bool{time.Tuesday: true},
+	}
+	marketHours, err := NewMarketHoursService(
+		[]model.ExchangeCalendar{DefaultUSCalendar(), b3, DefaultCryptoCalendar()},
+		DefaultExchangeCode,
+		map[model.AssetClass]string{model.AssetClassCrypto: CryptoExchangeCode},
+	)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, "US", marketHours.CalendarFor("AAPL", model.AssetClassStock).Code)
+	assert.Equal(t, "SA", marketHours.CalendarFor("PETR4.SA", model.AssetClassStock).Code)
+	assert.Equal(t, "US", marketHours.CalendarFor("SHOP.TO", model.AssetClassStock).Code)
+	assert.Equal(t, "CRYPTO", marketHours.CalendarFor("BTC-USD", model.AssetClassCrypto).Code)
+	assert.Equal(t, "US", marketHours.CalendarFor("EURUSD", model.AssetClassFX).Code)
+
+	// 11:00 in New York is 13:00 in Sao Paulo in March
+	at := time.Date(2026, 3, 10, 11, 0, 0, 0, DefaultUSCalendar().Location)
+	petrobras := marketHours.StatusAt("PETR4.SA", model.AssetClassStock, at)
+	assert.Equal(t, "PETR4.SA", petrobras.Symbol)
+	assert.Equal(t, model.MarketStatusOpen, petrobras.Status)
+	assert.Len(t, marketHours.Calendars(), 3)
+}
+
+func TestNewDefaultMarketHoursService_CryptoTradesAroundTheClock(t *testing.T) {
+	// Arrange
+	marketHours := NewDefaultMarketHoursService()
+	sundayNight := time.Date(2026, 3, 8, 23, 30, 0, 0, time.UTC)
+
+	// Act
+	bitcoin := marketHours.StatusAt("BTC-USD", model.AssetClassCrypto, sundayNight)
+	euro := marketHours.StatusAt("EURUSD", model.AssetClassFX, sundayNight)
+	apple := marketHours.StatusAt("AAPL", model.AssetClassStock, sundayNight)
+
+	// Assert
+	assert.Equal(t, model.MarketStatusOpen, bitcoin.Status)
+	assert.True(t, bitcoin.NextChange.IsZero())
+	assert.Equal(t, model.MarketStatusClosed, euro.Status)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), euro.NextChange)
+	assert.Equal(t, model.MarketStatusClosed, apple.Status)
+}
+
+func TestNewMarketHoursService_UnknownExchange(t *testing.T) {
+	_, err := NewMarketHoursService([]model.ExchangeCalendar{DefaultUSCalendar()}, "SA", nil)
+	assert.Error(t, err)
+
+	_, err = NewMarketHoursService([]model.ExchangeCalendar{DefaultUSCalendar()}, DefaultExchangeCode,
+		map[model.AssetClass]string{model.AssetClassCrypto: CryptoExchangeCode})
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package service
+
+import "github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+
+// SpreadService resolves the spread model of an instrument: the default model of its asset
+// class, then the asset class override, then the symbol override
+type SpreadService struct {
+	assetClasses map[model.AssetClass]model.SpreadModel
+	symbols      map[string]model.SpreadModel
+}
+
+func NewSpreadService(
+	assetClassOverrides map[model.AssetClass]model.SpreadModel,
+	symbolOverrides map[string]model.SpreadModel,
+) *SpreadService {
+	service := &SpreadService{
+		assetClasses: make(map[model.AssetClass]model.SpreadModel, len(model.AssetClasses())),
+		symbols:      make(map[string]model.SpreadModel, len(symbolOverrides)),
+	}
+
+	for _, assetClass := range model.AssetClasses() {
+		service.assetClasses[assetClass] = assetClass.DefaultSpreadModel().Merge(assetClassOverrides[assetClass])
+	}
+	for symbol, override := range symbolOverrides {
+		service.symbols[symbol] = override
+	}
+
+	return service
+}
+
+// NewDefaultSpreadService quotes every instrument with the default model of its asset class
+func NewDefaultSpreadService() *SpreadService {
+	return NewSpreadService(nil, nil)
+}
+
+// ModelFor returns the spread model the quote is simulated with
+func (s *SpreadService) ModelFor(quote model.AssetQuote) model.SpreadModel {
+	spreadModel, exists := s.assetClasses[quote.AssetClass]
+	if !exists {
+		spreadModel = quote.AssetClass.DefaultSpreadModel()
+	}
+	return spreadModel.Merge(s.symbols[quote.Symbol])
+}
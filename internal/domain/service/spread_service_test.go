@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadService_ModelFor(t *testing.T) {
+	// Arrange
+	spreadService := NewSpreadService(
+		map[model.AssetClass]model.SpreadModel{
+			model.AssetClassStock: {SpreadBps: decimal.NewFromInt(3)},
+		},
+		map[string]model.SpreadModel{
+			"TSLA": {MaxLots: 5},
+		},
+	)
+	assets := NewAssetDataService(time.Now())
+	apple, _ := assets.GetAssetBySymbol("AAPL")
+	tesla, _ := assets.GetAssetBySymbol("TSLA")
+	spy, _ := assets.GetAssetBySymbol("SPY")
+
+	// Act
+	appleModel := spreadService.ModelFor(apple)
+	teslaModel := spreadService.ModelFor(tesla)
+	spyModel := spreadService.ModelFor(spy)
+
+	// Assert
+	assert.Equal(t, "3", appleModel.SpreadBps.String())
+	assert.Equal(t, int64(20), appleModel.MaxLots)
+	assert.Equal(t, "3", teslaModel.SpreadBps.String())
+	assert.Equal(t, int64(5), teslaModel.MaxLots)
+	assert.Equal(t, model.AssetClassETF.DefaultSpreadModel(), spyModel)
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
@@ -36,7 +35,7 @@ func NewMarketDataCacheRepository(
 	}
 }
 
-func (c *MarketDataCacheRepository) GetMarketData(symbols []string) ([]model.MarketDataModel, error) {
+func (c *MarketDataCacheRepository) GetMarketData(symbols []model.Symbol) ([]model.MarketDataModel, error) {
 	cachedData, missingSymbols := c.tryGetFromCache(symbols)
 
 	if len(missingSymbols) == 0 {
@@ -64,9 +63,9 @@ func (c *MarketDataCacheRepository) GetMarketData(symbols []string) ([]model.Mar
 	return allData, nil
 }
 
-func (c *MarketDataCacheRepository) tryGetFromCache(symbols []string) ([]model.MarketDataModel, []string) {
+func (c *MarketDataCacheRepository) tryGetFromCache(symbols []model.Symbol) ([]model.MarketDataModel, []model.Symbol) {
 	var cachedData []model.MarketDataModel
-	var missingSymbols []string
+	var missingSymbols []model.Symbol
 
 	for _, symbol := range symbols {
 		cacheKey := c.buildCacheKey(symbol)
@@ -92,7 +91,12 @@ func (c *MarketDataCacheRepository) tryGetFromCache(symbols []string) ([]model.M
 
 func (c *MarketDataCacheRepository) cacheNewData(data []model.MarketDataModel) {
 	for _, item := range data {
-		cacheKey := c.buildCacheKey(item.Symbol)
+		symbol, err := model.ParseSymbol(item.Symbol)
+		if err != nil {
+			log.Printf("Skipping cache for malformed stored symbol %q: %v", item.Symbol, err)
+			continue
+		}
+		cacheKey := c.buildCacheKey(symbol)
 
 		dataBytes, err := json.Marshal(item)
 		if err != nil {
@@ -108,11 +112,13 @@ func (c *MarketDataCacheRepository) cacheNewData(data []model.MarketDataModel) {
 	}
 }
 
-func (c *MarketDataCacheRepository) buildCacheKey(symbol string) string {
-	return fmt.Sprintf("market_data:%s", strings.ToUpper(symbol))
+// buildCacheKey includes a schema version so entries cached with an older model layout
+// (such as the integer category or float prices) are never decoded into the current one
+func (c *MarketDataCacheRepository) buildCacheKey(symbol model.Symbol) string {
+	return fmt.Sprintf("market_data:v3:%s", symbol)
 }
 
-func (c *MarketDataCacheRepository) InvalidateCache(symbols []string) error {
+func (c *MarketDataCacheRepository) InvalidateCache(symbols []model.Symbol) error {
 	for _, symbol := range symbols {
 		cacheKey := c.buildCacheKey(symbol)
 		if err := c.cacheClient.Delete(cacheKey); err != nil {
@@ -124,7 +130,7 @@ func (c *MarketDataCacheRepository) InvalidateCache(symbols []string) error {
 	return nil
 }
 
-func (c *MarketDataCacheRepository) WarmCache(symbols []string) error {
+func (c *MarketDataCacheRepository) WarmCache(symbols []model.Symbol) error {
 	log.Printf("Warming cache for symbols: %v", symbols)
 
 	data, err := c.dbRepo.GetMarketData(symbols)
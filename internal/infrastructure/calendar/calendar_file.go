@@ -0,0 +1,157 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SUN": time.Sunday,
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+}
+
+var defaultTradingDays = []string{"MON", "TUE", "WED", "THU", "FRI"}
+
+type calendarFile struct {
+	DefaultExchange     string            `yaml:"default_exchange"`
+	AssetClassExchanges map[string]string `yaml:"asset_class_exchanges"`
+	Exchanges           []exchangeEntry   `yaml:"exchanges"`
+}
+
+type exchangeEntry struct {
+	Code        string   `yaml:"code"`
+	Name        string   `yaml:"name"`
+	Timezone    string   `yaml:"timezone"`
+	TradingDays []string `yaml:"trading_days"`
+	PreMarket   string   `yaml:"pre_market"`
+	Regular     string   `yaml:"regular"`
+	PostMarket  string   `yaml:"post_market"`
+	Holidays    []string `yaml:"holidays"`
+}
+
+// Calendars is the content of an exchange calendar file. AssetClassExchanges names the
+// calendar of asset classes that do not trade on the default exchange.
+type Calendars struct {
+	DefaultExchange     string
+	AssetClassExchanges map[model.AssetClass]string
+	Exchanges           []model.ExchangeCalendar
+}
+
+// LoadCalendarFile reads the exchange calendar configuration file
+func LoadCalendarFile(path string) (*Calendars, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calendar file %s: %w", path, err)
+	}
+
+	return ParseCalendars(data)
+}
+
+// ParseCalendars parses the YAML exchange calendar configuration
+func ParseCalendars(data []byte) (*Calendars, error) {
+	var file calendarFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse calendar file: %w", err)
+	}
+	if len(file.Exchanges) == 0 {
+		return nil, errors.New("calendar file defines no exchanges")
+	}
+
+	calendars := &Calendars{
+		DefaultExchange:     strings.ToUpper(strings.TrimSpace(file.DefaultExchange)),
+		AssetClassExchanges: make(map[model.AssetClass]string, len(file.AssetClassExchanges)),
+		Exchanges:           make([]model.ExchangeCalendar, 0, len(file.Exchanges)),
+	}
+	for rawAssetClass, exchange := range file.AssetClassExchanges {
+		assetClass, err := model.ParseAssetClass(rawAssetClass)
+		if err != nil {
+			return nil, fmt.Errorf("asset_class_exchanges: %w", err)
+		}
+		calendars.AssetClassExchanges[assetClass] = strings.ToUpper(strings.TrimSpace(exchange))
+	}
+	for _, entry := range file.Exchanges {
+		exchangeCalendar, err := entry.toCalendar()
+		if err != nil {
+			return nil, err
+		}
+		calendars.Exchanges = append(calendars.Exchanges, exchangeCalendar)
+	}
+
+	if calendars.DefaultExchange == "" {
+		calendars.DefaultExchange = calendars.Exchanges[0].Code
+	}
+
+	return calendars, nil
+}
+
+func (e exchangeEntry) toCalendar() (model.ExchangeCalendar, error) {
+	code := strings.ToUpper(strings.TrimSpace(e.Code))
+	if code == "" {
+		return model.ExchangeCalendar{}, errors.New("calendar entry is missing code")
+	}
+
+	location, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return model.ExchangeCalendar{}, fmt.Errorf("exchange %s has an invalid timezone %q: %w", code, e.Timezone, err)
+	}
+
+	exchangeCalendar := model.ExchangeCalendar{
+		Code:        code,
+		Name:        e.Name,
+		Location:    location,
+		TradingDays: make(map[time.Weekday]bool),
+		Holidays:    make(map[string]bool, len(e.Holidays)),
+	}
+
+	windows := []struct {
+		raw    string
+		target *model.TradingWindow
+	}{
+		{e.PreMarket, &exchangeCalendar.PreMarket},
+		{e.Regular, &exchangeCalendar.Regular},
+		{e.PostMarket, &exchangeCalendar.PostMarket},
+	}
+	for _, window := range windows {
+		parsed, err := model.ParseTradingWindow(window.raw)
+		if err != nil {
+			return model.ExchangeCalendar{}, fmt.Errorf("exchange %s: %w", code, err)
+		}
+		*window.target = parsed
+	}
+	if exchangeCalendar.Regular.IsZero() {
+		return model.ExchangeCalendar{}, fmt.Errorf("exchange %s has no regular session", code)
+	}
+
+	tradingDays := e.TradingDays
+	if len(tradingDays) == 0 {
+		tradingDays = defaultTradingDays
+	}
+	for _, day := range tradingDays {
+		weekday, ok := weekdays[strings.ToUpper(strings.TrimSpace(day))]
+		if !ok {
+			return model.ExchangeCalendar{}, fmt.Errorf("exchange %s has an invalid trading day %q", code, day)
+		}
+		exchangeCalendar.TradingDays[weekday] = true
+	}
+
+	for _, holiday := range e.Holidays {
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(holiday))
+		if err != nil {
+			return model.ExchangeCalendar{}, fmt.Errorf("exchange %s has an invalid holiday %q", code, holiday)
+		}
+		exchangeCalendar.Holidays[date.Format("2006-01-02")] = true
+	}
+
+	return exchangeCalendar, nil
+}
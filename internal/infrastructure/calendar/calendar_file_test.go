@@ -0,0 +1,89 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCalendars(t *testing.T) {
+	// Arrange
+	data := []byte(`
+default_exchange: us
+asset_class_exchanges:
+  crypto: crypto
+exchanges:
+  - code: us
+    name: US Equities
+    timezone: America/New_York
+    pre_market: "04:00-09:30"
+    regular: "09:30-16:00"
+    post_market: "16:00-20:00"
+    holidays: ["2026-12-25"]
+  - code: SA
+    timezone: America/Sao_Paulo
+    trading_days: [mon, tue, wed, thu, fri]
+    regular: "10:00-17:00"
+  - code: CRYPTO
+    timezone: UTC
+    trading_days: [sun, mon, tue, wed, thu, fri, sat]
+    regular: "00:00-24:00"
+`)
+
+	// Act
+	calendars, err := ParseCalendars(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "US", calendars.DefaultExchange)
+	require.Len(t, calendars.Exchanges, 3)
+	assert.Equal(t, map[model.AssetClass]string{model.AssetClassCrypto: "CRYPTO"}, calendars.AssetClassExchanges)
+
+	us := calendars.Exchanges[0]
+	assert.Equal(t, "US", us.Code)
+	assert.True(t, us.Holidays["2026-12-25"])
+	assert.True(t, us.TradingDays[time.Friday])
+	assert.False(t, us.TradingDays[time.Saturday])
+	assert.Equal(t, model.MarketStatusClosed, us.StatusAt(time.Date(2026, 12, 25, 12, 0, 0, 0, us.Location)))
+
+	b3 := calendars.Exchanges[1]
+	assert.True(t, b3.PreMarket.IsZero())
+	assert.Equal(t, model.MarketStatusOpen, b3.StatusAt(time.Date(2026, 3, 10, 11, 0, 0, 0, b3.Location)))
+}
+
+func TestParseCalendars_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no exchanges":     `default_exchange: US`,
+		"missing code":     `exchanges: [{timezone: UTC, regular: "09:00-17:00"}]`,
+		"bad timezone":     `exchanges: [{code: X, timezone: Mars/Base, regular: "09:00-17:00"}]`,
+		"no regular":       `exchanges: [{code: X, timezone: UTC}]`,
+		"bad window":       `exchanges: [{code: X, timezone: UTC, regular: "17:00-09:00"}]`,
+		"bad trading day":  `exchanges: [{code: X, timezone: UTC, regular: "09:00-17:00", trading_days: [FUN]}]`,
+		"bad holiday date": `exchanges: [{code: X, timezone: UTC, regular: "09:00-17:00", holidays: ["25/12/2026"]}]`,
+		"bad asset class":  `{asset_class_exchanges: {COMMODITY: X}, exchanges: [{code: X, timezone: UTC, regular: "09:00-17:00"}]}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCalendars([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadCalendarFile_ShippedCalendars(t *testing.T) {
+	// Act
+	calendars, err := LoadCalendarFile("../../../deployments/calendars/exchange_calendars.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "US", calendars.DefaultExchange)
+	assert.NotEmpty(t, calendars.Exchanges)
+
+	_, err = service.NewMarketHoursService(calendars.Exchanges, calendars.DefaultExchange, calendars.AssetClassExchanges)
+	assert.NoError(t, err)
+}
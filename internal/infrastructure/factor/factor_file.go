@@ -0,0 +1,90 @@
+package factor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+type factorFile struct {
+	Persistence  *float64                 `yaml:"persistence"`
+	Factors      []factorEntry            `yaml:"factors"`
+	Correlation  [][]float64              `yaml:"correlation"`
+	AssetClasses map[string]exposureEntry `yaml:"asset_classes"`
+	Symbols      map[string]exposureEntry `yaml:"symbols"`
+}
+
+type factorEntry struct {
+	Name       string  `yaml:"name"`
+	Volatility float64 `yaml:"volatility"`
+}
+
+type exposureEntry struct {
+	Betas                   map[string]float64 `yaml:"betas"`
+	IdiosyncraticVolatility float64            `yaml:"idiosyncratic_volatility"`
+}
+
+// LoadFactorFile reads the factor model configuration file
+func LoadFactorFile(path string) (model.FactorModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return model.FactorModel{}, fmt.Errorf("failed to read factor file %s: %w", path, err)
+	}
+
+	return ParseFactorModel(data)
+}
+
+// ParseFactorModel parses the YAML factor model configuration. The factors and their
+// correlation matrix replace those of model.DefaultFactorModel; asset classes without an
+// entry keep their default exposure, so the default betas to MARKET need a MARKET factor.
+func ParseFactorModel(data []byte) (model.FactorModel, error) {
+	var file factorFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return model.FactorModel{}, fmt.Errorf("failed to parse factor file: %w", err)
+	}
+	if len(file.Factors) == 0 {
+		return model.FactorModel{}, errors.New("factor file defines no factors")
+	}
+
+	factorModel := model.DefaultFactorModel()
+	factorModel.Factors = make([]model.Factor, len(file.Factors))
+	for i, entry := range file.Factors {
+		factorModel.Factors[i] = model.Factor{Name: entry.Name, Volatility: entry.Volatility}
+	}
+	factorModel.Correlation = file.Correlation
+	if file.Persistence != nil {
+		factorModel.Persistence = *file.Persistence
+	}
+
+	for rawClass, entry := range file.AssetClasses {
+		assetClass, err := model.ParseAssetClass(rawClass)
+		if err != nil {
+			return model.FactorModel{}, err
+		}
+		factorModel.AssetClasses[assetClass] = entry.toExposure()
+	}
+
+	factorModel.Symbols = make(map[string]model.FactorExposure, len(file.Symbols))
+	for rawSymbol, entry := range file.Symbols {
+		symbol, err := model.ParseSymbol(rawSymbol)
+		if err != nil {
+			return model.FactorModel{}, err
+		}
+		factorModel.Symbols[symbol.String()] = entry.toExposure()
+	}
+
+	if err := factorModel.Validate(); err != nil {
+		return model.FactorModel{}, err
+	}
+	return factorModel, nil
+}
+
+func (e exposureEntry) toExposure() model.FactorExposure {
+	return model.FactorExposure{
+		Betas:                   e.Betas,
+		IdiosyncraticVolatility: e.IdiosyncraticVolatility,
+	}
+}
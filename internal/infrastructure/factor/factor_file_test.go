@@ -0,0 +1,72 @@
+package factor
+
+import (
+	"testing"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFactorModel(t *testing.T) {
+	// Arrange
+	data := []byte(`
+persistence: 0.5
+factors:
+  - {name: MARKET, volatility: 0.004}
+  - {name: TECH, volatility: 0.003}
+correlation:
+  - [1, 0.4]
+  - [0.4, 1]
+asset_classes:
+  etf:
+    betas: {MARKET: 0.9}
+symbols:
+  xlk:
+    betas: {MARKET: 1, TECH: 1}
+    idiosyncratic_volatility: 0.001
+`)
+
+	// Act
+	factorModel, err := ParseFactorModel(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, factorModel.Persistence)
+	assert.Equal(t, []model.Factor{{Name: "MARKET", Volatility: 0.004}, {Name: "TECH", Volatility: 0.003}}, factorModel.Factors)
+	assert.Equal(t, map[string]float64{"MARKET": 0.9}, factorModel.AssetClasses[model.AssetClassETF].Betas)
+	assert.Equal(t, model.DefaultFactorModel().AssetClasses[model.AssetClassStock], factorModel.AssetClasses[model.AssetClassStock])
+	assert.Equal(t, 0.001, factorModel.Symbols["XLK"].IdiosyncraticVolatility)
+}
+
+func TestParseFactorModel_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no factors":          `persistence: 0.5`,
+		"unknown asset class": `{factors: [{name: MARKET}], correlation: [[1]], asset_classes: {WARRANT: {}}}`,
+		"invalid symbol":      `{factors: [{name: MARKET}], correlation: [[1]], symbols: {"AA PL": {}}}`,
+		"unknown factor":      `{factors: [{name: MARKET}], correlation: [[1]], symbols: {AAPL: {betas: {TECH: 1}}}}`,
+		"missing market":      `{factors: [{name: TECH}], correlation: [[1]]}`,
+		"matrix size":         `{factors: [{name: MARKET}, {name: TECH}], correlation: [[1]]}`,
+		"not positive definite": `{factors: [{name: MARKET}, {name: A}, {name: B}],
+		  correlation: [[1, 0.9, -0.9], [0.9, 1, 0.9], [-0.9, 0.9, 1]]}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := ParseFactorModel([]byte(data))
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadFactorFile_ShippedModel(t *testing.T) {
+	// Act
+	factorModel, err := LoadFactorFile("../../../deployments/factors/factor_model.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, factorModel.Symbols)
+}
@@ -0,0 +1,4965 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: internal/infrastructure/grpc/proto/market_data.proto
+
+// Market data contract served by this service.
+//
+// This file started as a copy of monolith/market_data_service.proto from
+// hub-proto-contracts and keeps the same proto package, service name and field
+// numbers, so clients generated from the shared contract stay wire compatible.
+// Fields and RPCs added here are additive and should be upstreamed to
+// hub-proto-contracts. Regenerate with `make proto-gen`.
+
+package marketdatapb
+
+import (
+	common "github.com/RodriguesYan/hub-proto-contracts/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SymbolStatus int32
+
+const (
+	SymbolStatus_SYMBOL_STATUS_UNSPECIFIED SymbolStatus = 0
+	SymbolStatus_SYMBOL_STATUS_FOUND       SymbolStatus = 1
+	SymbolStatus_SYMBOL_STATUS_NOT_FOUND   SymbolStatus = 2
+	SymbolStatus_SYMBOL_STATUS_INVALID     SymbolStatus = 3
+)
+
+// Enum value maps for SymbolStatus.
+var (
+	SymbolStatus_name = map[int32]string{
+		0: "SYMBOL_STATUS_UNSPECIFIED",
+		1: "SYMBOL_STATUS_FOUND",
+		2: "SYMBOL_STATUS_NOT_FOUND",
+		3: "SYMBOL_STATUS_INVALID",
+	}
+	SymbolStatus_value = map[string]int32{
+		"SYMBOL_STATUS_UNSPECIFIED": 0,
+		"SYMBOL_STATUS_FOUND":       1,
+		"SYMBOL_STATUS_NOT_FOUND":   2,
+		"SYMBOL_STATUS_INVALID":     3,
+	}
+)
+
+func (x SymbolStatus) Enum() *SymbolStatus {
+	p := new(SymbolStatus)
+	*p = x
+	return p
+}
+
+func (x SymbolStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SymbolStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[0].Descriptor()
+}
+
+func (SymbolStatus) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[0]
+}
+
+func (x SymbolStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SymbolStatus.Descriptor instead.
+func (SymbolStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{0}
+}
+
+// AssetClass values match the legacy integer category codes, so category can be
+// read as an AssetClass by older clients.
+type AssetClass int32
+
+const (
+	AssetClass_ASSET_CLASS_UNSPECIFIED AssetClass = 0
+	AssetClass_ASSET_CLASS_STOCK       AssetClass = 1
+	AssetClass_ASSET_CLASS_ETF         AssetClass = 2
+	AssetClass_ASSET_CLASS_CRYPTO      AssetClass = 3
+	AssetClass_ASSET_CLASS_REIT        AssetClass = 4
+	AssetClass_ASSET_CLASS_BOND        AssetClass = 5
+	AssetClass_ASSET_CLASS_FX          AssetClass = 6
+	AssetClass_ASSET_CLASS_INDEX       AssetClass = 7
+	AssetClass_ASSET_CLASS_OPTION      AssetClass = 8
+)
+
+// Enum value maps for AssetClass.
+var (
+	AssetClass_name = map[int32]string{
+		0: "ASSET_CLASS_UNSPECIFIED",
+		1: "ASSET_CLASS_STOCK",
+		2: "ASSET_CLASS_ETF",
+		3: "ASSET_CLASS_CRYPTO",
+		4: "ASSET_CLASS_REIT",
+		5: "ASSET_CLASS_BOND",
+		6: "ASSET_CLASS_FX",
+		7: "ASSET_CLASS_INDEX",
+		8: "ASSET_CLASS_OPTION",
+	}
+	AssetClass_value = map[string]int32{
+		"ASSET_CLASS_UNSPECIFIED": 0,
+		"ASSET_CLASS_STOCK":       1,
+		"ASSET_CLASS_ETF":         2,
+		"ASSET_CLASS_CRYPTO":      3,
+		"ASSET_CLASS_REIT":        4,
+		"ASSET_CLASS_BOND":        5,
+		"ASSET_CLASS_FX":          6,
+		"ASSET_CLASS_INDEX":       7,
+		"ASSET_CLASS_OPTION":      8,
+	}
+)
+
+func (x AssetClass) Enum() *AssetClass {
+	p := new(AssetClass)
+	*p = x
+	return p
+}
+
+func (x AssetClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[1].Descriptor()
+}
+
+func (AssetClass) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[1]
+}
+
+func (x AssetClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetClass.Descriptor instead.
+func (AssetClass) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{1}
+}
+
+type CorporateActionType int32
+
+const (
+	CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED   CorporateActionType = 0
+	CorporateActionType_CORPORATE_ACTION_TYPE_SPLIT         CorporateActionType = 1
+	CorporateActionType_CORPORATE_ACTION_TYPE_CASH_DIVIDEND CorporateActionType = 2
+	CorporateActionType_CORPORATE_ACTION_TYPE_SYMBOL_CHANGE CorporateActionType = 3
+)
+
+// Enum value maps for CorporateActionType.
+var (
+	CorporateActionType_name = map[int32]string{
+		0: "CORPORATE_ACTION_TYPE_UNSPECIFIED",
+		1: "CORPORATE_ACTION_TYPE_SPLIT",
+		2: "CORPORATE_ACTION_TYPE_CASH_DIVIDEND",
+		3: "CORPORATE_ACTION_TYPE_SYMBOL_CHANGE",
+	}
+	CorporateActionType_value = map[string]int32{
+		"CORPORATE_ACTION_TYPE_UNSPECIFIED":   0,
+		"CORPORATE_ACTION_TYPE_SPLIT":         1,
+		"CORPORATE_ACTION_TYPE_CASH_DIVIDEND": 2,
+		"CORPORATE_ACTION_TYPE_SYMBOL_CHANGE": 3,
+	}
+)
+
+func (x CorporateActionType) Enum() *CorporateActionType {
+	p := new(CorporateActionType)
+	*p = x
+	return p
+}
+
+func (x CorporateActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CorporateActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[2].Descriptor()
+}
+
+func (CorporateActionType) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[2]
+}
+
+func (x CorporateActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CorporateActionType.Descriptor instead.
+func (CorporateActionType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{2}
+}
+
+type MarketStatus int32
+
+const (
+	MarketStatus_MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	MarketStatus_MARKET_STATUS_OPEN        MarketStatus = 1
+	MarketStatus_MARKET_STATUS_CLOSED      MarketStatus = 2
+	MarketStatus_MARKET_STATUS_PRE_MARKET  MarketStatus = 3
+	MarketStatus_MARKET_STATUS_POST_MARKET MarketStatus = 4
+	MarketStatus_MARKET_STATUS_HALTED      MarketStatus = 5
+)
+
+// Enum value maps for MarketStatus.
+var (
+	MarketStatus_name = map[int32]string{
+		0: "MARKET_STATUS_UNSPECIFIED",
+		1: "MARKET_STATUS_OPEN",
+		2: "MARKET_STATUS_CLOSED",
+		3: "MARKET_STATUS_PRE_MARKET",
+		4: "MARKET_STATUS_POST_MARKET",
+		5: "MARKET_STATUS_HALTED",
+	}
+	MarketStatus_value = map[string]int32{
+		"MARKET_STATUS_UNSPECIFIED": 0,
+		"MARKET_STATUS_OPEN":        1,
+		"MARKET_STATUS_CLOSED":      2,
+		"MARKET_STATUS_PRE_MARKET":  3,
+		"MARKET_STATUS_POST_MARKET": 4,
+		"MARKET_STATUS_HALTED":      5,
+	}
+)
+
+func (x MarketStatus) Enum() *MarketStatus {
+	p := new(MarketStatus)
+	*p = x
+	return p
+}
+
+func (x MarketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[3].Descriptor()
+}
+
+func (MarketStatus) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[3]
+}
+
+func (x MarketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketStatus.Descriptor instead.
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{3}
+}
+
+type HaltReason int32
+
+const (
+	HaltReason_HALT_REASON_UNSPECIFIED HaltReason = 0
+	HaltReason_HALT_REASON_ADMIN       HaltReason = 1
+	HaltReason_HALT_REASON_LIMIT_UP    HaltReason = 2
+	HaltReason_HALT_REASON_LIMIT_DOWN  HaltReason = 3
+)
+
+// Enum value maps for HaltReason.
+var (
+	HaltReason_name = map[int32]string{
+		0: "HALT_REASON_UNSPECIFIED",
+		1: "HALT_REASON_ADMIN",
+		2: "HALT_REASON_LIMIT_UP",
+		3: "HALT_REASON_LIMIT_DOWN",
+	}
+	HaltReason_value = map[string]int32{
+		"HALT_REASON_UNSPECIFIED": 0,
+		"HALT_REASON_ADMIN":       1,
+		"HALT_REASON_LIMIT_UP":    2,
+		"HALT_REASON_LIMIT_DOWN":  3,
+	}
+)
+
+func (x HaltReason) Enum() *HaltReason {
+	p := new(HaltReason)
+	*p = x
+	return p
+}
+
+func (x HaltReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HaltReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[4].Descriptor()
+}
+
+func (HaltReason) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[4]
+}
+
+func (x HaltReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HaltReason.Descriptor instead.
+func (HaltReason) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{4}
+}
+
+// TradeSide is the aggressor side: buys print at the ask and sells at the bid
+type TradeSide int32
+
+const (
+	TradeSide_TRADE_SIDE_UNSPECIFIED TradeSide = 0
+	TradeSide_TRADE_SIDE_BUY         TradeSide = 1
+	TradeSide_TRADE_SIDE_SELL        TradeSide = 2
+)
+
+// Enum value maps for TradeSide.
+var (
+	TradeSide_name = map[int32]string{
+		0: "TRADE_SIDE_UNSPECIFIED",
+		1: "TRADE_SIDE_BUY",
+		2: "TRADE_SIDE_SELL",
+	}
+	TradeSide_value = map[string]int32{
+		"TRADE_SIDE_UNSPECIFIED": 0,
+		"TRADE_SIDE_BUY":         1,
+		"TRADE_SIDE_SELL":        2,
+	}
+)
+
+func (x TradeSide) Enum() *TradeSide {
+	p := new(TradeSide)
+	*p = x
+	return p
+}
+
+func (x TradeSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradeSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[5].Descriptor()
+}
+
+func (TradeSide) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[5]
+}
+
+func (x TradeSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradeSide.Descriptor instead.
+func (TradeSide) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{5}
+}
+
+type OptionType int32
+
+const (
+	OptionType_OPTION_TYPE_UNSPECIFIED OptionType = 0
+	OptionType_OPTION_TYPE_CALL        OptionType = 1
+	OptionType_OPTION_TYPE_PUT         OptionType = 2
+)
+
+// Enum value maps for OptionType.
+var (
+	OptionType_name = map[int32]string{
+		0: "OPTION_TYPE_UNSPECIFIED",
+		1: "OPTION_TYPE_CALL",
+		2: "OPTION_TYPE_PUT",
+	}
+	OptionType_value = map[string]int32{
+		"OPTION_TYPE_UNSPECIFIED": 0,
+		"OPTION_TYPE_CALL":        1,
+		"OPTION_TYPE_PUT":         2,
+	}
+)
+
+func (x OptionType) Enum() *OptionType {
+	p := new(OptionType)
+	*p = x
+	return p
+}
+
+func (x OptionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[6].Descriptor()
+}
+
+func (OptionType) Type() protoreflect.EnumType {
+	return &file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes[6]
+}
+
+func (x OptionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionType.Descriptor instead.
+func (OptionType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{6}
+}
+
+type GetMarketDataRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Optional ISO 4217 currency to convert prices into, e.g. "BRL"
+	TargetCurrency string `protobuf:"bytes,2,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMarketDataRequest) Reset() {
+	*x = GetMarketDataRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDataRequest) ProtoMessage() {}
+
+func (x *GetMarketDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDataRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketDataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMarketDataRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetMarketDataRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+type GetMarketDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	MarketData    *MarketData            `protobuf:"bytes,2,opt,name=market_data,json=marketData,proto3" json:"market_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketDataResponse) Reset() {
+	*x = GetMarketDataResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDataResponse) ProtoMessage() {}
+
+func (x *GetMarketDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDataResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketDataResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMarketDataResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetMarketDataResponse) GetMarketData() *MarketData {
+	if x != nil {
+		return x.MarketData
+	}
+	return nil
+}
+
+type GetAssetDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssetDetailsRequest) Reset() {
+	*x = GetAssetDetailsRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssetDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetDetailsRequest) ProtoMessage() {}
+
+func (x *GetAssetDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssetDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssetDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAssetDetailsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetAssetDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Asset         *AssetDetails          `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssetDetailsResponse) Reset() {
+	*x = GetAssetDetailsResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssetDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetDetailsResponse) ProtoMessage() {}
+
+func (x *GetAssetDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssetDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetAssetDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAssetDetailsResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetAssetDetailsResponse) GetAsset() *AssetDetails {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type GetBatchMarketDataRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Symbols []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// Optional ISO 4217 currency to convert prices into, e.g. "BRL"
+	TargetCurrency string `protobuf:"bytes,2,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBatchMarketDataRequest) Reset() {
+	*x = GetBatchMarketDataRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchMarketDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchMarketDataRequest) ProtoMessage() {}
+
+func (x *GetBatchMarketDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchMarketDataRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchMarketDataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBatchMarketDataRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *GetBatchMarketDataRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+type GetBatchMarketDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	MarketData    []*MarketData          `protobuf:"bytes,2,rep,name=market_data,json=marketData,proto3" json:"market_data,omitempty"` // Found symbols, in request order
+	Results       []*SymbolResult        `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`                         // One entry per distinct requested symbol
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchMarketDataResponse) Reset() {
+	*x = GetBatchMarketDataResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchMarketDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchMarketDataResponse) ProtoMessage() {}
+
+func (x *GetBatchMarketDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchMarketDataResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchMarketDataResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBatchMarketDataResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetBatchMarketDataResponse) GetMarketData() []*MarketData {
+	if x != nil {
+		return x.MarketData
+	}
+	return nil
+}
+
+func (x *GetBatchMarketDataResponse) GetResults() []*SymbolResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SymbolResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestedSymbol string                 `protobuf:"bytes,1,opt,name=requested_symbol,json=requestedSymbol,proto3" json:"requested_symbol,omitempty"` // Symbol as sent by the client
+	Symbol          string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`                                          // Normalized symbol (empty when invalid)
+	Status          SymbolStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=hub_investments.SymbolStatus" json:"status,omitempty"`
+	ErrorMessage    string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Reason for SYMBOL_STATUS_INVALID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SymbolResult) Reset() {
+	*x = SymbolResult{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolResult) ProtoMessage() {}
+
+func (x *SymbolResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolResult.ProtoReflect.Descriptor instead.
+func (*SymbolResult) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SymbolResult) GetRequestedSymbol() string {
+	if x != nil {
+		return x.RequestedSymbol
+	}
+	return ""
+}
+
+func (x *SymbolResult) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolResult) GetStatus() SymbolStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SymbolStatus_SYMBOL_STATUS_UNSPECIFIED
+}
+
+func (x *SymbolResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type MarketData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CurrentPrice  float64                `protobuf:"fixed64,3,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	PreviousClose float64                `protobuf:"fixed64,4,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	OpenPrice     float64                `protobuf:"fixed64,5,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice     float64                `protobuf:"fixed64,6,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice      float64                `protobuf:"fixed64,7,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	Change        float64                `protobuf:"fixed64,8,opt,name=change,proto3" json:"change,omitempty"`
+	ChangePercent float64                `protobuf:"fixed64,9,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	Volume        int64                  `protobuf:"varint,10,opt,name=volume,proto3" json:"volume,omitempty"`
+	LastUpdated   string                 `protobuf:"bytes,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Category      int32                  `protobuf:"varint,12,opt,name=category,proto3" json:"category,omitempty"` // Deprecated: numeric value of asset_class
+	AssetClass    AssetClass             `protobuf:"varint,13,opt,name=asset_class,json=assetClass,proto3,enum=hub_investments.AssetClass" json:"asset_class,omitempty"`
+	// Exact decimal form of current_price with price_precision decimal places, e.g. "175.50".
+	// The double fields are kept for compatibility and may carry float rounding.
+	CurrentPriceDecimal string `protobuf:"bytes,14,opt,name=current_price_decimal,json=currentPriceDecimal,proto3" json:"current_price_decimal,omitempty"`
+	PricePrecision      int32  `protobuf:"varint,15,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	// Simulated top of book, empty for instruments the simulator does not quote
+	BidDecimal string `protobuf:"bytes,16,opt,name=bid_decimal,json=bidDecimal,proto3" json:"bid_decimal,omitempty"`
+	AskDecimal string `protobuf:"bytes,17,opt,name=ask_decimal,json=askDecimal,proto3" json:"ask_decimal,omitempty"`
+	BidSize    int64  `protobuf:"varint,18,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	AskSize    int64  `protobuf:"varint,19,opt,name=ask_size,json=askSize,proto3" json:"ask_size,omitempty"`
+	// Volume weighted average price of the session, with price_precision + 2 decimal places
+	VwapDecimal string `protobuf:"bytes,20,opt,name=vwap_decimal,json=vwapDecimal,proto3" json:"vwap_decimal,omitempty"`
+	// Instrument metadata: every price is a whole multiple of tick_size_decimal and every
+	// size a whole multiple of lot_size, in currency (ISO 4217)
+	TickSizeDecimal string `protobuf:"bytes,21,opt,name=tick_size_decimal,json=tickSizeDecimal,proto3" json:"tick_size_decimal,omitempty"`
+	LotSize         int64  `protobuf:"varint,22,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	Currency        string `protobuf:"bytes,23,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Set when prices were converted into a target currency: currency is then the target
+	// currency, instrument_currency the currency the instrument trades in and fx_rate_decimal
+	// the price of one unit of instrument_currency in currency. Converted prices are not
+	// tradable increments and tick_size_decimal becomes one unit of the last decimal place.
+	InstrumentCurrency string `protobuf:"bytes,24,opt,name=instrument_currency,json=instrumentCurrency,proto3" json:"instrument_currency,omitempty"`
+	FxRateDecimal      string `protobuf:"bytes,25,opt,name=fx_rate_decimal,json=fxRateDecimal,proto3" json:"fx_rate_decimal,omitempty"`
+	// Asset class fields, only set for the classes they apply to: the base currency or coin of
+	// FX and crypto pairs, the yield to maturity in percent and the coupon terms of bonds, the
+	// constituents of indices and the holdings of ETFs, and the net asset value per share of
+	// ETFs with holdings
+	BaseCurrency      string   `protobuf:"bytes,26,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	YieldDecimal      string   `protobuf:"bytes,27,opt,name=yield_decimal,json=yieldDecimal,proto3" json:"yield_decimal,omitempty"`
+	CouponRateDecimal string   `protobuf:"bytes,28,opt,name=coupon_rate_decimal,json=couponRateDecimal,proto3" json:"coupon_rate_decimal,omitempty"`
+	CouponFrequency   int32    `protobuf:"varint,29,opt,name=coupon_frequency,json=couponFrequency,proto3" json:"coupon_frequency,omitempty"`
+	MaturityDate      string   `protobuf:"bytes,30,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	Constituents      []string `protobuf:"bytes,31,rep,name=constituents,proto3" json:"constituents,omitempty"`
+	NavDecimal        string   `protobuf:"bytes,32,opt,name=nav_decimal,json=navDecimal,proto3" json:"nav_decimal,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarketData) Reset() {
+	*x = MarketData{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketData) ProtoMessage() {}
+
+func (x *MarketData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketData.ProtoReflect.Descriptor instead.
+func (*MarketData) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarketData) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MarketData) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *MarketData) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *MarketData) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *MarketData) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *MarketData) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *MarketData) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *MarketData) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *MarketData) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *MarketData) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *MarketData) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+func (x *MarketData) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *MarketData) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *MarketData) GetCurrentPriceDecimal() string {
+	if x != nil {
+		return x.CurrentPriceDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *MarketData) GetBidDecimal() string {
+	if x != nil {
+		return x.BidDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetAskDecimal() string {
+	if x != nil {
+		return x.AskDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *MarketData) GetAskSize() int64 {
+	if x != nil {
+		return x.AskSize
+	}
+	return 0
+}
+
+func (x *MarketData) GetVwapDecimal() string {
+	if x != nil {
+		return x.VwapDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetTickSizeDecimal() string {
+	if x != nil {
+		return x.TickSizeDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetLotSize() int64 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *MarketData) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MarketData) GetInstrumentCurrency() string {
+	if x != nil {
+		return x.InstrumentCurrency
+	}
+	return ""
+}
+
+func (x *MarketData) GetFxRateDecimal() string {
+	if x != nil {
+		return x.FxRateDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *MarketData) GetYieldDecimal() string {
+	if x != nil {
+		return x.YieldDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetCouponRateDecimal() string {
+	if x != nil {
+		return x.CouponRateDecimal
+	}
+	return ""
+}
+
+func (x *MarketData) GetCouponFrequency() int32 {
+	if x != nil {
+		return x.CouponFrequency
+	}
+	return 0
+}
+
+func (x *MarketData) GetMaturityDate() string {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return ""
+}
+
+func (x *MarketData) GetConstituents() []string {
+	if x != nil {
+		return x.Constituents
+	}
+	return nil
+}
+
+func (x *MarketData) GetNavDecimal() string {
+	if x != nil {
+		return x.NavDecimal
+	}
+	return ""
+}
+
+type AssetDetails struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CompanyName      string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Sector           string                 `protobuf:"bytes,3,opt,name=sector,proto3" json:"sector,omitempty"`
+	Industry         string                 `protobuf:"bytes,4,opt,name=industry,proto3" json:"industry,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	MarketCap        float64                `protobuf:"fixed64,6,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
+	PeRatio          float64                `protobuf:"fixed64,7,opt,name=pe_ratio,json=peRatio,proto3" json:"pe_ratio,omitempty"`
+	DividendYield    float64                `protobuf:"fixed64,8,opt,name=dividend_yield,json=dividendYield,proto3" json:"dividend_yield,omitempty"`
+	FiftyTwoWeekHigh float64                `protobuf:"fixed64,9,opt,name=fifty_two_week_high,json=fiftyTwoWeekHigh,proto3" json:"fifty_two_week_high,omitempty"`
+	FiftyTwoWeekLow  float64                `protobuf:"fixed64,10,opt,name=fifty_two_week_low,json=fiftyTwoWeekLow,proto3" json:"fifty_two_week_low,omitempty"`
+	Currency         string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Exchange         string                 `protobuf:"bytes,12,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetClass       AssetClass             `protobuf:"varint,13,opt,name=asset_class,json=assetClass,proto3,enum=hub_investments.AssetClass" json:"asset_class,omitempty"`
+	Quote            *AssetQuote            `protobuf:"bytes,14,opt,name=quote,proto3" json:"quote,omitempty"` // Latest simulated quote, including bid and ask
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AssetDetails) Reset() {
+	*x = AssetDetails{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetDetails) ProtoMessage() {}
+
+func (x *AssetDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetDetails.ProtoReflect.Descriptor instead.
+func (*AssetDetails) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssetDetails) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetMarketCap() float64 {
+	if x != nil {
+		return x.MarketCap
+	}
+	return 0
+}
+
+func (x *AssetDetails) GetPeRatio() float64 {
+	if x != nil {
+		return x.PeRatio
+	}
+	return 0
+}
+
+func (x *AssetDetails) GetDividendYield() float64 {
+	if x != nil {
+		return x.DividendYield
+	}
+	return 0
+}
+
+func (x *AssetDetails) GetFiftyTwoWeekHigh() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekHigh
+	}
+	return 0
+}
+
+func (x *AssetDetails) GetFiftyTwoWeekLow() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekLow
+	}
+	return 0
+}
+
+func (x *AssetDetails) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AssetDetails) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *AssetDetails) GetQuote() *AssetQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type StreamQuotesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Action  string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`   // "subscribe" or "unsubscribe"
+	Symbols []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"` // List of symbols to subscribe/unsubscribe
+	// Optional ISO 4217 currency the quotes of the stream are converted into, e.g. "BRL".
+	// Set by a subscribe request, it applies to every symbol of the stream.
+	TargetCurrency string `protobuf:"bytes,3,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamQuotesRequest) Reset() {
+	*x = StreamQuotesRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesRequest) ProtoMessage() {}
+
+func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesRequest.ProtoReflect.Descriptor instead.
+func (*StreamQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StreamQuotesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StreamQuotesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *StreamQuotesRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+type StreamQuotesResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Type         string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                     // "quote", "market_status", "halt", "resume", "corporate_action", "error", "heartbeat"
+	Quote        *AssetQuote            `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`                                   // Quote data (only for type="quote")
+	ErrorMessage string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message (only for type="error")
+	// Status of a subscribed symbol, sent when subscribing and whenever it changes
+	// (for type="market_status", "halt" and "resume"). "halt" is sent when the symbol
+	// is halted and "resume" when a halt ends.
+	MarketStatus *SymbolMarketStatus `protobuf:"bytes,4,opt,name=market_status,json=marketStatus,proto3" json:"market_status,omitempty"`
+	// Corporate action applied to a subscribed symbol at the session open (only for
+	// type="corporate_action"). It is sent before the adjusted quote; after a symbol change
+	// the stream follows the new symbol.
+	CorporateAction *CorporateAction `protobuf:"bytes,5,opt,name=corporate_action,json=corporateAction,proto3" json:"corporate_action,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamQuotesResponse) Reset() {
+	*x = StreamQuotesResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesResponse) ProtoMessage() {}
+
+func (x *StreamQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesResponse.ProtoReflect.Descriptor instead.
+func (*StreamQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamQuotesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamQuotesResponse) GetQuote() *AssetQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *StreamQuotesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *StreamQuotesResponse) GetMarketStatus() *SymbolMarketStatus {
+	if x != nil {
+		return x.MarketStatus
+	}
+	return nil
+}
+
+func (x *StreamQuotesResponse) GetCorporateAction() *CorporateAction {
+	if x != nil {
+		return x.CorporateAction
+	}
+	return nil
+}
+
+// A split, cash dividend or symbol change. Prices before the ex date are multiplied by the
+// price factor to compare with the prices after it.
+type CorporateAction struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Symbol                string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Type                  CorporateActionType    `protobuf:"varint,2,opt,name=type,proto3,enum=hub_investments.CorporateActionType" json:"type,omitempty"`
+	ExDate                string                 `protobuf:"bytes,3,opt,name=ex_date,json=exDate,proto3" json:"ex_date,omitempty"`           // YYYY-MM-DD, first session the action is in effect
+	SplitFrom             int64                  `protobuf:"varint,4,opt,name=split_from,json=splitFrom,proto3" json:"split_from,omitempty"` // SPLIT: split_from shares become split_to shares
+	SplitTo               int64                  `protobuf:"varint,5,opt,name=split_to,json=splitTo,proto3" json:"split_to,omitempty"`
+	DividendAmountDecimal string                 `protobuf:"bytes,6,opt,name=dividend_amount_decimal,json=dividendAmountDecimal,proto3" json:"dividend_amount_decimal,omitempty"` // CASH_DIVIDEND: paid per share in the currency of the symbol
+	NewSymbol             string                 `protobuf:"bytes,7,opt,name=new_symbol,json=newSymbol,proto3" json:"new_symbol,omitempty"`                                       // SYMBOL_CHANGE: symbol the shares trade under from the ex date
+	PriceFactorDecimal    string                 `protobuf:"bytes,8,opt,name=price_factor_decimal,json=priceFactorDecimal,proto3" json:"price_factor_decimal,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CorporateAction) Reset() {
+	*x = CorporateAction{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateAction) ProtoMessage() {}
+
+func (x *CorporateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateAction.ProtoReflect.Descriptor instead.
+func (*CorporateAction) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CorporateAction) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetType() CorporateActionType {
+	if x != nil {
+		return x.Type
+	}
+	return CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CorporateAction) GetExDate() string {
+	if x != nil {
+		return x.ExDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetSplitFrom() int64 {
+	if x != nil {
+		return x.SplitFrom
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetSplitTo() int64 {
+	if x != nil {
+		return x.SplitTo
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetDividendAmountDecimal() string {
+	if x != nil {
+		return x.DividendAmountDecimal
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetNewSymbol() string {
+	if x != nil {
+		return x.NewSymbol
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetPriceFactorDecimal() string {
+	if x != nil {
+		return x.PriceFactorDecimal
+	}
+	return ""
+}
+
+type GetMarketStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"` // Optional symbols to report individually
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketStatusRequest) Reset() {
+	*x = GetMarketStatusRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatusRequest) ProtoMessage() {}
+
+func (x *GetMarketStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMarketStatusRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type GetMarketStatusResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse     `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Exchanges     []*ExchangeMarketStatus `protobuf:"bytes,2,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Symbols       []*SymbolMarketStatus   `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketStatusResponse) Reset() {
+	*x = GetMarketStatusResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatusResponse) ProtoMessage() {}
+
+func (x *GetMarketStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMarketStatusResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetMarketStatusResponse) GetExchanges() []*ExchangeMarketStatus {
+	if x != nil {
+		return x.Exchanges
+	}
+	return nil
+}
+
+func (x *GetMarketStatusResponse) GetSymbols() []*SymbolMarketStatus {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type ExchangeMarketStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"` // Exchange code, e.g. "US", "SA"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA time zone of the trading hours
+	Status        MarketStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=hub_investments.MarketStatus" json:"status,omitempty"`
+	NextChange    string                 `protobuf:"bytes,5,opt,name=next_change,json=nextChange,proto3" json:"next_change,omitempty"` // RFC3339 time of the next status change, empty if none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeMarketStatus) Reset() {
+	*x = ExchangeMarketStatus{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeMarketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeMarketStatus) ProtoMessage() {}
+
+func (x *ExchangeMarketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeMarketStatus.ProtoReflect.Descriptor instead.
+func (*ExchangeMarketStatus) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExchangeMarketStatus) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ExchangeMarketStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExchangeMarketStatus) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ExchangeMarketStatus) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (x *ExchangeMarketStatus) GetNextChange() string {
+	if x != nil {
+		return x.NextChange
+	}
+	return ""
+}
+
+type SymbolMarketStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Status        MarketStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=hub_investments.MarketStatus" json:"status,omitempty"`
+	NextChange    string                 `protobuf:"bytes,4,opt,name=next_change,json=nextChange,proto3" json:"next_change,omitempty"` // RFC3339 time of the next status change, empty if none
+	Halt          *TradingHalt           `protobuf:"bytes,5,opt,name=halt,proto3" json:"halt,omitempty"`                               // Active halt (only when status is MARKET_STATUS_HALTED)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolMarketStatus) Reset() {
+	*x = SymbolMarketStatus{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolMarketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolMarketStatus) ProtoMessage() {}
+
+func (x *SymbolMarketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolMarketStatus.ProtoReflect.Descriptor instead.
+func (*SymbolMarketStatus) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SymbolMarketStatus) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolMarketStatus) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SymbolMarketStatus) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (x *SymbolMarketStatus) GetNextChange() string {
+	if x != nil {
+		return x.NextChange
+	}
+	return ""
+}
+
+func (x *SymbolMarketStatus) GetHalt() *TradingHalt {
+	if x != nil {
+		return x.Halt
+	}
+	return nil
+}
+
+type TradingHalt struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Symbol                string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Reason                HaltReason             `protobuf:"varint,2,opt,name=reason,proto3,enum=hub_investments.HaltReason" json:"reason,omitempty"`
+	Message               string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	HaltedAt              string                 `protobuf:"bytes,4,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`                                          // RFC3339
+	ResumeAt              string                 `protobuf:"bytes,5,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"`                                          // RFC3339 end of a limit band halt, empty for admin halts
+	ReferencePriceDecimal string                 `protobuf:"bytes,6,opt,name=reference_price_decimal,json=referencePriceDecimal,proto3" json:"reference_price_decimal,omitempty"` // Price the limit band is measured from
+	LimitPriceDecimal     string                 `protobuf:"bytes,7,opt,name=limit_price_decimal,json=limitPriceDecimal,proto3" json:"limit_price_decimal,omitempty"`             // Band limit the price was held at
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TradingHalt) Reset() {
+	*x = TradingHalt{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradingHalt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingHalt) ProtoMessage() {}
+
+func (x *TradingHalt) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingHalt.ProtoReflect.Descriptor instead.
+func (*TradingHalt) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TradingHalt) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TradingHalt) GetReason() HaltReason {
+	if x != nil {
+		return x.Reason
+	}
+	return HaltReason_HALT_REASON_UNSPECIFIED
+}
+
+func (x *TradingHalt) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TradingHalt) GetHaltedAt() string {
+	if x != nil {
+		return x.HaltedAt
+	}
+	return ""
+}
+
+func (x *TradingHalt) GetResumeAt() string {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return ""
+}
+
+func (x *TradingHalt) GetReferencePriceDecimal() string {
+	if x != nil {
+		return x.ReferencePriceDecimal
+	}
+	return ""
+}
+
+func (x *TradingHalt) GetLimitPriceDecimal() string {
+	if x != nil {
+		return x.LimitPriceDecimal
+	}
+	return ""
+}
+
+type HaltSymbolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Optional reason shown to subscribers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaltSymbolRequest) Reset() {
+	*x = HaltSymbolRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaltSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltSymbolRequest) ProtoMessage() {}
+
+func (x *HaltSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltSymbolRequest.ProtoReflect.Descriptor instead.
+func (*HaltSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HaltSymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *HaltSymbolRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HaltSymbolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Halt          *TradingHalt           `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HaltSymbolResponse) Reset() {
+	*x = HaltSymbolResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HaltSymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltSymbolResponse) ProtoMessage() {}
+
+func (x *HaltSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltSymbolResponse.ProtoReflect.Descriptor instead.
+func (*HaltSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HaltSymbolResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *HaltSymbolResponse) GetHalt() *TradingHalt {
+	if x != nil {
+		return x.Halt
+	}
+	return nil
+}
+
+type ResumeSymbolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSymbolRequest) Reset() {
+	*x = ResumeSymbolRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSymbolRequest) ProtoMessage() {}
+
+func (x *ResumeSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSymbolRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResumeSymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type ResumeSymbolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Halt          *TradingHalt           `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt,omitempty"` // The halt that ended
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSymbolResponse) Reset() {
+	*x = ResumeSymbolResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSymbolResponse) ProtoMessage() {}
+
+func (x *ResumeSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSymbolResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResumeSymbolResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *ResumeSymbolResponse) GetHalt() *TradingHalt {
+	if x != nil {
+		return x.Halt
+	}
+	return nil
+}
+
+type ListHaltsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHaltsRequest) Reset() {
+	*x = ListHaltsRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHaltsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHaltsRequest) ProtoMessage() {}
+
+func (x *ListHaltsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHaltsRequest.ProtoReflect.Descriptor instead.
+func (*ListHaltsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{21}
+}
+
+type ListHaltsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Halts         []*TradingHalt         `protobuf:"bytes,2,rep,name=halts,proto3" json:"halts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHaltsResponse) Reset() {
+	*x = ListHaltsResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHaltsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHaltsResponse) ProtoMessage() {}
+
+func (x *ListHaltsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHaltsResponse.ProtoReflect.Descriptor instead.
+func (*ListHaltsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListHaltsResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *ListHaltsResponse) GetHalts() []*TradingHalt {
+	if x != nil {
+		return x.Halts
+	}
+	return nil
+}
+
+type StartScenarioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // Name of a scenario of the library
+	Definition    string                 `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"` // YAML scenario, used when name is empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScenarioRequest) Reset() {
+	*x = StartScenarioRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScenarioRequest) ProtoMessage() {}
+
+func (x *StartScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScenarioRequest.ProtoReflect.Descriptor instead.
+func (*StartScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartScenarioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartScenarioRequest) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+type StartScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Scenario      *ScenarioStatus        `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScenarioResponse) Reset() {
+	*x = StartScenarioResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScenarioResponse) ProtoMessage() {}
+
+func (x *StartScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScenarioResponse.ProtoReflect.Descriptor instead.
+func (*StartScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartScenarioResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *StartScenarioResponse) GetScenario() *ScenarioStatus {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type StopScenarioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopScenarioRequest) Reset() {
+	*x = StopScenarioRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScenarioRequest) ProtoMessage() {}
+
+func (x *StopScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScenarioRequest.ProtoReflect.Descriptor instead.
+func (*StopScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{25}
+}
+
+type StopScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Scenario      *ScenarioStatus        `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"` // Progress of the scenario when it was stopped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopScenarioResponse) Reset() {
+	*x = StopScenarioResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScenarioResponse) ProtoMessage() {}
+
+func (x *StopScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScenarioResponse.ProtoReflect.Descriptor instead.
+func (*StopScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StopScenarioResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *StopScenarioResponse) GetScenario() *ScenarioStatus {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type GetScenarioStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScenarioStatusRequest) Reset() {
+	*x = GetScenarioStatusRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScenarioStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScenarioStatusRequest) ProtoMessage() {}
+
+func (x *GetScenarioStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScenarioStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetScenarioStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{27}
+}
+
+type GetScenarioStatusResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse        *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Scenario           *ScenarioStatus        `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"` // Not set when no scenario is running
+	AvailableScenarios []string               `protobuf:"bytes,3,rep,name=available_scenarios,json=availableScenarios,proto3" json:"available_scenarios,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetScenarioStatusResponse) Reset() {
+	*x = GetScenarioStatusResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScenarioStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScenarioStatusResponse) ProtoMessage() {}
+
+func (x *GetScenarioStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScenarioStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetScenarioStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetScenarioStatusResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetScenarioStatusResponse) GetScenario() *ScenarioStatus {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *GetScenarioStatusResponse) GetAvailableScenarios() []string {
+	if x != nil {
+		return x.AvailableScenarios
+	}
+	return nil
+}
+
+type ScenarioStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // RFC3339
+	EventsApplied int32                  `protobuf:"varint,4,opt,name=events_applied,json=eventsApplied,proto3" json:"events_applied,omitempty"`
+	EventsTotal   int32                  `protobuf:"varint,5,opt,name=events_total,json=eventsTotal,proto3" json:"events_total,omitempty"`
+	Completed     bool                   `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"` // Every event applied and every drift finished
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioStatus) Reset() {
+	*x = ScenarioStatus{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioStatus) ProtoMessage() {}
+
+func (x *ScenarioStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioStatus.ProtoReflect.Descriptor instead.
+func (*ScenarioStatus) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ScenarioStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScenarioStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScenarioStatus) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ScenarioStatus) GetEventsApplied() int32 {
+	if x != nil {
+		return x.EventsApplied
+	}
+	return 0
+}
+
+func (x *ScenarioStatus) GetEventsTotal() int32 {
+	if x != nil {
+		return x.EventsTotal
+	}
+	return 0
+}
+
+func (x *ScenarioStatus) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type CreateSandboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`  // Letters, digits, '-' and '_', up to 64 characters
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"` // Seed of the sandbox simulation, 0 seeds from the clock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSandboxRequest) Reset() {
+	*x = CreateSandboxRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSandboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSandboxRequest) ProtoMessage() {}
+
+func (x *CreateSandboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSandboxRequest.ProtoReflect.Descriptor instead.
+func (*CreateSandboxRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateSandboxRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSandboxRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type CreateSandboxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Sandbox       *Sandbox               `protobuf:"bytes,2,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSandboxResponse) Reset() {
+	*x = CreateSandboxResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSandboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSandboxResponse) ProtoMessage() {}
+
+func (x *CreateSandboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSandboxResponse.ProtoReflect.Descriptor instead.
+func (*CreateSandboxResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateSandboxResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *CreateSandboxResponse) GetSandbox() *Sandbox {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
+type DestroySandboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroySandboxRequest) Reset() {
+	*x = DestroySandboxRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySandboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySandboxRequest) ProtoMessage() {}
+
+func (x *DestroySandboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySandboxRequest.ProtoReflect.Descriptor instead.
+func (*DestroySandboxRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DestroySandboxRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DestroySandboxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Sandbox       *Sandbox               `protobuf:"bytes,2,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroySandboxResponse) Reset() {
+	*x = DestroySandboxResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySandboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySandboxResponse) ProtoMessage() {}
+
+func (x *DestroySandboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySandboxResponse.ProtoReflect.Descriptor instead.
+func (*DestroySandboxResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DestroySandboxResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *DestroySandboxResponse) GetSandbox() *Sandbox {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
+type ListSandboxesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSandboxesRequest) Reset() {
+	*x = ListSandboxesRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSandboxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSandboxesRequest) ProtoMessage() {}
+
+func (x *ListSandboxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSandboxesRequest.ProtoReflect.Descriptor instead.
+func (*ListSandboxesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{34}
+}
+
+type ListSandboxesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Sandboxes     []*Sandbox             `protobuf:"bytes,2,rep,name=sandboxes,proto3" json:"sandboxes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSandboxesResponse) Reset() {
+	*x = ListSandboxesResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSandboxesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSandboxesResponse) ProtoMessage() {}
+
+func (x *ListSandboxesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSandboxesResponse.ProtoReflect.Descriptor instead.
+func (*ListSandboxesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSandboxesResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *ListSandboxesResponse) GetSandboxes() []*Sandbox {
+	if x != nil {
+		return x.Sandboxes
+	}
+	return nil
+}
+
+type Sandbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // RFC3339
+	LastUsedAt    string                 `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // RFC3339
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // RFC3339, when it expires if it stays idle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sandbox) Reset() {
+	*x = Sandbox{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sandbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sandbox) ProtoMessage() {}
+
+func (x *Sandbox) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sandbox.ProtoReflect.Descriptor instead.
+func (*Sandbox) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Sandbox) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sandbox) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Sandbox) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Sandbox) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *Sandbox) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetMarketDepthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Levels        int32                  `protobuf:"varint,2,opt,name=levels,proto3" json:"levels,omitempty"` // Levels per side, 0 for the default (10)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketDepthRequest) Reset() {
+	*x = GetMarketDepthRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDepthRequest) ProtoMessage() {}
+
+func (x *GetMarketDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketDepthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMarketDepthRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetMarketDepthRequest) GetLevels() int32 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+type GetMarketDepthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Book          *OrderBook             `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketDepthResponse) Reset() {
+	*x = GetMarketDepthResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketDepthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDepthResponse) ProtoMessage() {}
+
+func (x *GetMarketDepthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDepthResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketDepthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMarketDepthResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetMarketDepthResponse) GetBook() *OrderBook {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type StreamMarketDepthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // "subscribe" or "unsubscribe"
+	Symbols       []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Levels        int32                  `protobuf:"varint,3,opt,name=levels,proto3" json:"levels,omitempty"` // Levels per side for the whole stream, 0 for the default (10)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMarketDepthRequest) Reset() {
+	*x = StreamMarketDepthRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMarketDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMarketDepthRequest) ProtoMessage() {}
+
+func (x *StreamMarketDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMarketDepthRequest.ProtoReflect.Descriptor instead.
+func (*StreamMarketDepthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StreamMarketDepthRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StreamMarketDepthRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *StreamMarketDepthRequest) GetLevels() int32 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+type StreamMarketDepthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                     // "snapshot", "update", "error", "heartbeat"
+	Book          *OrderBook             `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`                                     // Full book (only for type="snapshot")
+	Update        *OrderBookUpdate       `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`                                 // Changed levels (only for type="update")
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message (only for type="error")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMarketDepthResponse) Reset() {
+	*x = StreamMarketDepthResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMarketDepthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMarketDepthResponse) ProtoMessage() {}
+
+func (x *StreamMarketDepthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMarketDepthResponse.ProtoReflect.Descriptor instead.
+func (*StreamMarketDepthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StreamMarketDepthResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamMarketDepthResponse) GetBook() *OrderBook {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *StreamMarketDepthResponse) GetUpdate() *OrderBookUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *StreamMarketDepthResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type PriceLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceDecimal  string                 `protobuf:"bytes,1,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // Total quantity at the price, 0 in an update removes the level
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLevel) Reset() {
+	*x = PriceLevel{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevel) ProtoMessage() {}
+
+func (x *PriceLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLevel.ProtoReflect.Descriptor instead.
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PriceLevel) GetPriceDecimal() string {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return ""
+}
+
+func (x *PriceLevel) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type OrderBook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Sequence       uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"` // Increases every time the book changes
+	Bids           []*PriceLevel          `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`          // Best (highest) price first
+	Asks           []*PriceLevel          `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`          // Best (lowest) price first
+	PricePrecision int32                  `protobuf:"varint,5,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OrderBook) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderBook) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBook) GetBids() []*PriceLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBook) GetAsks() []*PriceLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBook) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *OrderBook) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// OrderBookUpdate holds the levels that changed since the previous snapshot or update of
+// the symbol on the same stream: set the size of each level and remove levels with size 0
+// to obtain the new book with the requested number of levels.
+type OrderBookUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Bids          []*PriceLevel          `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*PriceLevel          `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookUpdate) Reset() {
+	*x = OrderBookUpdate{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookUpdate) ProtoMessage() {}
+
+func (x *OrderBookUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookUpdate.ProtoReflect.Descriptor instead.
+func (*OrderBookUpdate) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OrderBookUpdate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderBookUpdate) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBookUpdate) GetBids() []*PriceLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBookUpdate) GetAsks() []*PriceLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBookUpdate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AssetQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"` // Asset class name, e.g. "STOCK", "ETF", "CRYPTO"
+	CurrentPrice  float64                `protobuf:"fixed64,4,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	BasePrice     float64                `protobuf:"fixed64,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Change        float64                `protobuf:"fixed64,6,opt,name=change,proto3" json:"change,omitempty"`
+	ChangePercent float64                `protobuf:"fixed64,7,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	LastUpdated   string                 `protobuf:"bytes,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Volume        int64                  `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	MarketCap     int64                  `protobuf:"varint,10,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
+	AssetClass    AssetClass             `protobuf:"varint,11,opt,name=asset_class,json=assetClass,proto3,enum=hub_investments.AssetClass" json:"asset_class,omitempty"`
+	// Exact decimal forms of the price fields above, prices with price_precision
+	// decimal places and change_percent with four
+	CurrentPriceDecimal  string `protobuf:"bytes,12,opt,name=current_price_decimal,json=currentPriceDecimal,proto3" json:"current_price_decimal,omitempty"`
+	BasePriceDecimal     string `protobuf:"bytes,13,opt,name=base_price_decimal,json=basePriceDecimal,proto3" json:"base_price_decimal,omitempty"`
+	ChangeDecimal        string `protobuf:"bytes,14,opt,name=change_decimal,json=changeDecimal,proto3" json:"change_decimal,omitempty"`
+	ChangePercentDecimal string `protobuf:"bytes,15,opt,name=change_percent_decimal,json=changePercentDecimal,proto3" json:"change_percent_decimal,omitempty"`
+	PricePrecision       int32  `protobuf:"varint,16,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	// Trading session statistics. change and change_percent are measured against
+	// previous_close; session_date is the local date of the session (YYYY-MM-DD)
+	OpenPriceDecimal     string `protobuf:"bytes,17,opt,name=open_price_decimal,json=openPriceDecimal,proto3" json:"open_price_decimal,omitempty"`
+	HighPriceDecimal     string `protobuf:"bytes,18,opt,name=high_price_decimal,json=highPriceDecimal,proto3" json:"high_price_decimal,omitempty"`
+	LowPriceDecimal      string `protobuf:"bytes,19,opt,name=low_price_decimal,json=lowPriceDecimal,proto3" json:"low_price_decimal,omitempty"`
+	PreviousCloseDecimal string `protobuf:"bytes,20,opt,name=previous_close_decimal,json=previousCloseDecimal,proto3" json:"previous_close_decimal,omitempty"`
+	SessionDate          string `protobuf:"bytes,21,opt,name=session_date,json=sessionDate,proto3" json:"session_date,omitempty"`
+	// Simulated top of book: bid and ask with price_precision decimal places and the
+	// quantity shown on each side
+	BidDecimal string `protobuf:"bytes,22,opt,name=bid_decimal,json=bidDecimal,proto3" json:"bid_decimal,omitempty"`
+	AskDecimal string `protobuf:"bytes,23,opt,name=ask_decimal,json=askDecimal,proto3" json:"ask_decimal,omitempty"`
+	BidSize    int64  `protobuf:"varint,24,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	AskSize    int64  `protobuf:"varint,25,opt,name=ask_size,json=askSize,proto3" json:"ask_size,omitempty"`
+	// Volume weighted average price of the session, with price_precision + 2 decimal places.
+	// volume is the cumulative volume of the session.
+	VwapDecimal string `protobuf:"bytes,26,opt,name=vwap_decimal,json=vwapDecimal,proto3" json:"vwap_decimal,omitempty"`
+	// Instrument metadata: every price is a whole multiple of tick_size_decimal and every
+	// size a whole multiple of lot_size, in currency (ISO 4217)
+	TickSizeDecimal string `protobuf:"bytes,27,opt,name=tick_size_decimal,json=tickSizeDecimal,proto3" json:"tick_size_decimal,omitempty"`
+	LotSize         int64  `protobuf:"varint,28,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	Currency        string `protobuf:"bytes,29,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Set when prices were converted into a target currency, as in MarketData
+	InstrumentCurrency string `protobuf:"bytes,30,opt,name=instrument_currency,json=instrumentCurrency,proto3" json:"instrument_currency,omitempty"`
+	FxRateDecimal      string `protobuf:"bytes,31,opt,name=fx_rate_decimal,json=fxRateDecimal,proto3" json:"fx_rate_decimal,omitempty"`
+	// Asset class fields, as in MarketData
+	BaseCurrency      string   `protobuf:"bytes,32,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	YieldDecimal      string   `protobuf:"bytes,33,opt,name=yield_decimal,json=yieldDecimal,proto3" json:"yield_decimal,omitempty"`
+	CouponRateDecimal string   `protobuf:"bytes,34,opt,name=coupon_rate_decimal,json=couponRateDecimal,proto3" json:"coupon_rate_decimal,omitempty"`
+	CouponFrequency   int32    `protobuf:"varint,35,opt,name=coupon_frequency,json=couponFrequency,proto3" json:"coupon_frequency,omitempty"`
+	MaturityDate      string   `protobuf:"bytes,36,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	Constituents      []string `protobuf:"bytes,37,rep,name=constituents,proto3" json:"constituents,omitempty"`
+	NavDecimal        string   `protobuf:"bytes,38,opt,name=nav_decimal,json=navDecimal,proto3" json:"nav_decimal,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AssetQuote) Reset() {
+	*x = AssetQuote{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetQuote) ProtoMessage() {}
+
+func (x *AssetQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetQuote.ProtoReflect.Descriptor instead.
+func (*AssetQuote) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AssetQuote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetMarketCap() int64 {
+	if x != nil {
+		return x.MarketCap
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetAssetClass() AssetClass {
+	if x != nil {
+		return x.AssetClass
+	}
+	return AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func (x *AssetQuote) GetCurrentPriceDecimal() string {
+	if x != nil {
+		return x.CurrentPriceDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetBasePriceDecimal() string {
+	if x != nil {
+		return x.BasePriceDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetChangeDecimal() string {
+	if x != nil {
+		return x.ChangeDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetChangePercentDecimal() string {
+	if x != nil {
+		return x.ChangePercentDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetOpenPriceDecimal() string {
+	if x != nil {
+		return x.OpenPriceDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetHighPriceDecimal() string {
+	if x != nil {
+		return x.HighPriceDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetLowPriceDecimal() string {
+	if x != nil {
+		return x.LowPriceDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetPreviousCloseDecimal() string {
+	if x != nil {
+		return x.PreviousCloseDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetSessionDate() string {
+	if x != nil {
+		return x.SessionDate
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetBidDecimal() string {
+	if x != nil {
+		return x.BidDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetAskDecimal() string {
+	if x != nil {
+		return x.AskDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetAskSize() int64 {
+	if x != nil {
+		return x.AskSize
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetVwapDecimal() string {
+	if x != nil {
+		return x.VwapDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetTickSizeDecimal() string {
+	if x != nil {
+		return x.TickSizeDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetLotSize() int64 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetInstrumentCurrency() string {
+	if x != nil {
+		return x.InstrumentCurrency
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetFxRateDecimal() string {
+	if x != nil {
+		return x.FxRateDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetYieldDecimal() string {
+	if x != nil {
+		return x.YieldDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetCouponRateDecimal() string {
+	if x != nil {
+		return x.CouponRateDecimal
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetCouponFrequency() int32 {
+	if x != nil {
+		return x.CouponFrequency
+	}
+	return 0
+}
+
+func (x *AssetQuote) GetMaturityDate() string {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return ""
+}
+
+func (x *AssetQuote) GetConstituents() []string {
+	if x != nil {
+		return x.Constituents
+	}
+	return nil
+}
+
+func (x *AssetQuote) GetNavDecimal() string {
+	if x != nil {
+		return x.NavDecimal
+	}
+	return ""
+}
+
+type GetRecentTradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Number of trades, 0 for the default (50)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentTradesRequest) Reset() {
+	*x = GetRecentTradesRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentTradesRequest) ProtoMessage() {}
+
+func (x *GetRecentTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentTradesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentTradesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRecentTradesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetRecentTradesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentTradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Trades        []*Trade               `protobuf:"bytes,2,rep,name=trades,proto3" json:"trades,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentTradesResponse) Reset() {
+	*x = GetRecentTradesResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentTradesResponse) ProtoMessage() {}
+
+func (x *GetRecentTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentTradesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentTradesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRecentTradesResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetRecentTradesResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type StreamTradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // "subscribe" or "unsubscribe"
+	Symbols       []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTradesRequest) Reset() {
+	*x = StreamTradesRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTradesRequest) ProtoMessage() {}
+
+func (x *StreamTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTradesRequest.ProtoReflect.Descriptor instead.
+func (*StreamTradesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StreamTradesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StreamTradesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type StreamTradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                     // "trade", "error", "heartbeat"
+	Trade         *Trade                 `protobuf:"bytes,2,opt,name=trade,proto3" json:"trade,omitempty"`                                   // Trade print (only for type="trade")
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message (only for type="error")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTradesResponse) Reset() {
+	*x = StreamTradesResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTradesResponse) ProtoMessage() {}
+
+func (x *StreamTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTradesResponse.ProtoReflect.Descriptor instead.
+func (*StreamTradesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StreamTradesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamTradesResponse) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *StreamTradesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type Trade struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TradeId      uint64                 `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"` // Increases with every trade of the service
+	Symbol       string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceDecimal string                 `protobuf:"bytes,3,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"`
+	Size         int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Side         TradeSide              `protobuf:"varint,5,opt,name=side,proto3,enum=hub_investments.TradeSide" json:"side,omitempty"`
+	Timestamp    string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // RFC3339 with nanoseconds
+	// Cumulative session volume and VWAP of the symbol including this trade
+	Volume        int64  `protobuf:"varint,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	VwapDecimal   string `protobuf:"bytes,8,opt,name=vwap_decimal,json=vwapDecimal,proto3" json:"vwap_decimal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Trade) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *Trade) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Trade) GetPriceDecimal() string {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return ""
+}
+
+func (x *Trade) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Trade) GetSide() TradeSide {
+	if x != nil {
+		return x.Side
+	}
+	return TradeSide_TRADE_SIDE_UNSPECIFIED
+}
+
+func (x *Trade) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Trade) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Trade) GetVwapDecimal() string {
+	if x != nil {
+		return x.VwapDecimal
+	}
+	return ""
+}
+
+type GetOptionChainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`                                          // Underlying symbol
+	ExpiryDate     string                 `protobuf:"bytes,2,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`                // YYYY-MM-DD, empty for the nearest expiry
+	StrikesPerSide int32                  `protobuf:"varint,3,opt,name=strikes_per_side,json=strikesPerSide,proto3" json:"strikes_per_side,omitempty"` // Strikes on either side of the money, 0 for the default (5)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOptionChainRequest) Reset() {
+	*x = GetOptionChainRequest{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionChainRequest) ProtoMessage() {}
+
+func (x *GetOptionChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionChainRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionChainRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetOptionChainRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetOptionChainRequest) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *GetOptionChainRequest) GetStrikesPerSide() int32 {
+	if x != nil {
+		return x.StrikesPerSide
+	}
+	return 0
+}
+
+type GetOptionChainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiResponse   *common.APIResponse    `protobuf:"bytes,1,opt,name=api_response,json=apiResponse,proto3" json:"api_response,omitempty"`
+	Chain         *OptionChain           `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionChainResponse) Reset() {
+	*x = GetOptionChainResponse{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionChainResponse) ProtoMessage() {}
+
+func (x *GetOptionChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionChainResponse.ProtoReflect.Descriptor instead.
+func (*GetOptionChainResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetOptionChainResponse) GetApiResponse() *common.APIResponse {
+	if x != nil {
+		return x.ApiResponse
+	}
+	return nil
+}
+
+func (x *GetOptionChainResponse) GetChain() *OptionChain {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+// OptionChain holds the calls and puts of one expiry, in ascending strike order, quoted with
+// Black-Scholes at the same underlying price
+type OptionChain struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Underlying             string                 `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	UnderlyingPriceDecimal string                 `protobuf:"bytes,2,opt,name=underlying_price_decimal,json=underlyingPriceDecimal,proto3" json:"underlying_price_decimal,omitempty"`
+	ExpiryDate             string                 `protobuf:"bytes,3,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`    // YYYY-MM-DD
+	ExpiryDates            []string               `protobuf:"bytes,4,rep,name=expiry_dates,json=expiryDates,proto3" json:"expiry_dates,omitempty"` // Every listed expiry of the underlying
+	Calls                  []*OptionQuote         `protobuf:"bytes,5,rep,name=calls,proto3" json:"calls,omitempty"`
+	Puts                   []*OptionQuote         `protobuf:"bytes,6,rep,name=puts,proto3" json:"puts,omitempty"`
+	RiskFreeRate           float64                `protobuf:"fixed64,7,opt,name=risk_free_rate,json=riskFreeRate,proto3" json:"risk_free_rate,omitempty"` // Annual, continuously compounded, as a fraction
+	Timestamp              string                 `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                               // RFC3339 with nanoseconds
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OptionChain) Reset() {
+	*x = OptionChain{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionChain) ProtoMessage() {}
+
+func (x *OptionChain) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionChain.ProtoReflect.Descriptor instead.
+func (*OptionChain) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OptionChain) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OptionChain) GetUnderlyingPriceDecimal() string {
+	if x != nil {
+		return x.UnderlyingPriceDecimal
+	}
+	return ""
+}
+
+func (x *OptionChain) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *OptionChain) GetExpiryDates() []string {
+	if x != nil {
+		return x.ExpiryDates
+	}
+	return nil
+}
+
+func (x *OptionChain) GetCalls() []*OptionQuote {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+func (x *OptionChain) GetPuts() []*OptionQuote {
+	if x != nil {
+		return x.Puts
+	}
+	return nil
+}
+
+func (x *OptionChain) GetRiskFreeRate() float64 {
+	if x != nil {
+		return x.RiskFreeRate
+	}
+	return 0
+}
+
+func (x *OptionChain) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type OptionQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"` // OCC symbol, e.g. AAPL260417C00180000
+	Type          OptionType             `protobuf:"varint,2,opt,name=type,proto3,enum=hub_investments.OptionType" json:"type,omitempty"`
+	StrikeDecimal string                 `protobuf:"bytes,3,opt,name=strike_decimal,json=strikeDecimal,proto3" json:"strike_decimal,omitempty"`
+	ExpiryDate    string                 `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`       // YYYY-MM-DD, expires at the end of the day UTC
+	PriceDecimal  string                 `protobuf:"bytes,5,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"` // Theoretical price
+	BidDecimal    string                 `protobuf:"bytes,6,opt,name=bid_decimal,json=bidDecimal,proto3" json:"bid_decimal,omitempty"`
+	AskDecimal    string                 `protobuf:"bytes,7,opt,name=ask_decimal,json=askDecimal,proto3" json:"ask_decimal,omitempty"`
+	BidSize       int64                  `protobuf:"varint,8,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	AskSize       int64                  `protobuf:"varint,9,opt,name=ask_size,json=askSize,proto3" json:"ask_size,omitempty"`
+	// Greeks: delta and gamma per unit of the underlying, theta per calendar day and vega per
+	// volatility point
+	Delta             float64 `protobuf:"fixed64,10,opt,name=delta,proto3" json:"delta,omitempty"`
+	Gamma             float64 `protobuf:"fixed64,11,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Theta             float64 `protobuf:"fixed64,12,opt,name=theta,proto3" json:"theta,omitempty"`
+	Vega              float64 `protobuf:"fixed64,13,opt,name=vega,proto3" json:"vega,omitempty"`
+	ImpliedVolatility float64 `protobuf:"fixed64,14,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"` // Annual, as a fraction, implied by price_decimal
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OptionQuote) Reset() {
+	*x = OptionQuote{}
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionQuote) ProtoMessage() {}
+
+func (x *OptionQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionQuote.ProtoReflect.Descriptor instead.
+func (*OptionQuote) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *OptionQuote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetType() OptionType {
+	if x != nil {
+		return x.Type
+	}
+	return OptionType_OPTION_TYPE_UNSPECIFIED
+}
+
+func (x *OptionQuote) GetStrikeDecimal() string {
+	if x != nil {
+		return x.StrikeDecimal
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetPriceDecimal() string {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetBidDecimal() string {
+	if x != nil {
+		return x.BidDecimal
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetAskDecimal() string {
+	if x != nil {
+		return x.AskDecimal
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetAskSize() int64 {
+	if x != nil {
+		return x.AskSize
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetVega() float64 {
+	if x != nil {
+		return x.Vega
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetImpliedVolatility() float64 {
+	if x != nil {
+		return x.ImpliedVolatility
+	}
+	return 0
+}
+
+var File_internal_infrastructure_grpc_proto_market_data_proto protoreflect.FileDescriptor
+
+const file_internal_infrastructure_grpc_proto_market_data_proto_rawDesc = "" +
+	"\n" +
+	"4internal/infrastructure/grpc/proto/market_data.proto\x12\x0fhub_investments\x1a\x13common/common.proto\"W\n" +
+	"\x14GetMarketDataRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12'\n" +
+	"\x0ftarget_currency\x18\x02 \x01(\tR\x0etargetCurrency\"\x96\x01\n" +
+	"\x15GetMarketDataResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12<\n" +
+	"\vmarket_data\x18\x02 \x01(\v2\x1b.hub_investments.MarketDataR\n" +
+	"marketData\"0\n" +
+	"\x16GetAssetDetailsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"\x8f\x01\n" +
+	"\x17GetAssetDetailsResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x123\n" +
+	"\x05asset\x18\x02 \x01(\v2\x1d.hub_investments.AssetDetailsR\x05asset\"^\n" +
+	"\x19GetBatchMarketDataRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\x12'\n" +
+	"\x0ftarget_currency\x18\x02 \x01(\tR\x0etargetCurrency\"\xd4\x01\n" +
+	"\x1aGetBatchMarketDataResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12<\n" +
+	"\vmarket_data\x18\x02 \x03(\v2\x1b.hub_investments.MarketDataR\n" +
+	"marketData\x127\n" +
+	"\aresults\x18\x03 \x03(\v2\x1d.hub_investments.SymbolResultR\aresults\"\xad\x01\n" +
+	"\fSymbolResult\x12)\n" +
+	"\x10requested_symbol\x18\x01 \x01(\tR\x0frequestedSymbol\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1d.hub_investments.SymbolStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\x85\t\n" +
+	"\n" +
+	"MarketData\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12!\n" +
+	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12#\n" +
+	"\rcurrent_price\x18\x03 \x01(\x01R\fcurrentPrice\x12%\n" +
+	"\x0eprevious_close\x18\x04 \x01(\x01R\rpreviousClose\x12\x1d\n" +
+	"\n" +
+	"open_price\x18\x05 \x01(\x01R\topenPrice\x12\x1d\n" +
+	"\n" +
+	"high_price\x18\x06 \x01(\x01R\thighPrice\x12\x1b\n" +
+	"\tlow_price\x18\a \x01(\x01R\blowPrice\x12\x16\n" +
+	"\x06change\x18\b \x01(\x01R\x06change\x12%\n" +
+	"\x0echange_percent\x18\t \x01(\x01R\rchangePercent\x12\x16\n" +
+	"\x06volume\x18\n" +
+	" \x01(\x03R\x06volume\x12!\n" +
+	"\flast_updated\x18\v \x01(\tR\vlastUpdated\x12\x1a\n" +
+	"\bcategory\x18\f \x01(\x05R\bcategory\x12<\n" +
+	"\vasset_class\x18\r \x01(\x0e2\x1b.hub_investments.AssetClassR\n" +
+	"assetClass\x122\n" +
+	"\x15current_price_decimal\x18\x0e \x01(\tR\x13currentPriceDecimal\x12'\n" +
+	"\x0fprice_precision\x18\x0f \x01(\x05R\x0epricePrecision\x12\x1f\n" +
+	"\vbid_decimal\x18\x10 \x01(\tR\n" +
+	"bidDecimal\x12\x1f\n" +
+	"\vask_decimal\x18\x11 \x01(\tR\n" +
+	"askDecimal\x12\x19\n" +
+	"\bbid_size\x18\x12 \x01(\x03R\abidSize\x12\x19\n" +
+	"\bask_size\x18\x13 \x01(\x03R\aaskSize\x12!\n" +
+	"\fvwap_decimal\x18\x14 \x01(\tR\vvwapDecimal\x12*\n" +
+	"\x11tick_size_decimal\x18\x15 \x01(\tR\x0ftickSizeDecimal\x12\x19\n" +
+	"\blot_size\x18\x16 \x01(\x03R\alotSize\x12\x1a\n" +
+	"\bcurrency\x18\x17 \x01(\tR\bcurrency\x12/\n" +
+	"\x13instrument_currency\x18\x18 \x01(\tR\x12instrumentCurrency\x12&\n" +
+	"\x0ffx_rate_decimal\x18\x19 \x01(\tR\rfxRateDecimal\x12#\n" +
+	"\rbase_currency\x18\x1a \x01(\tR\fbaseCurrency\x12#\n" +
+	"\ryield_decimal\x18\x1b \x01(\tR\fyieldDecimal\x12.\n" +
+	"\x13coupon_rate_decimal\x18\x1c \x01(\tR\x11couponRateDecimal\x12)\n" +
+	"\x10coupon_frequency\x18\x1d \x01(\x05R\x0fcouponFrequency\x12#\n" +
+	"\rmaturity_date\x18\x1e \x01(\tR\fmaturityDate\x12\"\n" +
+	"\fconstituents\x18\x1f \x03(\tR\fconstituents\x12\x1f\n" +
+	"\vnav_decimal\x18  \x01(\tR\n" +
+	"navDecimal\"\x85\x04\n" +
+	"\fAssetDetails\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12!\n" +
+	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12\x16\n" +
+	"\x06sector\x18\x03 \x01(\tR\x06sector\x12\x1a\n" +
+	"\bindustry\x18\x04 \x01(\tR\bindustry\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"market_cap\x18\x06 \x01(\x01R\tmarketCap\x12\x19\n" +
+	"\bpe_ratio\x18\a \x01(\x01R\apeRatio\x12%\n" +
+	"\x0edividend_yield\x18\b \x01(\x01R\rdividendYield\x12-\n" +
+	"\x13fifty_two_week_high\x18\t \x01(\x01R\x10fiftyTwoWeekHigh\x12+\n" +
+	"\x12fifty_two_week_low\x18\n" +
+	" \x01(\x01R\x0ffiftyTwoWeekLow\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\f \x01(\tR\bexchange\x12<\n" +
+	"\vasset_class\x18\r \x01(\x0e2\x1b.hub_investments.AssetClassR\n" +
+	"assetClass\x121\n" +
+	"\x05quote\x18\x0e \x01(\v2\x1b.hub_investments.AssetQuoteR\x05quote\"p\n" +
+	"\x13StreamQuotesRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\x12'\n" +
+	"\x0ftarget_currency\x18\x03 \x01(\tR\x0etargetCurrency\"\x99\x02\n" +
+	"\x14StreamQuotesResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x121\n" +
+	"\x05quote\x18\x02 \x01(\v2\x1b.hub_investments.AssetQuoteR\x05quote\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12H\n" +
+	"\rmarket_status\x18\x04 \x01(\v2#.hub_investments.SymbolMarketStatusR\fmarketStatus\x12K\n" +
+	"\x10corporate_action\x18\x05 \x01(\v2 .hub_investments.CorporateActionR\x0fcorporateAction\"\xbf\x02\n" +
+	"\x0fCorporateAction\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x128\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.hub_investments.CorporateActionTypeR\x04type\x12\x17\n" +
+	"\aex_date\x18\x03 \x01(\tR\x06exDate\x12\x1d\n" +
+	"\n" +
+	"split_from\x18\x04 \x01(\x03R\tsplitFrom\x12\x19\n" +
+	"\bsplit_to\x18\x05 \x01(\x03R\asplitTo\x126\n" +
+	"\x17dividend_amount_decimal\x18\x06 \x01(\tR\x15dividendAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"new_symbol\x18\a \x01(\tR\tnewSymbol\x120\n" +
+	"\x14price_factor_decimal\x18\b \x01(\tR\x12priceFactorDecimal\"2\n" +
+	"\x16GetMarketStatusRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"\xde\x01\n" +
+	"\x17GetMarketStatusResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12C\n" +
+	"\texchanges\x18\x02 \x03(\v2%.hub_investments.ExchangeMarketStatusR\texchanges\x12=\n" +
+	"\asymbols\x18\x03 \x03(\v2#.hub_investments.SymbolMarketStatusR\asymbols\"\xba\x01\n" +
+	"\x14ExchangeMarketStatus\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.hub_investments.MarketStatusR\x06status\x12\x1f\n" +
+	"\vnext_change\x18\x05 \x01(\tR\n" +
+	"nextChange\"\xd2\x01\n" +
+	"\x12SymbolMarketStatus\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1d.hub_investments.MarketStatusR\x06status\x12\x1f\n" +
+	"\vnext_change\x18\x04 \x01(\tR\n" +
+	"nextChange\x120\n" +
+	"\x04halt\x18\x05 \x01(\v2\x1c.hub_investments.TradingHaltR\x04halt\"\x96\x02\n" +
+	"\vTradingHalt\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x123\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1b.hub_investments.HaltReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
+	"\thalted_at\x18\x04 \x01(\tR\bhaltedAt\x12\x1b\n" +
+	"\tresume_at\x18\x05 \x01(\tR\bresumeAt\x126\n" +
+	"\x17reference_price_decimal\x18\x06 \x01(\tR\x15referencePriceDecimal\x12.\n" +
+	"\x13limit_price_decimal\x18\a \x01(\tR\x11limitPriceDecimal\"E\n" +
+	"\x11HaltSymbolRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x87\x01\n" +
+	"\x12HaltSymbolResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x120\n" +
+	"\x04halt\x18\x02 \x01(\v2\x1c.hub_investments.TradingHaltR\x04halt\"-\n" +
+	"\x13ResumeSymbolRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"\x89\x01\n" +
+	"\x14ResumeSymbolResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x120\n" +
+	"\x04halt\x18\x02 \x01(\v2\x1c.hub_investments.TradingHaltR\x04halt\"\x12\n" +
+	"\x10ListHaltsRequest\"\x88\x01\n" +
+	"\x11ListHaltsResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x122\n" +
+	"\x05halts\x18\x02 \x03(\v2\x1c.hub_investments.TradingHaltR\x05halts\"J\n" +
+	"\x14StartScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x02 \x01(\tR\n" +
+	"definition\"\x95\x01\n" +
+	"\x15StartScenarioResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12;\n" +
+	"\bscenario\x18\x02 \x01(\v2\x1f.hub_investments.ScenarioStatusR\bscenario\"\x15\n" +
+	"\x13StopScenarioRequest\"\x94\x01\n" +
+	"\x14StopScenarioResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12;\n" +
+	"\bscenario\x18\x02 \x01(\v2\x1f.hub_investments.ScenarioStatusR\bscenario\"\x1a\n" +
+	"\x18GetScenarioStatusRequest\"\xca\x01\n" +
+	"\x19GetScenarioStatusResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12;\n" +
+	"\bscenario\x18\x02 \x01(\v2\x1f.hub_investments.ScenarioStatusR\bscenario\x12/\n" +
+	"\x13available_scenarios\x18\x03 \x03(\tR\x12availableScenarios\"\xcd\x01\n" +
+	"\x0eScenarioStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\tR\tstartedAt\x12%\n" +
+	"\x0eevents_applied\x18\x04 \x01(\x05R\reventsApplied\x12!\n" +
+	"\fevents_total\x18\x05 \x01(\x05R\veventsTotal\x12\x1c\n" +
+	"\tcompleted\x18\x06 \x01(\bR\tcompleted\">\n" +
+	"\x14CreateSandboxRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\"\x8c\x01\n" +
+	"\x15CreateSandboxResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x122\n" +
+	"\asandbox\x18\x02 \x01(\v2\x18.hub_investments.SandboxR\asandbox\"+\n" +
+	"\x15DestroySandboxRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x8d\x01\n" +
+	"\x16DestroySandboxResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x122\n" +
+	"\asandbox\x18\x02 \x01(\v2\x18.hub_investments.SandboxR\asandbox\"\x16\n" +
+	"\x14ListSandboxesRequest\"\x90\x01\n" +
+	"\x15ListSandboxesResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x126\n" +
+	"\tsandboxes\x18\x02 \x03(\v2\x18.hub_investments.SandboxR\tsandboxes\"\x91\x01\n" +
+	"\aSandbox\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"G\n" +
+	"\x15GetMarketDepthRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06levels\x18\x02 \x01(\x05R\x06levels\"\x89\x01\n" +
+	"\x16GetMarketDepthResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12.\n" +
+	"\x04book\x18\x02 \x01(\v2\x1a.hub_investments.OrderBookR\x04book\"d\n" +
+	"\x18StreamMarketDepthRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\x12\x16\n" +
+	"\x06levels\x18\x03 \x01(\x05R\x06levels\"\xbe\x01\n" +
+	"\x19StreamMarketDepthResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12.\n" +
+	"\x04book\x18\x02 \x01(\v2\x1a.hub_investments.OrderBookR\x04book\x128\n" +
+	"\x06update\x18\x03 \x01(\v2 .hub_investments.OrderBookUpdateR\x06update\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"E\n" +
+	"\n" +
+	"PriceLevel\x12#\n" +
+	"\rprice_decimal\x18\x01 \x01(\tR\fpriceDecimal\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\xe9\x01\n" +
+	"\tOrderBook\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x04R\bsequence\x12/\n" +
+	"\x04bids\x18\x03 \x03(\v2\x1b.hub_investments.PriceLevelR\x04bids\x12/\n" +
+	"\x04asks\x18\x04 \x03(\v2\x1b.hub_investments.PriceLevelR\x04asks\x12'\n" +
+	"\x0fprice_precision\x18\x05 \x01(\x05R\x0epricePrecision\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xc6\x01\n" +
+	"\x0fOrderBookUpdate\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x04R\bsequence\x12/\n" +
+	"\x04bids\x18\x03 \x03(\v2\x1b.hub_investments.PriceLevelR\x04bids\x12/\n" +
+	"\x04asks\x18\x04 \x03(\v2\x1b.hub_investments.PriceLevelR\x04asks\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xa1\v\n" +
+	"\n" +
+	"AssetQuote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12#\n" +
+	"\rcurrent_price\x18\x04 \x01(\x01R\fcurrentPrice\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x05 \x01(\x01R\tbasePrice\x12\x16\n" +
+	"\x06change\x18\x06 \x01(\x01R\x06change\x12%\n" +
+	"\x0echange_percent\x18\a \x01(\x01R\rchangePercent\x12!\n" +
+	"\flast_updated\x18\b \x01(\tR\vlastUpdated\x12\x16\n" +
+	"\x06volume\x18\t \x01(\x03R\x06volume\x12\x1d\n" +
+	"\n" +
+	"market_cap\x18\n" +
+	" \x01(\x03R\tmarketCap\x12<\n" +
+	"\vasset_class\x18\v \x01(\x0e2\x1b.hub_investments.AssetClassR\n" +
+	"assetClass\x122\n" +
+	"\x15current_price_decimal\x18\f \x01(\tR\x13currentPriceDecimal\x12,\n" +
+	"\x12base_price_decimal\x18\r \x01(\tR\x10basePriceDecimal\x12%\n" +
+	"\x0echange_decimal\x18\x0e \x01(\tR\rchangeDecimal\x124\n" +
+	"\x16change_percent_decimal\x18\x0f \x01(\tR\x14changePercentDecimal\x12'\n" +
+	"\x0fprice_precision\x18\x10 \x01(\x05R\x0epricePrecision\x12,\n" +
+	"\x12open_price_decimal\x18\x11 \x01(\tR\x10openPriceDecimal\x12,\n" +
+	"\x12high_price_decimal\x18\x12 \x01(\tR\x10highPriceDecimal\x12*\n" +
+	"\x11low_price_decimal\x18\x13 \x01(\tR\x0flowPriceDecimal\x124\n" +
+	"\x16previous_close_decimal\x18\x14 \x01(\tR\x14previousCloseDecimal\x12!\n" +
+	"\fsession_date\x18\x15 \x01(\tR\vsessionDate\x12\x1f\n" +
+	"\vbid_decimal\x18\x16 \x01(\tR\n" +
+	"bidDecimal\x12\x1f\n" +
+	"\vask_decimal\x18\x17 \x01(\tR\n" +
+	"askDecimal\x12\x19\n" +
+	"\bbid_size\x18\x18 \x01(\x03R\abidSize\x12\x19\n" +
+	"\bask_size\x18\x19 \x01(\x03R\aaskSize\x12!\n" +
+	"\fvwap_decimal\x18\x1a \x01(\tR\vvwapDecimal\x12*\n" +
+	"\x11tick_size_decimal\x18\x1b \x01(\tR\x0ftickSizeDecimal\x12\x19\n" +
+	"\blot_size\x18\x1c \x01(\x03R\alotSize\x12\x1a\n" +
+	"\bcurrency\x18\x1d \x01(\tR\bcurrency\x12/\n" +
+	"\x13instrument_currency\x18\x1e \x01(\tR\x12instrumentCurrency\x12&\n" +
+	"\x0ffx_rate_decimal\x18\x1f \x01(\tR\rfxRateDecimal\x12#\n" +
+	"\rbase_currency\x18  \x01(\tR\fbaseCurrency\x12#\n" +
+	"\ryield_decimal\x18! \x01(\tR\fyieldDecimal\x12.\n" +
+	"\x13coupon_rate_decimal\x18\" \x01(\tR\x11couponRateDecimal\x12)\n" +
+	"\x10coupon_frequency\x18# \x01(\x05R\x0fcouponFrequency\x12#\n" +
+	"\rmaturity_date\x18$ \x01(\tR\fmaturityDate\x12\"\n" +
+	"\fconstituents\x18% \x03(\tR\fconstituents\x12\x1f\n" +
+	"\vnav_decimal\x18& \x01(\tR\n" +
+	"navDecimal\"F\n" +
+	"\x16GetRecentTradesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8a\x01\n" +
+	"\x17GetRecentTradesResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x12.\n" +
+	"\x06trades\x18\x02 \x03(\v2\x16.hub_investments.TradeR\x06trades\"G\n" +
+	"\x13StreamTradesRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\"}\n" +
+	"\x14StreamTradesResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12,\n" +
+	"\x05trade\x18\x02 \x01(\v2\x16.hub_investments.TradeR\x05trade\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xfc\x01\n" +
+	"\x05Trade\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x04R\atradeId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12#\n" +
+	"\rprice_decimal\x18\x03 \x01(\tR\fpriceDecimal\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12.\n" +
+	"\x04side\x18\x05 \x01(\x0e2\x1a.hub_investments.TradeSideR\x04side\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06volume\x18\a \x01(\x03R\x06volume\x12!\n" +
+	"\fvwap_decimal\x18\b \x01(\tR\vvwapDecimal\"z\n" +
+	"\x15GetOptionChainRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vexpiry_date\x18\x02 \x01(\tR\n" +
+	"expiryDate\x12(\n" +
+	"\x10strikes_per_side\x18\x03 \x01(\x05R\x0estrikesPerSide\"\x8d\x01\n" +
+	"\x16GetOptionChainResponse\x12?\n" +
+	"\fapi_response\x18\x01 \x01(\v2\x1c.hub_investments.APIResponseR\vapiResponse\x122\n" +
+	"\x05chain\x18\x02 \x01(\v2\x1c.hub_investments.OptionChainR\x05chain\"\xd5\x02\n" +
+	"\vOptionChain\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tR\n" +
+	"underlying\x128\n" +
+	"\x18underlying_price_decimal\x18\x02 \x01(\tR\x16underlyingPriceDecimal\x12\x1f\n" +
+	"\vexpiry_date\x18\x03 \x01(\tR\n" +
+	"expiryDate\x12!\n" +
+	"\fexpiry_dates\x18\x04 \x03(\tR\vexpiryDates\x122\n" +
+	"\x05calls\x18\x05 \x03(\v2\x1c.hub_investments.OptionQuoteR\x05calls\x120\n" +
+	"\x04puts\x18\x06 \x03(\v2\x1c.hub_investments.OptionQuoteR\x04puts\x12$\n" +
+	"\x0erisk_free_rate\x18\a \x01(\x01R\friskFreeRate\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\"\xc0\x03\n" +
+	"\vOptionQuote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.hub_investments.OptionTypeR\x04type\x12%\n" +
+	"\x0estrike_decimal\x18\x03 \x01(\tR\rstrikeDecimal\x12\x1f\n" +
+	"\vexpiry_date\x18\x04 \x01(\tR\n" +
+	"expiryDate\x12#\n" +
+	"\rprice_decimal\x18\x05 \x01(\tR\fpriceDecimal\x12\x1f\n" +
+	"\vbid_decimal\x18\x06 \x01(\tR\n" +
+	"bidDecimal\x12\x1f\n" +
+	"\vask_decimal\x18\a \x01(\tR\n" +
+	"askDecimal\x12\x19\n" +
+	"\bbid_size\x18\b \x01(\x03R\abidSize\x12\x19\n" +
+	"\bask_size\x18\t \x01(\x03R\aaskSize\x12\x14\n" +
+	"\x05delta\x18\n" +
+	" \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\v \x01(\x01R\x05gamma\x12\x14\n" +
+	"\x05theta\x18\f \x01(\x01R\x05theta\x12\x12\n" +
+	"\x04vega\x18\r \x01(\x01R\x04vega\x12-\n" +
+	"\x12implied_volatility\x18\x0e \x01(\x01R\x11impliedVolatility*~\n" +
+	"\fSymbolStatus\x12\x1d\n" +
+	"\x19SYMBOL_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SYMBOL_STATUS_FOUND\x10\x01\x12\x1b\n" +
+	"\x17SYMBOL_STATUS_NOT_FOUND\x10\x02\x12\x19\n" +
+	"\x15SYMBOL_STATUS_INVALID\x10\x03*\xdc\x01\n" +
+	"\n" +
+	"AssetClass\x12\x1b\n" +
+	"\x17ASSET_CLASS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ASSET_CLASS_STOCK\x10\x01\x12\x13\n" +
+	"\x0fASSET_CLASS_ETF\x10\x02\x12\x16\n" +
+	"\x12ASSET_CLASS_CRYPTO\x10\x03\x12\x14\n" +
+	"\x10ASSET_CLASS_REIT\x10\x04\x12\x14\n" +
+	"\x10ASSET_CLASS_BOND\x10\x05\x12\x12\n" +
+	"\x0eASSET_CLASS_FX\x10\x06\x12\x15\n" +
+	"\x11ASSET_CLASS_INDEX\x10\a\x12\x16\n" +
+	"\x12ASSET_CLASS_OPTION\x10\b*\xaf\x01\n" +
+	"\x13CorporateActionType\x12%\n" +
+	"!CORPORATE_ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bCORPORATE_ACTION_TYPE_SPLIT\x10\x01\x12'\n" +
+	"#CORPORATE_ACTION_TYPE_CASH_DIVIDEND\x10\x02\x12'\n" +
+	"#CORPORATE_ACTION_TYPE_SYMBOL_CHANGE\x10\x03*\xb6\x01\n" +
+	"\fMarketStatus\x12\x1d\n" +
+	"\x19MARKET_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12MARKET_STATUS_OPEN\x10\x01\x12\x18\n" +
+	"\x14MARKET_STATUS_CLOSED\x10\x02\x12\x1c\n" +
+	"\x18MARKET_STATUS_PRE_MARKET\x10\x03\x12\x1d\n" +
+	"\x19MARKET_STATUS_POST_MARKET\x10\x04\x12\x18\n" +
+	"\x14MARKET_STATUS_HALTED\x10\x05*v\n" +
+	"\n" +
+	"HaltReason\x12\x1b\n" +
+	"\x17HALT_REASON_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11HALT_REASON_ADMIN\x10\x01\x12\x18\n" +
+	"\x14HALT_REASON_LIMIT_UP\x10\x02\x12\x1a\n" +
+	"\x16HALT_REASON_LIMIT_DOWN\x10\x03*P\n" +
+	"\tTradeSide\x12\x1a\n" +
+	"\x16TRADE_SIDE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eTRADE_SIDE_BUY\x10\x01\x12\x13\n" +
+	"\x0fTRADE_SIDE_SELL\x10\x02*T\n" +
+	"\n" +
+	"OptionType\x12\x1b\n" +
+	"\x17OPTION_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10OPTION_TYPE_CALL\x10\x01\x12\x13\n" +
+	"\x0fOPTION_TYPE_PUT\x10\x022\x8c\b\n" +
+	"\x11MarketDataService\x12^\n" +
+	"\rGetMarketData\x12%.hub_investments.GetMarketDataRequest\x1a&.hub_investments.GetMarketDataResponse\x12d\n" +
+	"\x0fGetAssetDetails\x12'.hub_investments.GetAssetDetailsRequest\x1a(.hub_investments.GetAssetDetailsResponse\x12m\n" +
+	"\x12GetBatchMarketData\x12*.hub_investments.GetBatchMarketDataRequest\x1a+.hub_investments.GetBatchMarketDataResponse\x12_\n" +
+	"\fStreamQuotes\x12$.hub_investments.StreamQuotesRequest\x1a%.hub_investments.StreamQuotesResponse(\x010\x01\x12d\n" +
+	"\x0fGetMarketStatus\x12'.hub_investments.GetMarketStatusRequest\x1a(.hub_investments.GetMarketStatusResponse\x12a\n" +
+	"\x0eGetMarketDepth\x12&.hub_investments.GetMarketDepthRequest\x1a'.hub_investments.GetMarketDepthResponse\x12n\n" +
+	"\x11StreamMarketDepth\x12).hub_investments.StreamMarketDepthRequest\x1a*.hub_investments.StreamMarketDepthResponse(\x010\x01\x12d\n" +
+	"\x0fGetRecentTrades\x12'.hub_investments.GetRecentTradesRequest\x1a(.hub_investments.GetRecentTradesResponse\x12_\n" +
+	"\fStreamTrades\x12$.hub_investments.StreamTradesRequest\x1a%.hub_investments.StreamTradesResponse(\x010\x01\x12a\n" +
+	"\x0eGetOptionChain\x12&.hub_investments.GetOptionChainRequest\x1a'.hub_investments.GetOptionChainResponse2\xec\x06\n" +
+	"\x16MarketDataAdminService\x12U\n" +
+	"\n" +
+	"HaltSymbol\x12\".hub_investments.HaltSymbolRequest\x1a#.hub_investments.HaltSymbolResponse\x12[\n" +
+	"\fResumeSymbol\x12$.hub_investments.ResumeSymbolRequest\x1a%.hub_investments.ResumeSymbolResponse\x12R\n" +
+	"\tListHalts\x12!.hub_investments.ListHaltsRequest\x1a\".hub_investments.ListHaltsResponse\x12^\n" +
+	"\rStartScenario\x12%.hub_investments.StartScenarioRequest\x1a&.hub_investments.StartScenarioResponse\x12[\n" +
+	"\fStopScenario\x12$.hub_investments.StopScenarioRequest\x1a%.hub_investments.StopScenarioResponse\x12j\n" +
+	"\x11GetScenarioStatus\x12).hub_investments.GetScenarioStatusRequest\x1a*.hub_investments.GetScenarioStatusResponse\x12^\n" +
+	"\rCreateSandbox\x12%.hub_investments.CreateSandboxRequest\x1a&.hub_investments.CreateSandboxResponse\x12a\n" +
+	"\x0eDestroySandbox\x12&.hub_investments.DestroySandboxRequest\x1a'.hub_investments.DestroySandboxResponse\x12^\n" +
+	"\rListSandboxes\x12%.hub_investments.ListSandboxesRequest\x1a&.hub_investments.ListSandboxesResponseBaZ_github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto;marketdatapbb\x06proto3"
+
+var (
+	file_internal_infrastructure_grpc_proto_market_data_proto_rawDescOnce sync.Once
+	file_internal_infrastructure_grpc_proto_market_data_proto_rawDescData []byte
+)
+
+func file_internal_infrastructure_grpc_proto_market_data_proto_rawDescGZIP() []byte {
+	file_internal_infrastructure_grpc_proto_market_data_proto_rawDescOnce.Do(func() {
+		file_internal_infrastructure_grpc_proto_market_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_infrastructure_grpc_proto_market_data_proto_rawDesc), len(file_internal_infrastructure_grpc_proto_market_data_proto_rawDesc)))
+	})
+	return file_internal_infrastructure_grpc_proto_market_data_proto_rawDescData
+}
+
+var file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_internal_infrastructure_grpc_proto_market_data_proto_goTypes = []any{
+	(SymbolStatus)(0),                  // 0: hub_investments.SymbolStatus
+	(AssetClass)(0),                    // 1: hub_investments.AssetClass
+	(CorporateActionType)(0),           // 2: hub_investments.CorporateActionType
+	(MarketStatus)(0),                  // 3: hub_investments.MarketStatus
+	(HaltReason)(0),                    // 4: hub_investments.HaltReason
+	(TradeSide)(0),                     // 5: hub_investments.TradeSide
+	(OptionType)(0),                    // 6: hub_investments.OptionType
+	(*GetMarketDataRequest)(nil),       // 7: hub_investments.GetMarketDataRequest
+	(*GetMarketDataResponse)(nil),      // 8: hub_investments.GetMarketDataResponse
+	(*GetAssetDetailsRequest)(nil),     // 9: hub_investments.GetAssetDetailsRequest
+	(*GetAssetDetailsResponse)(nil),    // 10: hub_investments.GetAssetDetailsResponse
+	(*GetBatchMarketDataRequest)(nil),  // 11: hub_investments.GetBatchMarketDataRequest
+	(*GetBatchMarketDataResponse)(nil), // 12: hub_investments.GetBatchMarketDataResponse
+	(*SymbolResult)(nil),               // 13: hub_investments.SymbolResult
+	(*MarketData)(nil),                 // 14: hub_investments.MarketData
+	(*AssetDetails)(nil),               // 15: hub_investments.AssetDetails
+	(*StreamQuotesRequest)(nil),        // 16: hub_investments.StreamQuotesRequest
+	(*StreamQuotesResponse)(nil),       // 17: hub_investments.StreamQuotesResponse
+	(*CorporateAction)(nil),            // 18: hub_investments.CorporateAction
+	(*GetMarketStatusRequest)(nil),     // 19: hub_investments.GetMarketStatusRequest
+	(*GetMarketStatusResponse)(nil),    // 20: hub_investments.GetMarketStatusResponse
+	(*ExchangeMarketStatus)(nil),       // 21: hub_investments.ExchangeMarketStatus
+	(*SymbolMarketStatus)(nil),         // 22: hub_investments.SymbolMarketStatus
+	(*TradingHalt)(nil),                // 23: hub_investments.TradingHalt
+	(*HaltSymbolRequest)(nil),          // 24: hub_investments.HaltSymbolRequest
+	(*HaltSymbolResponse)(nil),         // 25: hub_investments.HaltSymbolResponse
+	(*ResumeSymbolRequest)(nil),        // 26: hub_investments.ResumeSymbolRequest
+	(*ResumeSymbolResponse)(nil),       // 27: hub_investments.ResumeSymbolResponse
+	(*ListHaltsRequest)(nil),           // 28: hub_investments.ListHaltsRequest
+	(*ListHaltsResponse)(nil),          // 29: hub_investments.ListHaltsResponse
+	(*StartScenarioRequest)(nil),       // 30: hub_investments.StartScenarioRequest
+	(*StartScenarioResponse)(nil),      // 31: hub_investments.StartScenarioResponse
+	(*StopScenarioRequest)(nil),        // 32: hub_investments.StopScenarioRequest
+	(*StopScenarioResponse)(nil),       // 33: hub_investments.StopScenarioResponse
+	(*GetScenarioStatusRequest)(nil),   // 34: hub_investments.GetScenarioStatusRequest
+	(*GetScenarioStatusResponse)(nil),  // 35: hub_investments.GetScenarioStatusResponse
+	(*ScenarioStatus)(nil),             // 36: hub_investments.ScenarioStatus
+	(*CreateSandboxRequest)(nil),       // 37: hub_investments.CreateSandboxRequest
+	(*CreateSandboxResponse)(nil),      // 38: hub_investments.CreateSandboxResponse
+	(*DestroySandboxRequest)(nil),      // 39: hub_investments.DestroySandboxRequest
+	(*DestroySandboxResponse)(nil),     // 40: hub_investments.DestroySandboxResponse
+	(*ListSandboxesRequest)(nil),       // 41: hub_investments.ListSandboxesRequest
+	(*ListSandboxesResponse)(nil),      // 42: hub_investments.ListSandboxesResponse
+	(*Sandbox)(nil),                    // 43: hub_investments.Sandbox
+	(*GetMarketDepthRequest)(nil),      // 44: hub_investments.GetMarketDepthRequest
+	(*GetMarketDepthResponse)(nil),     // 45: hub_investments.GetMarketDepthResponse
+	(*StreamMarketDepthRequest)(nil),   // 46: hub_investments.StreamMarketDepthRequest
+	(*StreamMarketDepthResponse)(nil),  // 47: hub_investments.StreamMarketDepthResponse
+	(*PriceLevel)(nil),                 // 48: hub_investments.PriceLevel
+	(*OrderBook)(nil),                  // 49: hub_investments.OrderBook
+	(*OrderBookUpdate)(nil),            // 50: hub_investments.OrderBookUpdate
+	(*AssetQuote)(nil),                 // 51: hub_investments.AssetQuote
+	(*GetRecentTradesRequest)(nil),     // 52: hub_investments.GetRecentTradesRequest
+	(*GetRecentTradesResponse)(nil),    // 53: hub_investments.GetRecentTradesResponse
+	(*StreamTradesRequest)(nil),        // 54: hub_investments.StreamTradesRequest
+	(*StreamTradesResponse)(nil),       // 55: hub_investments.StreamTradesResponse
+	(*Trade)(nil),                      // 56: hub_investments.Trade
+	(*GetOptionChainRequest)(nil),      // 57: hub_investments.GetOptionChainRequest
+	(*GetOptionChainResponse)(nil),     // 58: hub_investments.GetOptionChainResponse
+	(*OptionChain)(nil),                // 59: hub_investments.OptionChain
+	(*OptionQuote)(nil),                // 60: hub_investments.OptionQuote
+	(*common.APIResponse)(nil),         // 61: hub_investments.APIResponse
+}
+var file_internal_infrastructure_grpc_proto_market_data_proto_depIdxs = []int32{
+	61, // 0: hub_investments.GetMarketDataResponse.api_response:type_name -> hub_investments.APIResponse
+	14, // 1: hub_investments.GetMarketDataResponse.market_data:type_name -> hub_investments.MarketData
+	61, // 2: hub_investments.GetAssetDetailsResponse.api_response:type_name -> hub_investments.APIResponse
+	15, // 3: hub_investments.GetAssetDetailsResponse.asset:type_name -> hub_investments.AssetDetails
+	61, // 4: hub_investments.GetBatchMarketDataResponse.api_response:type_name -> hub_investments.APIResponse
+	14, // 5: hub_investments.GetBatchMarketDataResponse.market_data:type_name -> hub_investments.MarketData
+	13, // 6: hub_investments.GetBatchMarketDataResponse.results:type_name -> hub_investments.SymbolResult
+	0,  // 7: hub_investments.SymbolResult.status:type_name -> hub_investments.SymbolStatus
+	1,  // 8: hub_investments.MarketData.asset_class:type_name -> hub_investments.AssetClass
+	1,  // 9: hub_investments.AssetDetails.asset_class:type_name -> hub_investments.AssetClass
+	51, // 10: hub_investments.AssetDetails.quote:type_name -> hub_investments.AssetQuote
+	51, // 11: hub_investments.StreamQuotesResponse.quote:type_name -> hub_investments.AssetQuote
+	22, // 12: hub_investments.StreamQuotesResponse.market_status:type_name -> hub_investments.SymbolMarketStatus
+	18, // 13: hub_investments.StreamQuotesResponse.corporate_action:type_name -> hub_investments.CorporateAction
+	2,  // 14: hub_investments.CorporateAction.type:type_name -> hub_investments.CorporateActionType
+	61, // 15: hub_investments.GetMarketStatusResponse.api_response:type_name -> hub_investments.APIResponse
+	21, // 16: hub_investments.GetMarketStatusResponse.exchanges:type_name -> hub_investments.ExchangeMarketStatus
+	22, // 17: hub_investments.GetMarketStatusResponse.symbols:type_name -> hub_investments.SymbolMarketStatus
+	3,  // 18: hub_investments.ExchangeMarketStatus.status:type_name -> hub_investments.MarketStatus
+	3,  // 19: hub_investments.SymbolMarketStatus.status:type_name -> hub_investments.MarketStatus
+	23, // 20: hub_investments.SymbolMarketStatus.halt:type_name -> hub_investments.TradingHalt
+	4,  // 21: hub_investments.TradingHalt.reason:type_name -> hub_investments.HaltReason
+	61, // 22: hub_investments.HaltSymbolResponse.api_response:type_name -> hub_investments.APIResponse
+	23, // 23: hub_investments.HaltSymbolResponse.halt:type_name -> hub_investments.TradingHalt
+	61, // 24: hub_investments.ResumeSymbolResponse.api_response:type_name -> hub_investments.APIResponse
+	23, // 25: hub_investments.ResumeSymbolResponse.halt:type_name -> hub_investments.TradingHalt
+	61, // 26: hub_investments.ListHaltsResponse.api_response:type_name -> hub_investments.APIResponse
+	23, // 27: hub_investments.ListHaltsResponse.halts:type_name -> hub_investments.TradingHalt
+	61, // 28: hub_investments.StartScenarioResponse.api_response:type_name -> hub_investments.APIResponse
+	36, // 29: hub_investments.StartScenarioResponse.scenario:type_name -> hub_investments.ScenarioStatus
+	61, // 30: hub_investments.StopScenarioResponse.api_response:type_name -> hub_investments.APIResponse
+	36, // 31: hub_investments.StopScenarioResponse.scenario:type_name -> hub_investments.ScenarioStatus
+	61, // 32: hub_investments.GetScenarioStatusResponse.api_response:type_name -> hub_investments.APIResponse
+	36, // 33: hub_investments.GetScenarioStatusResponse.scenario:type_name -> hub_investments.ScenarioStatus
+	61, // 34: hub_investments.CreateSandboxResponse.api_response:type_name -> hub_investments.APIResponse
+	43, // 35: hub_investments.CreateSandboxResponse.sandbox:type_name -> hub_investments.Sandbox
+	61, // 36: hub_investments.DestroySandboxResponse.api_response:type_name -> hub_investments.APIResponse
+	43, // 37: hub_investments.DestroySandboxResponse.sandbox:type_name -> hub_investments.Sandbox
+	61, // 38: hub_investments.ListSandboxesResponse.api_response:type_name -> hub_investments.APIResponse
+	43, // 39: hub_investments.ListSandboxesResponse.sandboxes:type_name -> hub_investments.Sandbox
+	61, // 40: hub_investments.GetMarketDepthResponse.api_response:type_name -> hub_investments.APIResponse
+	49, // 41: hub_investments.GetMarketDepthResponse.book:type_name -> hub_investments.OrderBook
+	49, // 42: hub_investments.StreamMarketDepthResponse.book:type_name -> hub_investments.OrderBook
+	50, // 43: hub_investments.StreamMarketDepthResponse.update:type_name -> hub_investments.OrderBookUpdate
+	48, // 44: hub_investments.OrderBook.bids:type_name -> hub_investments.PriceLevel
+	48, // 45: hub_investments.OrderBook.asks:type_name -> hub_investments.PriceLevel
+	48, // 46: hub_investments.OrderBookUpdate.bids:type_name -> hub_investments.PriceLevel
+	48, // 47: hub_investments.OrderBookUpdate.asks:type_name -> hub_investments.PriceLevel
+	1,  // 48: hub_investments.AssetQuote.asset_class:type_name -> hub_investments.AssetClass
+	61, // 49: hub_investments.GetRecentTradesResponse.api_response:type_name -> hub_investments.APIResponse
+	56, // 50: hub_investments.GetRecentTradesResponse.trades:type_name -> hub_investments.Trade
+	56, // 51: hub_investments.StreamTradesResponse.trade:type_name -> hub_investments.Trade
+	5,  // 52: hub_investments.Trade.side:type_name -> hub_investments.TradeSide
+	61, // 53: hub_investments.GetOptionChainResponse.api_response:type_name -> hub_investments.APIResponse
+	59, // 54: hub_investments.GetOptionChainResponse.chain:type_name -> hub_investments.OptionChain
+	60, // 55: hub_investments.OptionChain.calls:type_name -> hub_investments.OptionQuote
+	60, // 56: hub_investments.OptionChain.puts:type_name -> hub_investments.OptionQuote
+	6,  // 57: hub_investments.OptionQuote.type:type_name -> hub_investments.OptionType
+	7,  // 58: hub_investments.MarketDataService.GetMarketData:input_type -> hub_investments.GetMarketDataRequest
+	9,  // 59: hub_investments.MarketDataService.GetAssetDetails:input_type -> hub_investments.GetAssetDetailsRequest
+	11, // 60: hub_investments.MarketDataService.GetBatchMarketData:input_type -> hub_investments.GetBatchMarketDataRequest
+	16, // 61: hub_investments.MarketDataService.StreamQuotes:input_type -> hub_investments.StreamQuotesRequest
+	19, // 62: hub_investments.MarketDataService.GetMarketStatus:input_type -> hub_investments.GetMarketStatusRequest
+	44, // 63: hub_investments.MarketDataService.GetMarketDepth:input_type -> hub_investments.GetMarketDepthRequest
+	46, // 64: hub_investments.MarketDataService.StreamMarketDepth:input_type -> hub_investments.StreamMarketDepthRequest
+	52, // 65: hub_investments.MarketDataService.GetRecentTrades:input_type -> hub_investments.GetRecentTradesRequest
+	54, // 66: hub_investments.MarketDataService.StreamTrades:input_type -> hub_investments.StreamTradesRequest
+	57, // 67: hub_investments.MarketDataService.GetOptionChain:input_type -> hub_investments.GetOptionChainRequest
+	24, // 68: hub_investments.MarketDataAdminService.HaltSymbol:input_type -> hub_investments.HaltSymbolRequest
+	26, // 69: hub_investments.MarketDataAdminService.ResumeSymbol:input_type -> hub_investments.ResumeSymbolRequest
+	28, // 70: hub_investments.MarketDataAdminService.ListHalts:input_type -> hub_investments.ListHaltsRequest
+	30, // 71: hub_investments.MarketDataAdminService.StartScenario:input_type -> hub_investments.StartScenarioRequest
+	32, // 72: hub_investments.MarketDataAdminService.StopScenario:input_type -> hub_investments.StopScenarioRequest
+	34, // 73: hub_investments.MarketDataAdminService.GetScenarioStatus:input_type -> hub_investments.GetScenarioStatusRequest
+	37, // 74: hub_investments.MarketDataAdminService.CreateSandbox:input_type -> hub_investments.CreateSandboxRequest
+	39, // 75: hub_investments.MarketDataAdminService.DestroySandbox:input_type -> hub_investments.DestroySandboxRequest
+	41, // 76: hub_investments.MarketDataAdminService.ListSandboxes:input_type -> hub_investments.ListSandboxesRequest
+	8,  // 77: hub_investments.MarketDataService.GetMarketData:output_type -> hub_investments.GetMarketDataResponse
+	10, // 78: hub_investments.MarketDataService.GetAssetDetails:output_type -> hub_investments.GetAssetDetailsResponse
+	12, // 79: hub_investments.MarketDataService.GetBatchMarketData:output_type -> hub_investments.GetBatchMarketDataResponse
+	17, // 80: hub_investments.MarketDataService.StreamQuotes:output_type -> hub_investments.StreamQuotesResponse
+	20, // 81: hub_investments.MarketDataService.GetMarketStatus:output_type -> hub_investments.GetMarketStatusResponse
+	45, // 82: hub_investments.MarketDataService.GetMarketDepth:output_type -> hub_investments.GetMarketDepthResponse
+	47, // 83: hub_investments.MarketDataService.StreamMarketDepth:output_type -> hub_investments.StreamMarketDepthResponse
+	53, // 84: hub_investments.MarketDataService.GetRecentTrades:output_type -> hub_investments.GetRecentTradesResponse
+	55, // 85: hub_investments.MarketDataService.StreamTrades:output_type -> hub_investments.StreamTradesResponse
+	58, // 86: hub_investments.MarketDataService.GetOptionChain:output_type -> hub_investments.GetOptionChainResponse
+	25, // 87: hub_investments.MarketDataAdminService.HaltSymbol:output_type -> hub_investments.HaltSymbolResponse
+	27, // 88: hub_investments.MarketDataAdminService.ResumeSymbol:output_type -> hub_investments.ResumeSymbolResponse
+	29, // 89: hub_investments.MarketDataAdminService.ListHalts:output_type -> hub_investments.ListHaltsResponse
+	31, // 90: hub_investments.MarketDataAdminService.StartScenario:output_type -> hub_investments.StartScenarioResponse
+	33, // 91: hub_investments.MarketDataAdminService.StopScenario:output_type -> hub_investments.StopScenarioResponse
+	35, // 92: hub_investments.MarketDataAdminService.GetScenarioStatus:output_type -> hub_investments.GetScenarioStatusResponse
+	38, // 93: hub_investments.MarketDataAdminService.CreateSandbox:output_type -> hub_investments.CreateSandboxResponse
+	40, // 94: hub_investments.MarketDataAdminService.DestroySandbox:output_type -> hub_investments.DestroySandboxResponse
+	42, // 95: hub_investments.MarketDataAdminService.ListSandboxes:output_type -> hub_investments.ListSandboxesResponse
+	77, // [77:96] is the sub-list for method output_type
+	58, // [58:77] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
+}
+
+func init() { file_internal_infrastructure_grpc_proto_market_data_proto_init() }
+func file_internal_infrastructure_grpc_proto_market_data_proto_init() {
+	if File_internal_infrastructure_grpc_proto_market_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_infrastructure_grpc_proto_market_data_proto_rawDesc), len(file_internal_infrastructure_grpc_proto_market_data_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_internal_infrastructure_grpc_proto_market_data_proto_goTypes,
+		DependencyIndexes: file_internal_infrastructure_grpc_proto_market_data_proto_depIdxs,
+		EnumInfos:         file_internal_infrastructure_grpc_proto_market_data_proto_enumTypes,
+		MessageInfos:      file_internal_infrastructure_grpc_proto_market_data_proto_msgTypes,
+	}.Build()
+	File_internal_infrastructure_grpc_proto_market_data_proto = out.File
+	file_internal_infrastructure_grpc_proto_market_data_proto_goTypes = nil
+	file_internal_infrastructure_grpc_proto_market_data_proto_depIdxs = nil
+}
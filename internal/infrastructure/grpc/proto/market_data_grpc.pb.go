@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: internal/infrastructure/grpc/proto/market_data.proto
+
+// Market data contract served by this service.
+//
+// This file started as a copy of monolith/market_data_service.proto from
+// hub-proto-contracts and keeps the same proto package, service name and field
+// numbers, so clients generated from the shared contract stay wire compatible.
+// Fields and RPCs added here are additive and should be upstreamed to
+// hub-proto-contracts. Regenerate with `make proto-gen`.
+
+package marketdatapb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MarketDataService_GetMarketData_FullMethodName      = "/hub_investments.MarketDataService/GetMarketData"
+	MarketDataService_GetAssetDetails_FullMethodName    = "/hub_investments.MarketDataService/GetAssetDetails"
+	MarketDataService_GetBatchMarketData_FullMethodName = "/hub_investments.MarketDataService/GetBatchMarketData"
+	MarketDataService_StreamQuotes_FullMethodName       = "/hub_investments.MarketDataService/StreamQuotes"
+	MarketDataService_GetMarketStatus_FullMethodName    = "/hub_investments.MarketDataService/GetMarketStatus"
+	MarketDataService_GetMarketDepth_FullMethodName     = "/hub_investments.MarketDataService/GetMarketDepth"
+	MarketDataService_StreamMarketDepth_FullMethodName  = "/hub_investments.MarketDataService/StreamMarketDepth"
+	MarketDataService_GetRecentTrades_FullMethodName    = "/hub_investments.MarketDataService/GetRecentTrades"
+	MarketDataService_StreamTrades_FullMethodName       = "/hub_investments.MarketDataService/StreamTrades"
+	MarketDataService_GetOptionChain_FullMethodName     = "/hub_investments.MarketDataService/GetOptionChain"
+)
+
+// MarketDataServiceClient is the client API for MarketDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MarketDataService provides market data operations
+type MarketDataServiceClient interface {
+	// GetMarketData retrieves market data for a specific symbol
+	GetMarketData(ctx context.Context, in *GetMarketDataRequest, opts ...grpc.CallOption) (*GetMarketDataResponse, error)
+	// GetAssetDetails retrieves detailed asset information
+	GetAssetDetails(ctx context.Context, in *GetAssetDetailsRequest, opts ...grpc.CallOption) (*GetAssetDetailsResponse, error)
+	// GetBatchMarketData retrieves market data for multiple symbols
+	GetBatchMarketData(ctx context.Context, in *GetBatchMarketDataRequest, opts ...grpc.CallOption) (*GetBatchMarketDataResponse, error)
+	// StreamQuotes streams real-time quote updates for subscribed symbols
+	StreamQuotes(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamQuotesRequest, StreamQuotesResponse], error)
+	// GetMarketStatus returns the trading status of every exchange and of the requested symbols
+	GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*GetMarketStatusResponse, error)
+	// GetMarketDepth returns the simulated order book of a symbol
+	GetMarketDepth(ctx context.Context, in *GetMarketDepthRequest, opts ...grpc.CallOption) (*GetMarketDepthResponse, error)
+	// StreamMarketDepth streams order book snapshots and incremental updates for subscribed symbols
+	StreamMarketDepth(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamMarketDepthRequest, StreamMarketDepthResponse], error)
+	// GetRecentTrades returns the latest simulated trades of a symbol, newest first
+	GetRecentTrades(ctx context.Context, in *GetRecentTradesRequest, opts ...grpc.CallOption) (*GetRecentTradesResponse, error)
+	// StreamTrades streams the time and sales of subscribed symbols
+	StreamTrades(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamTradesRequest, StreamTradesResponse], error)
+	// GetOptionChain quotes the calls and puts of one expiry of an underlying with their Greeks
+	GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*GetOptionChainResponse, error)
+}
+
+type marketDataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMarketDataServiceClient(cc grpc.ClientConnInterface) MarketDataServiceClient {
+	return &marketDataServiceClient{cc}
+}
+
+func (c *marketDataServiceClient) GetMarketData(ctx context.Context, in *GetMarketDataRequest, opts ...grpc.CallOption) (*GetMarketDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketDataResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetMarketData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetAssetDetails(ctx context.Context, in *GetAssetDetailsRequest, opts ...grpc.CallOption) (*GetAssetDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssetDetailsResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetAssetDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetBatchMarketData(ctx context.Context, in *GetBatchMarketDataRequest, opts ...grpc.CallOption) (*GetBatchMarketDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchMarketDataResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetBatchMarketData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) StreamQuotes(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamQuotesRequest, StreamQuotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[0], MarketDataService_StreamQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamQuotesRequest, StreamQuotesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamQuotesClient = grpc.BidiStreamingClient[StreamQuotesRequest, StreamQuotesResponse]
+
+func (c *marketDataServiceClient) GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*GetMarketStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketStatusResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetMarketStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) GetMarketDepth(ctx context.Context, in *GetMarketDepthRequest, opts ...grpc.CallOption) (*GetMarketDepthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketDepthResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetMarketDepth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) StreamMarketDepth(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamMarketDepthRequest, StreamMarketDepthResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[1], MarketDataService_StreamMarketDepth_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMarketDepthRequest, StreamMarketDepthResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamMarketDepthClient = grpc.BidiStreamingClient[StreamMarketDepthRequest, StreamMarketDepthResponse]
+
+func (c *marketDataServiceClient) GetRecentTrades(ctx context.Context, in *GetRecentTradesRequest, opts ...grpc.CallOption) (*GetRecentTradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentTradesResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetRecentTrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataServiceClient) StreamTrades(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamTradesRequest, StreamTradesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MarketDataService_ServiceDesc.Streams[2], MarketDataService_StreamTrades_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTradesRequest, StreamTradesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamTradesClient = grpc.BidiStreamingClient[StreamTradesRequest, StreamTradesResponse]
+
+func (c *marketDataServiceClient) GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*GetOptionChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOptionChainResponse)
+	err := c.cc.Invoke(ctx, MarketDataService_GetOptionChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketDataServiceServer is the server API for MarketDataService service.
+// All implementations must embed UnimplementedMarketDataServiceServer
+// for forward compatibility.
+//
+// MarketDataService provides market data operations
+type MarketDataServiceServer interface {
+	// GetMarketData retrieves market data for a specific symbol
+	GetMarketData(context.Context, *GetMarketDataRequest) (*GetMarketDataResponse, error)
+	// GetAssetDetails retrieves detailed asset information
+	GetAssetDetails(context.Context, *GetAssetDetailsRequest) (*GetAssetDetailsResponse, error)
+	// GetBatchMarketData retrieves market data for multiple symbols
+	GetBatchMarketData(context.Context, *GetBatchMarketDataRequest) (*GetBatchMarketDataResponse, error)
+	// StreamQuotes streams real-time quote updates for subscribed symbols
+	StreamQuotes(grpc.BidiStreamingServer[StreamQuotesRequest, StreamQuotesResponse]) error
+	// GetMarketStatus returns the trading status of every exchange and of the requested symbols
+	GetMarketStatus(context.Context, *GetMarketStatusRequest) (*GetMarketStatusResponse, error)
+	// GetMarketDepth returns the simulated order book of a symbol
+	GetMarketDepth(context.Context, *GetMarketDepthRequest) (*GetMarketDepthResponse, error)
+	// StreamMarketDepth streams order book snapshots and incremental updates for subscribed symbols
+	StreamMarketDepth(grpc.BidiStreamingServer[StreamMarketDepthRequest, StreamMarketDepthResponse]) error
+	// GetRecentTrades returns the latest simulated trades of a symbol, newest first
+	GetRecentTrades(context.Context, *GetRecentTradesRequest) (*GetRecentTradesResponse, error)
+	// StreamTrades streams the time and sales of subscribed symbols
+	StreamTrades(grpc.BidiStreamingServer[StreamTradesRequest, StreamTradesResponse]) error
+	// GetOptionChain quotes the calls and puts of one expiry of an underlying with their Greeks
+	GetOptionChain(context.Context, *GetOptionChainRequest) (*GetOptionChainResponse, error)
+	mustEmbedUnimplementedMarketDataServiceServer()
+}
+
+// UnimplementedMarketDataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarketDataServiceServer struct{}
+
+func (UnimplementedMarketDataServiceServer) GetMarketData(context.Context, *GetMarketDataRequest) (*GetMarketDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketData not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetAssetDetails(context.Context, *GetAssetDetailsRequest) (*GetAssetDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetDetails not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetBatchMarketData(context.Context, *GetBatchMarketDataRequest) (*GetBatchMarketDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchMarketData not implemented")
+}
+func (UnimplementedMarketDataServiceServer) StreamQuotes(grpc.BidiStreamingServer[StreamQuotesRequest, StreamQuotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuotes not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetMarketStatus(context.Context, *GetMarketStatusRequest) (*GetMarketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketStatus not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetMarketDepth(context.Context, *GetMarketDepthRequest) (*GetMarketDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketDepth not implemented")
+}
+func (UnimplementedMarketDataServiceServer) StreamMarketDepth(grpc.BidiStreamingServer[StreamMarketDepthRequest, StreamMarketDepthResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMarketDepth not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetRecentTrades(context.Context, *GetRecentTradesRequest) (*GetRecentTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+}
+func (UnimplementedMarketDataServiceServer) StreamTrades(grpc.BidiStreamingServer[StreamTradesRequest, StreamTradesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTrades not implemented")
+}
+func (UnimplementedMarketDataServiceServer) GetOptionChain(context.Context, *GetOptionChainRequest) (*GetOptionChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionChain not implemented")
+}
+func (UnimplementedMarketDataServiceServer) mustEmbedUnimplementedMarketDataServiceServer() {}
+func (UnimplementedMarketDataServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMarketDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketDataServiceServer will
+// result in compilation errors.
+type UnsafeMarketDataServiceServer interface {
+	mustEmbedUnimplementedMarketDataServiceServer()
+}
+
+func RegisterMarketDataServiceServer(s grpc.ServiceRegistrar, srv MarketDataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMarketDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MarketDataService_ServiceDesc, srv)
+}
+
+func _MarketDataService_GetMarketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetMarketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetMarketData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetMarketData(ctx, req.(*GetMarketDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetAssetDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetAssetDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetAssetDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetAssetDetails(ctx, req.(*GetAssetDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetBatchMarketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchMarketDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetBatchMarketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetBatchMarketData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetBatchMarketData(ctx, req.(*GetBatchMarketDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_StreamQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MarketDataServiceServer).StreamQuotes(&grpc.GenericServerStream[StreamQuotesRequest, StreamQuotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamQuotesServer = grpc.BidiStreamingServer[StreamQuotesRequest, StreamQuotesResponse]
+
+func _MarketDataService_GetMarketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetMarketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetMarketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetMarketStatus(ctx, req.(*GetMarketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_GetMarketDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetMarketDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetMarketDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetMarketDepth(ctx, req.(*GetMarketDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_StreamMarketDepth_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MarketDataServiceServer).StreamMarketDepth(&grpc.GenericServerStream[StreamMarketDepthRequest, StreamMarketDepthResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamMarketDepthServer = grpc.BidiStreamingServer[StreamMarketDepthRequest, StreamMarketDepthResponse]
+
+func _MarketDataService_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetRecentTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetRecentTrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetRecentTrades(ctx, req.(*GetRecentTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataService_StreamTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MarketDataServiceServer).StreamTrades(&grpc.GenericServerStream[StreamTradesRequest, StreamTradesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MarketDataService_StreamTradesServer = grpc.BidiStreamingServer[StreamTradesRequest, StreamTradesResponse]
+
+func _MarketDataService_GetOptionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataServiceServer).GetOptionChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataService_GetOptionChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataServiceServer).GetOptionChain(ctx, req.(*GetOptionChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MarketDataService_ServiceDesc is the grpc.ServiceDesc for MarketDataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MarketDataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hub_investments.MarketDataService",
+	HandlerType: (*MarketDataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMarketData",
+			Handler:    _MarketDataService_GetMarketData_Handler,
+		},
+		{
+			MethodName: "GetAssetDetails",
+			Handler:    _MarketDataService_GetAssetDetails_Handler,
+		},
+		{
+			MethodName: "GetBatchMarketData",
+			Handler:    _MarketDataService_GetBatchMarketData_Handler,
+		},
+		{
+			MethodName: "GetMarketStatus",
+			Handler:    _MarketDataService_GetMarketStatus_Handler,
+		},
+		{
+			MethodName: "GetMarketDepth",
+			Handler:    _MarketDataService_GetMarketDepth_Handler,
+		},
+		{
+			MethodName: "GetRecentTrades",
+			Handler:    _MarketDataService_GetRecentTrades_Handler,
+		},
+		{
+			MethodName: "GetOptionChain",
+			Handler:    _MarketDataService_GetOptionChain_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQuotes",
+			Handler:       _MarketDataService_StreamQuotes_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamMarketDepth",
+			Handler:       _MarketDataService_StreamMarketDepth_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamTrades",
+			Handler:       _MarketDataService_StreamTrades_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "internal/infrastructure/grpc/proto/market_data.proto",
+}
+
+const (
+	MarketDataAdminService_HaltSymbol_FullMethodName        = "/hub_investments.MarketDataAdminService/HaltSymbol"
+	MarketDataAdminService_ResumeSymbol_FullMethodName      = "/hub_investments.MarketDataAdminService/ResumeSymbol"
+	MarketDataAdminService_ListHalts_FullMethodName         = "/hub_investments.MarketDataAdminService/ListHalts"
+	MarketDataAdminService_StartScenario_FullMethodName     = "/hub_investments.MarketDataAdminService/StartScenario"
+	MarketDataAdminService_StopScenario_FullMethodName      = "/hub_investments.MarketDataAdminService/StopScenario"
+	MarketDataAdminService_GetScenarioStatus_FullMethodName = "/hub_investments.MarketDataAdminService/GetScenarioStatus"
+	MarketDataAdminService_CreateSandbox_FullMethodName     = "/hub_investments.MarketDataAdminService/CreateSandbox"
+	MarketDataAdminService_DestroySandbox_FullMethodName    = "/hub_investments.MarketDataAdminService/DestroySandbox"
+	MarketDataAdminService_ListSandboxes_FullMethodName     = "/hub_investments.MarketDataAdminService/ListSandboxes"
+)
+
+// MarketDataAdminServiceClient is the client API for MarketDataAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MarketDataAdminService controls the simulator. Every RPC requires the admin role.
+type MarketDataAdminServiceClient interface {
+	// HaltSymbol stops price updates for a symbol until it is resumed
+	HaltSymbol(ctx context.Context, in *HaltSymbolRequest, opts ...grpc.CallOption) (*HaltSymbolResponse, error)
+	// ResumeSymbol ends the halt of a symbol, whatever its reason
+	ResumeSymbol(ctx context.Context, in *ResumeSymbolRequest, opts ...grpc.CallOption) (*ResumeSymbolResponse, error)
+	// ListHalts returns every active trading halt
+	ListHalts(ctx context.Context, in *ListHaltsRequest, opts ...grpc.CallOption) (*ListHaltsResponse, error)
+	// StartScenario runs a scenario of the library or a YAML scenario definition, replacing
+	// the running scenario
+	StartScenario(ctx context.Context, in *StartScenarioRequest, opts ...grpc.CallOption) (*StartScenarioResponse, error)
+	// StopScenario ends the running scenario; prices it moved are kept
+	StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*StopScenarioResponse, error)
+	// GetScenarioStatus returns the running scenario and the scenarios of the library
+	GetScenarioStatus(ctx context.Context, in *GetScenarioStatusRequest, opts ...grpc.CallOption) (*GetScenarioStatusResponse, error)
+	// CreateSandbox starts an isolated simulation selected with the x-sandbox metadata key
+	CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc.CallOption) (*CreateSandboxResponse, error)
+	// DestroySandbox stops a sandbox and ends its streams
+	DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc.CallOption) (*DestroySandboxResponse, error)
+	// ListSandboxes returns every running sandbox
+	ListSandboxes(ctx context.Context, in *ListSandboxesRequest, opts ...grpc.CallOption) (*ListSandboxesResponse, error)
+}
+
+type marketDataAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMarketDataAdminServiceClient(cc grpc.ClientConnInterface) MarketDataAdminServiceClient {
+	return &marketDataAdminServiceClient{cc}
+}
+
+func (c *marketDataAdminServiceClient) HaltSymbol(ctx context.Context, in *HaltSymbolRequest, opts ...grpc.CallOption) (*HaltSymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HaltSymbolResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_HaltSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) ResumeSymbol(ctx context.Context, in *ResumeSymbolRequest, opts ...grpc.CallOption) (*ResumeSymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSymbolResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_ResumeSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) ListHalts(ctx context.Context, in *ListHaltsRequest, opts ...grpc.CallOption) (*ListHaltsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHaltsResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_ListHalts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) StartScenario(ctx context.Context, in *StartScenarioRequest, opts ...grpc.CallOption) (*StartScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartScenarioResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_StartScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*StopScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopScenarioResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_StopScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) GetScenarioStatus(ctx context.Context, in *GetScenarioStatusRequest, opts ...grpc.CallOption) (*GetScenarioStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScenarioStatusResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_GetScenarioStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc.CallOption) (*CreateSandboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSandboxResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_CreateSandbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc.CallOption) (*DestroySandboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DestroySandboxResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_DestroySandbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketDataAdminServiceClient) ListSandboxes(ctx context.Context, in *ListSandboxesRequest, opts ...grpc.CallOption) (*ListSandboxesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSandboxesResponse)
+	err := c.cc.Invoke(ctx, MarketDataAdminService_ListSandboxes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketDataAdminServiceServer is the server API for MarketDataAdminService service.
+// All implementations must embed UnimplementedMarketDataAdminServiceServer
+// for forward compatibility.
+//
+// MarketDataAdminService controls the simulator. Every RPC requires the admin role.
+type MarketDataAdminServiceServer interface {
+	// HaltSymbol stops price updates for a symbol until it is resumed
+	HaltSymbol(context.Context, *HaltSymbolRequest) (*HaltSymbolResponse, error)
+	// ResumeSymbol ends the halt of a symbol, whatever its reason
+	ResumeSymbol(context.Context, *ResumeSymbolRequest) (*ResumeSymbolResponse, error)
+	// ListHalts returns every active trading halt
+	ListHalts(context.Context, *ListHaltsRequest) (*ListHaltsResponse, error)
+	// StartScenario runs a scenario of the library or a YAML scenario definition, replacing
+	// the running scenario
+	StartScenario(context.Context, *StartScenarioRequest) (*StartScenarioResponse, error)
+	// StopScenario ends the running scenario; prices it moved are kept
+	StopScenario(context.Context, *StopScenarioRequest) (*StopScenarioResponse, error)
+	// GetScenarioStatus returns the running scenario and the scenarios of the library
+	GetScenarioStatus(context.Context, *GetScenarioStatusRequest) (*GetScenarioStatusResponse, error)
+	// CreateSandbox starts an isolated simulation selected with the x-sandbox metadata key
+	CreateSandbox(context.Context, *CreateSandboxRequest) (*CreateSandboxResponse, error)
+	// DestroySandbox stops a sandbox and ends its streams
+	DestroySandbox(context.Context, *DestroySandboxRequest) (*DestroySandboxResponse, error)
+	// ListSandboxes returns every running sandbox
+	ListSandboxes(context.Context, *ListSandboxesRequest) (*ListSandboxesResponse, error)
+	mustEmbedUnimplementedMarketDataAdminServiceServer()
+}
+
+// UnimplementedMarketDataAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarketDataAdminServiceServer struct{}
+
+func (UnimplementedMarketDataAdminServiceServer) HaltSymbol(context.Context, *HaltSymbolRequest) (*HaltSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltSymbol not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) ResumeSymbol(context.Context, *ResumeSymbolRequest) (*ResumeSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSymbol not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) ListHalts(context.Context, *ListHaltsRequest) (*ListHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHalts not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) StartScenario(context.Context, *StartScenarioRequest) (*StartScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartScenario not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) StopScenario(context.Context, *StopScenarioRequest) (*StopScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopScenario not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) GetScenarioStatus(context.Context, *GetScenarioStatusRequest) (*GetScenarioStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScenarioStatus not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) CreateSandbox(context.Context, *CreateSandboxRequest) (*CreateSandboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSandbox not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) DestroySandbox(context.Context, *DestroySandboxRequest) (*DestroySandboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroySandbox not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) ListSandboxes(context.Context, *ListSandboxesRequest) (*ListSandboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSandboxes not implemented")
+}
+func (UnimplementedMarketDataAdminServiceServer) mustEmbedUnimplementedMarketDataAdminServiceServer() {
+}
+func (UnimplementedMarketDataAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMarketDataAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketDataAdminServiceServer will
+// result in compilation errors.
+type UnsafeMarketDataAdminServiceServer interface {
+	mustEmbedUnimplementedMarketDataAdminServiceServer()
+}
+
+func RegisterMarketDataAdminServiceServer(s grpc.ServiceRegistrar, srv MarketDataAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMarketDataAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MarketDataAdminService_ServiceDesc, srv)
+}
+
+func _MarketDataAdminService_HaltSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).HaltSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_HaltSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).HaltSymbol(ctx, req.(*HaltSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_ResumeSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).ResumeSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_ResumeSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).ResumeSymbol(ctx, req.(*ResumeSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_ListHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).ListHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_ListHalts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).ListHalts(ctx, req.(*ListHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_StartScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).StartScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_StartScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).StartScenario(ctx, req.(*StartScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_StopScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).StopScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_StopScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).StopScenario(ctx, req.(*StopScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_GetScenarioStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScenarioStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).GetScenarioStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_GetScenarioStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).GetScenarioStatus(ctx, req.(*GetScenarioStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).CreateSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_CreateSandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).CreateSandbox(ctx, req.(*CreateSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_DestroySandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroySandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).DestroySandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_DestroySandbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).DestroySandbox(ctx, req.(*DestroySandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketDataAdminService_ListSandboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSandboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketDataAdminServiceServer).ListSandboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketDataAdminService_ListSandboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketDataAdminServiceServer).ListSandboxes(ctx, req.(*ListSandboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MarketDataAdminService_ServiceDesc is the grpc.ServiceDesc for MarketDataAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MarketDataAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hub_investments.MarketDataAdminService",
+	HandlerType: (*MarketDataAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HaltSymbol",
+			Handler:    _MarketDataAdminService_HaltSymbol_Handler,
+		},
+		{
+			MethodName: "ResumeSymbol",
+			Handler:    _MarketDataAdminService_ResumeSymbol_Handler,
+		},
+		{
+			MethodName: "ListHalts",
+			Handler:    _MarketDataAdminService_ListHalts_Handler,
+		},
+		{
+			MethodName: "StartScenario",
+			Handler:    _MarketDataAdminService_StartScenario_Handler,
+		},
+		{
+			MethodName: "StopScenario",
+			Handler:    _MarketDataAdminService_StopScenario_Handler,
+		},
+		{
+			MethodName: "GetScenarioStatus",
+			Handler:    _MarketDataAdminService_GetScenarioStatus_Handler,
+		},
+		{
+			MethodName: "CreateSandbox",
+			Handler:    _MarketDataAdminService_CreateSandbox_Handler,
+		},
+		{
+			MethodName: "DestroySandbox",
+			Handler:    _MarketDataAdminService_DestroySandbox_Handler,
+		},
+		{
+			MethodName: "ListSandboxes",
+			Handler:    _MarketDataAdminService_ListSandboxes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/infrastructure/grpc/proto/market_data.proto",
+}
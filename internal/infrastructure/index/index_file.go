@@ -0,0 +1,112 @@
+package index
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+type indexFile struct {
+	Indices []indexEntry `yaml:"indices"`
+}
+
+type indexEntry struct {
+	Symbol       string             `yaml:"symbol"`
+	Name         string             `yaml:"name"`
+	BaseLevel    string             `yaml:"base_level"`
+	Weighting    string             `yaml:"weighting"`
+	Constituents []constituentEntry `yaml:"constituents"`
+}
+
+type constituentEntry struct {
+	Symbol string `yaml:"symbol"`
+	Weight string `yaml:"weight"`
+}
+
+// LoadIndexFile reads the custom index configuration file
+func LoadIndexFile(path string) ([]model.IndexSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index file %s: %w", path, err)
+	}
+
+	return ParseIndices(data)
+}
+
+// ParseIndices parses the YAML custom index configuration. An entry without a base level
+// defines the holdings of an ETF, valued at its current price.
+func ParseIndices(data []byte) ([]model.IndexSpec, error) {
+	var file indexFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse index file: %w", err)
+	}
+
+	specs := make([]model.IndexSpec, 0, len(file.Indices))
+	seen := make(map[model.Symbol]bool, len(file.Indices))
+	for _, entry := range file.Indices {
+		spec, err := entry.toSpec()
+		if err != nil {
+			return nil, err
+		}
+		if seen[spec.Definition.Symbol] {
+			return nil, fmt.Errorf("index %s is defined twice", spec.Definition.Symbol)
+		}
+		seen[spec.Definition.Symbol] = true
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+func (e indexEntry) toSpec() (model.IndexSpec, error) {
+	symbol, err := model.ParseSymbol(e.Symbol)
+	if err != nil {
+		return model.IndexSpec{}, err
+	}
+
+	weighting, err := model.ParseIndexWeighting(e.Weighting)
+	if err != nil {
+		return model.IndexSpec{}, fmt.Errorf("index %s: %w", symbol, err)
+	}
+
+	spec := model.IndexSpec{
+		Definition: model.IndexDefinition{Symbol: symbol, Name: e.Name},
+		Weighting:  weighting,
+	}
+	if e.BaseLevel != "" {
+		if spec.BaseLevel, err = decimal.NewFromString(e.BaseLevel); err != nil || !spec.BaseLevel.IsPositive() {
+			return model.IndexSpec{}, fmt.Errorf("index %s: invalid base_level %q", symbol, e.BaseLevel)
+		}
+	}
+
+	if len(e.Constituents) == 0 {
+		return model.IndexSpec{}, fmt.Errorf("index %s has no constituents", symbol)
+	}
+	for _, constituent := range e.Constituents {
+		constituentSymbol, err := model.ParseSymbol(constituent.Symbol)
+		if err != nil {
+			return model.IndexSpec{}, fmt.Errorf("index %s: %w", symbol, err)
+		}
+
+		weight := decimal.NewFromInt(1)
+		if constituent.Weight != "" {
+			if weight, err = decimal.NewFromString(constituent.Weight); err != nil {
+				return model.IndexSpec{}, fmt.Errorf("index %s: invalid weight %q of %s", symbol, constituent.Weight, constituentSymbol)
+			}
+		}
+		spec.Definition.Constituents = append(spec.Definition.Constituents, model.IndexConstituent{
+			Symbol: constituentSymbol,
+			Weight: weight,
+		})
+	}
+
+	// Weights, duplicates and self references are checked without prices
+	definition := spec.Definition
+	definition.Divisor = decimal.NewFromInt(1)
+	if err := definition.Validate(); err != nil {
+		return model.IndexSpec{}, err
+	}
+	return spec, nil
+}
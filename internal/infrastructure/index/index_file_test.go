@@ -0,0 +1,83 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIndices(t *testing.T) {
+	// Arrange
+	data := []byte(`
+indices:
+  - symbol: chips
+    name: Chip Makers
+    base_level: 1000
+    weighting: percent
+    constituents:
+      - {symbol: nvda, weight: 60}
+      - {symbol: AAPL, weight: 40}
+  - symbol: XLK
+    constituents:
+      - {symbol: AAPL}
+      - {symbol: MSFT, weight: 2}
+`)
+
+	// Act
+	specs, err := ParseIndices(data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, model.Symbol("CHIPS"), specs[0].Definition.Symbol)
+	assert.Equal(t, model.IndexWeightingPercent, specs[0].Weighting)
+	assert.Equal(t, "1000", specs[0].BaseLevel.String())
+	assert.Equal(t, model.Symbol("NVDA"), specs[0].Definition.Constituents[0].Symbol)
+	assert.Equal(t, "60", specs[0].Definition.Constituents[0].Weight.String())
+
+	assert.Equal(t, model.IndexWeightingUnits, specs[1].Weighting)
+	assert.True(t, specs[1].BaseLevel.IsZero())
+	assert.Equal(t, "1", specs[1].Definition.Constituents[0].Weight.String())
+}
+
+func TestParseIndices_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"invalid symbol":        `{indices: [{symbol: "AA PL", constituents: [{symbol: AAPL}]}]}`,
+		"unknown weighting":     `{indices: [{symbol: IDX, weighting: cap, constituents: [{symbol: AAPL}]}]}`,
+		"negative base level":   `{indices: [{symbol: IDX, base_level: -1, constituents: [{symbol: AAPL}]}]}`,
+		"no constituents":       `{indices: [{symbol: IDX, base_level: 100}]}`,
+		"invalid weight":        `{indices: [{symbol: IDX, constituents: [{symbol: AAPL, weight: x}]}]}`,
+		"zero weight":           `{indices: [{symbol: IDX, constituents: [{symbol: AAPL, weight: 0}]}]}`,
+		"duplicate constituent": `{indices: [{symbol: IDX, constituents: [{symbol: AAPL}, {symbol: aapl}]}]}`,
+		"defined twice":         `{indices: [{symbol: IDX, constituents: [{symbol: AAPL}]}, {symbol: IDX, constituents: [{symbol: MSFT}]}]}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := ParseIndices([]byte(data))
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadIndexFile_ShippedIndices(t *testing.T) {
+	// Arrange
+	assetDataService := service.NewAssetDataService(time.Now())
+
+	// Act
+	specs, err := LoadIndexFile("../../../deployments/indices/custom_indices.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, specs)
+	for _, spec := range specs {
+		assert.NoError(t, assetDataService.RegisterIndex(spec), spec.Definition.Symbol)
+	}
+}
@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
+)
+
+const corporateActionColumns = `id, symbol, action_type, ex_date, split_from, split_to, dividend_amount, new_symbol`
+
+type CorporateActionRepository struct {
+	db database.Database
+}
+
+func NewCorporateActionRepository(db database.Database) repository.ICorporateActionRepository {
+	return &CorporateActionRepository{db: db}
+}
+
+// GetPendingCorporateActions returns the actions not applied yet whose ex date is at or
+// before through, in the order they take effect
+func (r *CorporateActionRepository) GetPendingCorporateActions(through time.Time) ([]model.CorporateAction, error) {
+	query := `SELECT ` + corporateActionColumns + `
+		FROM corporate_actions
+		WHERE applied_at IS NULL AND ex_date <= $1
+		ORDER BY ex_date, id`
+
+	return r.selectCorporateActions(query, through.Format("2006-01-02"))
+}
+
+// GetAppliedSymbolChanges returns the symbol changes already applied, in the order they took
+// effect, so the simulated assets can be renamed again on startup
+func (r *CorporateActionRepository) GetAppliedSymbolChanges() ([]model.CorporateAction, error) {
+	query := `SELECT ` + corporateActionColumns + `
+		FROM corporate_actions
+		WHERE applied_at IS NOT NULL AND action_type = $1
+		ORDER BY ex_date, id`
+
+	return r.selectCorporateActions(query, model.CorporateActionSymbolChange.String())
+}
+
+func (r *CorporateActionRepository) selectCorporateActions(query string, args ...interface{}) ([]model.CorporateAction, error) {
+	var rows []dto.CorporateActionDTO
+	if err := r.db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch corporate actions: %w", err)
+	}
+
+	actions := make([]model.CorporateAction, len(rows))
+	for i, row := range rows {
+		actions[i] = dto.ToCorporateActionDomain(row)
+	}
+
+	return actions, nil
+}
+
+// ApplyCorporateAction back-adjusts the session closes before the ex date and the saved quote
+// state of the symbol by the price and size factors of the action, moves them to its new
+// symbol on a symbol change, and marks the action applied. It is a single statement that
+// first claims the pending action, and only adjusts the history when the claim succeeds, so
+// an action is applied entirely or not at all, and never twice.
+func (r *CorporateActionRepository) ApplyCorporateAction(action model.CorporateAction, appliedAt time.Time) error {
+	if action.Type == model.CorporateActionSymbolChange {
+		if err := r.checkSymbolUnused(action); err != nil {
+			return err
+		}
+	}
+
+	query := `WITH claimed AS (
+			UPDATE corporate_actions SET price_factor = $4, applied_at = $7
+			WHERE id = $1 AND applied_at IS NULL
+			RETURNING id
+		), adjusted_closes AS (
+			UPDATE session_closes SET
+				symbol = $3,
+				close_price = ROUND(close_price * $4, 8),
+				close_bid = ROUND(close_bid * $4, 8),
+				close_ask = ROUND(close_ask * $4, 8),
+				close_bid_size = ROUND(close_bid_size * $5::NUMERIC),
+				close_ask_size = ROUND(close_ask_size * $5::NUMERIC)
+			WHERE symbol = $2 AND session_date < $6 AND EXISTS (SELECT 1 FROM claimed)
+		), adjusted_states AS (
+			UPDATE quote_states SET
+				symbol = $3,
+				current_price = ROUND(current_price * $4, 8),
+				base_price = ROUND(base_price * $4, 8),
+				open_price = ROUND(open_price * $4, 8),
+				high_price = ROUND(high_price * $4, 8),
+				low_price = ROUND(low_price * $4, 8),
+				previous_close = ROUND(previous_close * $4, 8),
+				volume = ROUND(volume * $5::NUMERIC),
+				turnover = ROUND(turnover * $4 * $5::NUMERIC, 8),
+				vwap = ROUND(vwap * $4, 10)
+			WHERE symbol = $2 AND EXISTS (SELECT 1 FROM claimed)
+		)
+		SELECT id FROM claimed`
+
+	var claimed []int64
+	if err := r.db.Select(&claimed, query, action.ID, action.Symbol, action.TargetSymbol(), action.PriceFactor,
+		action.SizeFactor(), action.ExDate.Format("2006-01-02"), appliedAt); err != nil {
+		return fmt.Errorf("failed to apply %s of %s: %w", action.Type, action.Symbol, err)
+	}
+	if len(claimed) == 0 {
+		return fmt.Errorf("corporate action %d is unknown or already applied", action.ID)
+	}
+
+	return nil
+}
+
+// checkSymbolUnused rejects a symbol change whose new symbol already has stored closes or a
+// saved quote state, which the renamed history would collide with
+func (r *CorporateActionRepository) checkSymbolUnused(action model.CorporateAction) error {
+	query := `SELECT 'session_closes' FROM session_closes WHERE symbol = $1
+		UNION ALL SELECT 'quote_states' FROM quote_states WHERE symbol = $1
+		LIMIT 1`
+
+	var tables []string
+	if err := r.db.Select(&tables, query, action.NewSymbol); err != nil {
+		return fmt.Errorf("failed to check the history of %s: %w", action.NewSymbol, err)
+	}
+	if len(tables) > 0 {
+		return fmt.Errorf("%w: %s cannot be renamed to %s, which already has %s rows",
+			model.ErrInvalidCorporateAction, action.Symbol, action.NewSymbol, tables[0])
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorporateActionRepository_GetPendingCorporateActions(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewCorporateActionRepository(mockDB)
+	rows := []dto.CorporateActionDTO{
+		{ID: 7, Symbol: "AAPL", ActionType: "SPLIT", ExDate: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), SplitFrom: 1, SplitTo: 4},
+		{ID: 8, Symbol: "JPM", ActionType: "CASH_DIVIDEND", ExDate: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), DividendAmount: decimal.RequireFromString("1.15")},
+	}
+
+	mockDB.On("Select", mock.AnythingOfType("*[]dto.CorporateActionDTO"), mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "applied_at IS NULL AND ex_date <= $1") && strings.Contains(query, "ORDER BY ex_date, id")
+	}), []interface{}{"2026-03-11"}).Return(nil, rows)
+
+	// Act
+	actions, err := repo.GetPendingCorporateActions(time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.Equal(t, int64(7), actions[0].ID)
+	assert.Equal(t, model.CorporateActionSplit, actions[0].Type)
+	assert.Equal(t, int64(4), actions[0].SplitTo)
+	assert.Equal(t, "2026-03-11", actions[0].ExDate.Format("2006-01-02"))
+	assert.Equal(t, model.CorporateActionCashDividend, actions[1].Type)
+	assert.Equal(t, "1.15", actions[1].DividendAmount.String())
+	mockDB.AssertExpectations(t)
+}
+
+func TestCorporateActionRepository_ApplyCorporateAction(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewCorporateActionRepository(mockDB)
+	appliedAt := time.Date(2026, 3, 10, 20, 0, 0, 0, time.UTC)
+	action := model.CorporateAction{
+		ID: 7, Symbol: "AAPL", Type: model.CorporateActionSplit, ExDate: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+		SplitFrom: 1, SplitTo: 4, PriceFactor: decimal.RequireFromString("0.25"),
+	}
+
+	mockDB.On("Select", mock.AnythingOfType("*[]int64"), mock.MatchedBy(func(query string) bool {
+		return strings.HasPrefix(strings.TrimSpace(query), "WITH claimed AS (") &&
+			strings.Contains(query, "WHERE id = $1 AND applied_at IS NULL") &&
+			strings.Contains(query, "WHERE symbol = $2 AND session_date < $6 AND EXISTS (SELECT 1 FROM claimed)") &&
+			strings.Contains(query, "WHERE symbol = $2 AND EXISTS (SELECT 1 FROM claimed)") &&
+			strings.Contains(query, "volume = ROUND(volume * $5::NUMERIC)")
+	}), []interface{}{
+		int64(7), "AAPL", "AAPL", action.PriceFactor, action.SizeFactor(), "2026-03-11", appliedAt,
+	}).Return(nil, []int64{7})
+
+	// Act
+	err := repo.ApplyCorporateAction(action, appliedAt)
+
+	// Assert
+	assert.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestCorporateActionRepository_ApplyCorporateAction_Twice(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewCorporateActionRepository(mockDB)
+	appliedAt := time.Date(2026, 3, 10, 20, 0, 0, 0, time.UTC)
+	action := model.CorporateAction{
+		ID: 7, Symbol: "AAPL", Type: model.CorporateActionSplit, ExDate: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+		SplitFrom: 1, SplitTo: 4, PriceFactor: decimal.RequireFromString("0.25"),
+	}
+
+	// The claim only returns the action while it is pending, and the adjustments only run with it
+	mockDB.On("Select", mock.AnythingOfType("*[]int64"), mock.Anything, mock.Anything).Return(nil, []int64{7}).Once()
+	mockDB.On("Select", mock.AnythingOfType("*[]int64"), mock.Anything, mock.Anything).Return(nil, []int64{}).Once()
+
+	// Act
+	firstErr := repo.ApplyCorporateAction(action, appliedAt)
+	secondErr := repo.ApplyCorporateAction(action, appliedAt)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	require.Error(t, secondErr)
+	assert.Contains(t, secondErr.Error(), "corporate action 7 is unknown or already applied")
+	mockDB.AssertNumberOfCalls(t, "Select", 2)
+	mockDB.AssertExpectations(t)
+}
+
+func TestCorporateActionRepository_ApplyCorporateAction_SymbolInUse(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewCorporateActionRepository(mockDB)
+	action := model.CorporateAction{
+		ID: 9, Symbol: "FB", Type: model.CorporateActionSymbolChange, NewSymbol: "META", PriceFactor: decimal.NewFromInt(1),
+	}
+
+	mockDB.On("Select", mock.AnythingOfType("*[]string"), mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "FROM session_closes WHERE symbol = $1") && strings.Contains(query, "FROM quote_states WHERE symbol = $1")
+	}), []interface{}{"META"}).Return(nil, []string{"session_closes"})
+
+	// Act
+	err := repo.ApplyCorporateAction(action, time.Now())
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, model.ErrInvalidCorporateAction)
+	assert.Contains(t, err.Error(), "FB cannot be renamed to META, which already has session_closes rows")
+	mockDB.AssertNotCalled(t, "Select", mock.AnythingOfType("*[]int64"), mock.Anything, mock.Anything)
+	mockDB.AssertExpectations(t)
+}
+
+func TestCorporateActionRepository_ApplyCorporateAction_Errors(t *testing.T) {
+	action := model.CorporateAction{
+		ID: 9, Symbol: "FB", Type: model.CorporateActionSymbolChange, NewSymbol: "META", PriceFactor: decimal.NewFromInt(1),
+	}
+
+	tests := map[string]struct {
+		claimed  []int64
+		err      error
+		expected string
+	}{
+		"database error":  {nil, errors.New("connection refused"), "failed to apply SYMBOL_CHANGE of FB"},
+		"already applied": {[]int64{}, nil, "corporate action 9 is unknown or already applied"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mockDB := &MockDatabase{}
+			repo := NewCorporateActionRepository(mockDB)
+			mockDB.On("Select", mock.AnythingOfType("*[]string"), mock.Anything, mock.Anything).Return(nil, []string{})
+			mockDB.On("Select", mock.AnythingOfType("*[]int64"), mock.Anything, mock.Anything).Return(test.err, test.claimed)
+
+			// Act
+			err := repo.ApplyCorporateAction(action, time.Now())
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
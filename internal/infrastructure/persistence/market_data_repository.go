@@ -10,23 +10,56 @@ import (
 	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
 )
 
+// DefaultQueryChunkSize bounds the number of symbols sent in a single IN (...) query
+const DefaultQueryChunkSize = 100
+
 type MarketDataRepository struct {
-	db     database.Database
-	mapper *dto.MarketDataMapper
+	db             database.Database
+	mapper         *dto.MarketDataMapper
+	queryChunkSize int
 }
 
 func NewMarketDataRepository(db database.Database) repository.IMarketDataRepository {
-	return &MarketDataRepository{db: db, mapper: dto.NewMarketDataMapper()}
+	return NewMarketDataRepositoryWithChunkSize(db, DefaultQueryChunkSize)
+}
+
+// NewMarketDataRepositoryWithChunkSize creates a repository that splits large symbol lists
+// into several queries of at most queryChunkSize symbols
+func NewMarketDataRepositoryWithChunkSize(db database.Database, queryChunkSize int) repository.IMarketDataRepository {
+	if queryChunkSize <= 0 {
+		queryChunkSize = DefaultQueryChunkSize
+	}
+
+	return &MarketDataRepository{db: db, mapper: dto.NewMarketDataMapper(), queryChunkSize: queryChunkSize}
+}
+
+func (m *MarketDataRepository) GetMarketData(symbols []model.Symbol) ([]model.MarketDataModel, error) {
+	if len(symbols) <= m.queryChunkSize {
+		return m.getMarketDataChunk(symbols)
+	}
+
+	result := make([]model.MarketDataModel, 0, len(symbols))
+	for start := 0; start < len(symbols); start += m.queryChunkSize {
+		end := min(start+m.queryChunkSize, len(symbols))
+
+		chunk, err := m.getMarketDataChunk(symbols[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, chunk...)
+	}
+
+	return result, nil
 }
 
-func (m *MarketDataRepository) GetMarketData(symbols []string) ([]model.MarketDataModel, error) {
+func (m *MarketDataRepository) getMarketDataChunk(symbols []model.Symbol) ([]model.MarketDataModel, error) {
 	// Create placeholders for the IN clause
 	placeholders := make([]string, len(symbols))
 	args := make([]interface{}, len(symbols))
 
 	for i, symbol := range symbols {
 		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = symbol
+		args[i] = symbol.String()
 	}
 
 	query := fmt.Sprintf("SELECT * FROM market_data WHERE symbol IN (%s)",
@@ -9,9 +9,12 @@ import (
 	"testing"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
 	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockDatabase is a mock implementation of the database interface
@@ -79,6 +82,26 @@ func (m *MockDatabase) Select(dest interface{}, query string, args ...interface{
 			destSlice := dest.(*[]dto.MarketDataDTO)
 			*destSlice = dtos
 		}
+		if dtos, ok := callArgs.Get(1).([]dto.SessionCloseDTO); ok {
+			destSlice := dest.(*[]dto.SessionCloseDTO)
+			*destSlice = dtos
+		}
+		if dtos, ok := callArgs.Get(1).([]dto.QuoteStateDTO); ok {
+			destSlice := dest.(*[]dto.QuoteStateDTO)
+			*destSlice = dtos
+		}
+		if dtos, ok := callArgs.Get(1).([]dto.CorporateActionDTO); ok {
+			destSlice := dest.(*[]dto.CorporateActionDTO)
+			*destSlice = dtos
+		}
+		if ids, ok := callArgs.Get(1).([]int64); ok {
+			destSlice := dest.(*[]int64)
+			*destSlice = ids
+		}
+		if values, ok := callArgs.Get(1).([]string); ok {
+			destSlice := dest.(*[]string)
+			*destSlice = values
+		}
 	}
 
 	return callArgs.Error(0)
@@ -111,11 +134,11 @@ func TestMarketDataRepository_GetMarketData_Success(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"AAPL", "GOOGL", "MSFT"}
+	symbols := []model.Symbol{"AAPL", "GOOGL", "MSFT"}
 	expectedDTOs := []dto.MarketDataDTO{
-		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
-		{Id: 2, Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: 2650.75, Category: 1},
-		{Id: 3, Symbol: "MSFT", Name: "Microsoft Corporation", LastQuote: 285.25, Category: 1},
+		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: "STOCK"},
+		{Id: 2, Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: decimal.RequireFromString("2650.75"), AssetClass: "STOCK"},
+		{Id: 3, Symbol: "MSFT", Name: "Microsoft Corporation", LastQuote: decimal.RequireFromString("285.25"), AssetClass: "STOCK"},
 	}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2,$3)"
@@ -141,8 +164,8 @@ func TestMarketDataRepository_GetMarketData_Success(t *testing.T) {
 	// Verify the domain models are correctly mapped
 	assert.Equal(t, "AAPL", result[0].Symbol)
 	assert.Equal(t, "Apple Inc.", result[0].Name)
-	assert.Equal(t, float32(155.50), result[0].LastQuote)
-	assert.Equal(t, 1, result[0].Category)
+	assert.Equal(t, "155.50", result[0].LastQuote.StringFixed(2))
+	assert.Equal(t, model.AssetClassStock, result[0].AssetClass)
 
 	assert.Equal(t, "GOOGL", result[1].Symbol)
 	assert.Equal(t, "MSFT", result[2].Symbol)
@@ -153,9 +176,9 @@ func TestMarketDataRepository_GetMarketData_SingleSymbol(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"AAPL"}
+	symbols := []model.Symbol{"AAPL"}
 	expectedDTOs := []dto.MarketDataDTO{
-		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
+		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: "STOCK"},
 	}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1)"
@@ -179,8 +202,8 @@ func TestMarketDataRepository_GetMarketData_SingleSymbol(t *testing.T) {
 	assert.Equal(t, 1, len(result))
 	assert.Equal(t, "AAPL", result[0].Symbol)
 	assert.Equal(t, "Apple Inc.", result[0].Name)
-	assert.Equal(t, float32(155.50), result[0].LastQuote)
-	assert.Equal(t, 1, result[0].Category)
+	assert.Equal(t, "155.50", result[0].LastQuote.StringFixed(2))
+	assert.Equal(t, model.AssetClassStock, result[0].AssetClass)
 }
 
 func TestMarketDataRepository_GetMarketData_EmptySymbols(t *testing.T) {
@@ -188,7 +211,7 @@ func TestMarketDataRepository_GetMarketData_EmptySymbols(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{}
+	symbols := []model.Symbol{}
 	expectedDTOs := []dto.MarketDataDTO{}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ()"
@@ -212,12 +235,53 @@ func TestMarketDataRepository_GetMarketData_EmptySymbols(t *testing.T) {
 	assert.Equal(t, 0, len(result))
 }
 
+func TestMarketDataRepository_GetMarketData_ChunkedQueries(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	defer mockDB.AssertExpectations(t)
+
+	symbols := []model.Symbol{"AAPL", "GOOGL", "MSFT", "TSLA", "NVDA"}
+
+	mockDB.On("Select",
+		mock.AnythingOfType("*[]dto.MarketDataDTO"),
+		"SELECT * FROM market_data WHERE symbol IN ($1,$2)",
+		[]interface{}{"AAPL", "GOOGL"},
+	).Return(nil, []dto.MarketDataDTO{
+		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: "STOCK"},
+	}).Once()
+	mockDB.On("Select",
+		mock.AnythingOfType("*[]dto.MarketDataDTO"),
+		"SELECT * FROM market_data WHERE symbol IN ($1,$2)",
+		[]interface{}{"MSFT", "TSLA"},
+	).Return(nil, []dto.MarketDataDTO{
+		{Id: 3, Symbol: "MSFT", Name: "Microsoft Corporation", LastQuote: decimal.RequireFromString("285.25"), AssetClass: "STOCK"},
+		{Id: 4, Symbol: "TSLA", Name: "Tesla Inc.", LastQuote: decimal.RequireFromString("248.75"), AssetClass: "STOCK"},
+	}).Once()
+	mockDB.On("Select",
+		mock.AnythingOfType("*[]dto.MarketDataDTO"),
+		"SELECT * FROM market_data WHERE symbol IN ($1)",
+		[]interface{}{"NVDA"},
+	).Return(nil, []dto.MarketDataDTO{}).Once()
+
+	repo := NewMarketDataRepositoryWithChunkSize(mockDB, 2)
+
+	// Act
+	result, err := repo.GetMarketData(symbols)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(result))
+	assert.Equal(t, "AAPL", result[0].Symbol)
+	assert.Equal(t, "MSFT", result[1].Symbol)
+	assert.Equal(t, "TSLA", result[2].Symbol)
+}
+
 func TestMarketDataRepository_GetMarketData_DatabaseError(t *testing.T) {
 	// Arrange
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"AAPL", "GOOGL"}
+	symbols := []model.Symbol{"AAPL", "GOOGL"}
 	databaseError := errors.New("connection lost")
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2)"
@@ -248,7 +312,7 @@ func TestMarketDataRepository_GetMarketData_NoDataFound(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"INVALID", "NOTFOUND"}
+	symbols := []model.Symbol{"INVALID", "NOTFOUND"}
 	expectedDTOs := []dto.MarketDataDTO{} // Empty result
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2)"
@@ -277,10 +341,10 @@ func TestMarketDataRepository_GetMarketData_PartialDataFound(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"AAPL", "INVALID", "GOOGL"}
+	symbols := []model.Symbol{"AAPL", "INVALID", "GOOGL"}
 	expectedDTOs := []dto.MarketDataDTO{
-		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},
-		{Id: 2, Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: 2650.75, Category: 1},
+		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: "STOCK"},
+		{Id: 2, Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: decimal.RequireFromString("2650.75"), AssetClass: "STOCK"},
 	}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2,$3)"
@@ -311,11 +375,11 @@ func TestMarketDataRepository_GetMarketData_DifferentCategories(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"AAPL", "VOO", "BTC"}
+	symbols := []model.Symbol{"AAPL", "VOO", "BTC"}
 	expectedDTOs := []dto.MarketDataDTO{
-		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 155.50, Category: 1},          // Stock
-		{Id: 2, Symbol: "VOO", Name: "Vanguard S&P 500 ETF", LastQuote: 385.25, Category: 2}, // ETF
-		{Id: 3, Symbol: "BTC", Name: "Bitcoin", LastQuote: 45000.00, Category: 3},            // Crypto
+		{Id: 1, Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("155.50"), AssetClass: "STOCK"},        // Stock
+		{Id: 2, Symbol: "VOO", Name: "Vanguard S&P 500 ETF", LastQuote: decimal.RequireFromString("385.25"), AssetClass: "ETF"}, // ETF
+		{Id: 3, Symbol: "BTC", Name: "Bitcoin", LastQuote: decimal.RequireFromString("45000.00"), AssetClass: "CRYPTO"},         // Crypto
 	}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2,$3)"
@@ -339,9 +403,9 @@ func TestMarketDataRepository_GetMarketData_DifferentCategories(t *testing.T) {
 	assert.Equal(t, 3, len(result))
 
 	// Verify different categories
-	assert.Equal(t, 1, result[0].Category) // Stock
-	assert.Equal(t, 2, result[1].Category) // ETF
-	assert.Equal(t, 3, result[2].Category) // Crypto
+	assert.Equal(t, model.AssetClassStock, result[0].AssetClass)  // Stock
+	assert.Equal(t, model.AssetClassETF, result[1].AssetClass)    // ETF
+	assert.Equal(t, model.AssetClassCrypto, result[2].AssetClass) // Crypto
 }
 
 func TestMarketDataRepository_GetMarketData_LargeSymbolList(t *testing.T) {
@@ -350,20 +414,20 @@ func TestMarketDataRepository_GetMarketData_LargeSymbolList(t *testing.T) {
 	defer mockDB.AssertExpectations(t)
 
 	// Create 50 symbols
-	symbols := make([]string, 50)
+	symbols := make([]model.Symbol, 50)
 	expectedDTOs := make([]dto.MarketDataDTO, 50)
 	expectedArgs := make([]interface{}, 50)
 
 	for i := 0; i < 50; i++ {
 		symbol := "SYM" + fmt.Sprintf("%02d", i)
-		symbols[i] = symbol
+		symbols[i] = model.Symbol(symbol)
 		expectedArgs[i] = symbol
 		expectedDTOs[i] = dto.MarketDataDTO{
-			Id:        i + 1,
-			Symbol:    symbol,
-			Name:      "Company " + symbol,
-			LastQuote: float32(100.0 + float64(i)),
-			Category:  1,
+			Id:         i + 1,
+			Symbol:     symbol,
+			Name:       "Company " + symbol,
+			LastQuote:  decimal.NewFromInt(int64(100 + i)),
+			AssetClass: "STOCK",
 		}
 	}
 
@@ -401,11 +465,11 @@ func TestMarketDataRepository_GetMarketData_SpecialCharacters(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"BRK.B", "BRK.A", "SPY"}
+	symbols := []model.Symbol{"BRK.B", "BRK.A", "SPY"}
 	expectedDTOs := []dto.MarketDataDTO{
-		{Id: 1, Symbol: "BRK.B", Name: "Berkshire Hathaway Inc. Class B", LastQuote: 275.50, Category: 1},
-		{Id: 2, Symbol: "BRK.A", Name: "Berkshire Hathaway Inc. Class A", LastQuote: 415000.00, Category: 1},
-		{Id: 3, Symbol: "SPY", Name: "SPDR S&P 500 ETF Trust", LastQuote: 420.75, Category: 2},
+		{Id: 1, Symbol: "BRK.B", Name: "Berkshire Hathaway Inc. Class B", LastQuote: decimal.RequireFromString("275.50"), AssetClass: "STOCK"},
+		{Id: 2, Symbol: "BRK.A", Name: "Berkshire Hathaway Inc. Class A", LastQuote: decimal.RequireFromString("415000.00"), AssetClass: "STOCK"},
+		{Id: 3, Symbol: "SPY", Name: "SPDR S&P 500 ETF Trust", LastQuote: decimal.RequireFromString("420.75"), AssetClass: "ETF"},
 	}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ($1,$2,$3)"
@@ -435,25 +499,25 @@ func TestMarketDataRepository_GetMarketData_QueryGeneration(t *testing.T) {
 	// Arrange - Test that the SQL query is generated correctly for different input sizes
 	testCases := []struct {
 		name          string
-		symbols       []string
+		symbols       []model.Symbol
 		expectedQuery string
 		expectedArgs  []interface{}
 	}{
 		{
 			name:          "single symbol",
-			symbols:       []string{"AAPL"},
+			symbols:       []model.Symbol{"AAPL"},
 			expectedQuery: "SELECT * FROM market_data WHERE symbol IN ($1)",
 			expectedArgs:  []interface{}{"AAPL"},
 		},
 		{
 			name:          "two symbols",
-			symbols:       []string{"AAPL", "GOOGL"},
+			symbols:       []model.Symbol{"AAPL", "GOOGL"},
 			expectedQuery: "SELECT * FROM market_data WHERE symbol IN ($1,$2)",
 			expectedArgs:  []interface{}{"AAPL", "GOOGL"},
 		},
 		{
 			name:          "three symbols",
-			symbols:       []string{"AAPL", "GOOGL", "MSFT"},
+			symbols:       []model.Symbol{"AAPL", "GOOGL", "MSFT"},
 			expectedQuery: "SELECT * FROM market_data WHERE symbol IN ($1,$2,$3)",
 			expectedArgs:  []interface{}{"AAPL", "GOOGL", "MSFT"},
 		},
@@ -467,11 +531,11 @@ func TestMarketDataRepository_GetMarketData_QueryGeneration(t *testing.T) {
 			expectedDTOs := make([]dto.MarketDataDTO, len(tc.symbols))
 			for i, symbol := range tc.symbols {
 				expectedDTOs[i] = dto.MarketDataDTO{
-					Id:        i + 1,
-					Symbol:    symbol,
-					Name:      "Company " + symbol,
-					LastQuote: float32(100.0 + float64(i)),
-					Category:  1,
+					Id:         i + 1,
+					Symbol:     symbol.String(),
+					Name:       "Company " + symbol.String(),
+					LastQuote:  decimal.NewFromInt(int64(100 + i)),
+					AssetClass: "STOCK",
 				}
 			}
 
@@ -499,14 +563,14 @@ func TestMarketDataRepository_GetMarketData_DataMapping(t *testing.T) {
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	symbols := []string{"TEST"}
+	symbols := []model.Symbol{"TEST"}
 	expectedDTOs := []dto.MarketDataDTO{
 		{
-			Id:        123,
-			Symbol:    "TEST",
-			Name:      "Test Company Inc.",
-			LastQuote: 99.99,
-			Category:  2,
+			Id:         123,
+			Symbol:     "TEST",
+			Name:       "Test Company Inc.",
+			LastQuote:  decimal.RequireFromString("99.99"),
+			AssetClass: "ETF",
 		},
 	}
 
@@ -534,18 +598,88 @@ func TestMarketDataRepository_GetMarketData_DataMapping(t *testing.T) {
 	domainModel := result[0]
 	assert.Equal(t, "TEST", domainModel.Symbol)
 	assert.Equal(t, "Test Company Inc.", domainModel.Name)
-	assert.Equal(t, float32(99.99), domainModel.LastQuote)
-	assert.Equal(t, 2, domainModel.Category)
+	assert.Equal(t, "99.99", domainModel.LastQuote.StringFixed(2))
+	assert.Equal(t, model.AssetClassETF, domainModel.AssetClass)
+	assert.Equal(t, int32(2), domainModel.PricePrecision)
 
 	// Note: ID field is not mapped to domain model as it's not part of MarketDataModel
 }
 
+func TestMarketDataRepository_GetMarketData_PricePrecision(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	defer mockDB.AssertExpectations(t)
+
+	mockDB.On("Select",
+		mock.AnythingOfType("*[]dto.MarketDataDTO"),
+		"SELECT * FROM market_data WHERE symbol IN ($1,$2)",
+		[]interface{}{"EURUSD", "BTC-USD"},
+	).Return(nil, []dto.MarketDataDTO{
+		{Id: 1, Symbol: "EURUSD", Name: "Euro / US Dollar", LastQuote: decimal.RequireFromString("1.08456000"), PricePrecision: 5, AssetClass: "FX"},
+		{Id: 2, Symbol: "BTC-USD", Name: "Bitcoin", LastQuote: decimal.RequireFromString("64250.123456"), AssetClass: "CRYPTO"},
+	})
+
+	repo := NewMarketDataRepository(mockDB)
+
+	// Act
+	result, err := repo.GetMarketData([]model.Symbol{"EURUSD", "BTC-USD"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, int32(5), result[0].PricePrecision)
+	assert.Equal(t, "1.08456", model.FormatPrice(result[0].LastQuote, result[0].PricePrecision))
+	// Rows without a stored precision fall back to the asset class default
+	assert.Equal(t, int32(4), result[1].PricePrecision)
+	assert.Equal(t, "64250.1235", model.FormatPrice(result[1].LastQuote, result[1].PricePrecision))
+}
+
+func TestMarketDataRepository_GetMarketData_InstrumentMetadata(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	defer mockDB.AssertExpectations(t)
+
+	mockDB.On("Select",
+		mock.AnythingOfType("*[]dto.MarketDataDTO"),
+		"SELECT * FROM market_data WHERE symbol IN ($1,$2)",
+		[]interface{}{"ES", "SAP.DE"},
+	).Return(nil, []dto.MarketDataDTO{
+		{
+			Id: 1, Symbol: "ES", Name: "E-mini S&P 500", LastQuote: decimal.RequireFromString("5123.37"), PricePrecision: 2,
+			AssetClass: "INDEX", TickSize: decimal.RequireFromString("0.25"), LotSize: 1, Currency: "USD",
+		},
+		{
+			Id: 2, Symbol: "SAP.DE", Name: "SAP SE", LastQuote: decimal.RequireFromString("180.123"), PricePrecision: 2,
+			AssetClass: "STOCK", TickSize: decimal.RequireFromString("0.001"), Currency: "eur",
+		},
+	})
+
+	repo := NewMarketDataRepository(mockDB)
+
+	// Act
+	result, err := repo.GetMarketData([]model.Symbol{"ES", "SAP.DE"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "0.25", result[0].TickSize.String())
+	assert.Equal(t, "5123.25", result[0].FormatPrice(result[0].LastQuote))
+	assert.Equal(t, int64(1), result[0].LotSize)
+
+	// A tick finer than the precision, a missing lot size and an invalid currency fall back
+	// to the asset class defaults
+	assert.Equal(t, "0.01", result[1].TickSize.String())
+	assert.Equal(t, int64(100), result[1].LotSize)
+	assert.Equal(t, model.DefaultCurrency, result[1].Currency)
+	assert.Equal(t, "180.12", result[1].FormatPrice(result[1].LastQuote))
+}
+
 func TestMarketDataRepository_GetMarketData_NilSymbols(t *testing.T) {
 	// Arrange
 	mockDB := &MockDatabase{}
 	defer mockDB.AssertExpectations(t)
 
-	var symbols []string = nil
+	var symbols []model.Symbol = nil
 	expectedDTOs := []dto.MarketDataDTO{}
 
 	expectedQuery := "SELECT * FROM market_data WHERE symbol IN ()"
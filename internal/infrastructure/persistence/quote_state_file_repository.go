@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+)
+
+// QuoteStateFileRepository keeps the quote states in a local JSON file, for deployments
+// that run the simulator without Postgres
+type QuoteStateFileRepository struct {
+	path string
+}
+
+func NewQuoteStateFileRepository(path string) repository.IQuoteStateRepository {
+	return &QuoteStateFileRepository{path: path}
+}
+
+// SaveQuoteStates replaces the file with the states. The file is written next to the
+// previous one and renamed over it, so a crash while saving keeps the previous snapshot.
+func (r *QuoteStateFileRepository) SaveQuoteStates(states []model.QuoteState) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	rows := make([]dto.QuoteStateDTO, len(states))
+	for i, state := range states {
+		rows[i] = dto.ToQuoteStateDTO(state)
+	}
+
+	data, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %d quote states: %w", len(states), err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create quote state directory: %w", err)
+	}
+
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write quote states: %w", err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("failed to replace quote states: %w", err)
+	}
+
+	return nil
+}
+
+// GetQuoteStates returns the states of the file, or none when it does not exist yet
+func (r *QuoteStateFileRepository) GetQuoteStates() ([]model.QuoteState, error) {
+	data, err := os.ReadFile(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read quote states: %w", err)
+	}
+
+	var rows []dto.QuoteStateDTO
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse quote states %s: %w", r.path, err)
+	}
+
+	states := make([]model.QuoteState, len(rows))
+	for i, row := range rows {
+		states[i] = dto.ToQuoteStateDomain(row)
+	}
+
+	return states, nil
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
+)
+
+// quoteStateColumns is the number of values written per state
+const quoteStateColumns = 12
+
+type QuoteStateRepository struct {
+	db database.Database
+}
+
+func NewQuoteStateRepository(db database.Database) repository.IQuoteStateRepository {
+	return &QuoteStateRepository{db: db}
+}
+
+// SaveQuoteStates upserts the states in a single statement, so a snapshot is persisted
+// entirely or not at all
+func (r *QuoteStateRepository) SaveQuoteStates(states []model.QuoteState) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(states))
+	args := make([]interface{}, 0, len(states)*quoteStateColumns)
+
+	for i, state := range states {
+		placeholders := make([]string, quoteStateColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", i*quoteStateColumns+j+1)
+		}
+		values[i] = "(" + strings.Join(placeholders, ", ") + ")"
+		args = append(args, state.Symbol, state.SessionDate.Format("2006-01-02"), state.CurrentPrice, state.BasePrice,
+			state.OpenPrice, state.HighPrice, state.LowPrice, state.PreviousClose,
+			state.Volume, state.Turnover, state.VWAP, state.UpdatedAt)
+	}
+
+	query := fmt.Sprintf(`INSERT INTO quote_states
+		(symbol, session_date, current_price, base_price, open_price, high_price, low_price,
+			previous_close, volume, turnover, vwap, updated_at) VALUES %s
+		ON CONFLICT (symbol) DO UPDATE SET
+			session_date = EXCLUDED.session_date,
+			current_price = EXCLUDED.current_price,
+			base_price = EXCLUDED.base_price,
+			open_price = EXCLUDED.open_price,
+			high_price = EXCLUDED.high_price,
+			low_price = EXCLUDED.low_price,
+			previous_close = EXCLUDED.previous_close,
+			volume = EXCLUDED.volume,
+			turnover = EXCLUDED.turnover,
+			vwap = EXCLUDED.vwap,
+			updated_at = EXCLUDED.updated_at`,
+		strings.Join(values, ","))
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("failed to save %d quote states: %w", len(states), err)
+	}
+
+	return nil
+}
+
+// GetQuoteStates returns the saved state of every symbol
+func (r *QuoteStateRepository) GetQuoteStates() ([]model.QuoteState, error) {
+	query := `SELECT symbol, session_date, current_price, base_price,
+			open_price, high_price, low_price, previous_close, volume, turnover, vwap, updated_at
+		FROM quote_states ORDER BY symbol`
+
+	var rows []dto.QuoteStateDTO
+	if err := r.db.Select(&rows, query); err != nil {
+		return nil, fmt.Errorf("failed to fetch quote states: %w", err)
+	}
+
+	states := make([]model.QuoteState, len(rows))
+	for i, row := range rows {
+		states[i] = dto.ToQuoteStateDomain(row)
+	}
+
+	return states, nil
+}
@@ -0,0 +1,123 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQuoteState() model.QuoteState {
+	return model.QuoteState{
+		Symbol:        "AAPL",
+		SessionDate:   time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		CurrentPrice:  decimal.RequireFromString("176.25"),
+		BasePrice:     decimal.RequireFromString("176"),
+		OpenPrice:     decimal.RequireFromString("175.5"),
+		HighPrice:     decimal.RequireFromString("177"),
+		LowPrice:      decimal.RequireFromString("175"),
+		PreviousClose: decimal.RequireFromString("175.5"),
+		Volume:        1200,
+		Turnover:      decimal.RequireFromString("211350"),
+		VWAP:          decimal.RequireFromString("176.125"),
+		UpdatedAt:     time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestQuoteStateRepository_SaveQuoteStates(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewQuoteStateRepository(mockDB)
+	state := newTestQuoteState()
+
+	mockDB.On("Exec", mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "INSERT INTO quote_states") &&
+			strings.Contains(query, "($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)") &&
+			strings.Contains(query, "ON CONFLICT (symbol) DO UPDATE")
+	}), []interface{}{
+		"AAPL", "2026-03-10", state.CurrentPrice, state.BasePrice, state.OpenPrice, state.HighPrice, state.LowPrice,
+		state.PreviousClose, int64(1200), state.Turnover, state.VWAP, state.UpdatedAt,
+	}).Return(driver.RowsAffected(1), nil)
+
+	// Act
+	err := repo.SaveQuoteStates([]model.QuoteState{state})
+
+	// Assert
+	assert.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestQuoteStateRepository_SaveQuoteStates_DatabaseError(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewQuoteStateRepository(mockDB)
+	mockDB.On("Exec", mock.Anything, mock.Anything).Return(driver.RowsAffected(0), errors.New("connection refused"))
+
+	// Act
+	err := repo.SaveQuoteStates([]model.QuoteState{newTestQuoteState()})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to save 1 quote states")
+}
+
+func TestQuoteStateRepository_GetQuoteStates(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewQuoteStateRepository(mockDB)
+	rows := []dto.QuoteStateDTO{dto.ToQuoteStateDTO(newTestQuoteState())}
+
+	mockDB.On("Select", mock.AnythingOfType("*[]dto.QuoteStateDTO"), mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "FROM quote_states")
+	}), mock.Anything).Return(nil, rows)
+
+	// Act
+	states, err := repo.GetQuoteStates()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, newTestQuoteState(), states[0])
+}
+
+func TestQuoteStateFileRepository_RoundTrip(t *testing.T) {
+	// Arrange
+	repo := NewQuoteStateFileRepository(filepath.Join(t.TempDir(), "state", "simulator_state.json"))
+	state := newTestQuoteState()
+
+	// Act
+	err := repo.SaveQuoteStates([]model.QuoteState{state})
+	require.NoError(t, err)
+	states, err := repo.GetQuoteStates()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, "AAPL", states[0].Symbol)
+	assert.Equal(t, state.SessionDate, states[0].SessionDate)
+	assert.True(t, states[0].CurrentPrice.Equal(state.CurrentPrice))
+	assert.True(t, states[0].VWAP.Equal(state.VWAP))
+	assert.Equal(t, int64(1200), states[0].Volume)
+	assert.True(t, states[0].UpdatedAt.Equal(state.UpdatedAt))
+}
+
+func TestQuoteStateFileRepository_MissingFile(t *testing.T) {
+	// Arrange
+	repo := NewQuoteStateFileRepository(filepath.Join(t.TempDir(), "missing.json"))
+
+	// Act
+	states, err := repo.GetQuoteStates()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, states)
+}
@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+)
+
+// SessionCloseMemoryRepository keeps the latest close of every symbol in memory, for
+// simulations that roll their sessions over without persisting them, like sandboxes
+type SessionCloseMemoryRepository struct {
+	mu     sync.Mutex
+	closes map[string]model.SessionClose
+}
+
+func NewSessionCloseMemoryRepository() repository.ISessionCloseRepository {
+	return &SessionCloseMemoryRepository{closes: make(map[string]model.SessionClose)}
+}
+
+// SaveSessionCloses keeps each close unless a later session of its symbol is already kept
+func (r *SessionCloseMemoryRepository) SaveSessionCloses(closes []model.SessionClose) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, close := range closes {
+		if kept, exists := r.closes[close.Symbol]; exists && kept.SessionDate.After(close.SessionDate) {
+			continue
+		}
+		r.closes[close.Symbol] = close
+	}
+	return nil
+}
+
+// GetLatestSessionCloses returns the most recent close of every symbol, by symbol
+func (r *SessionCloseMemoryRepository) GetLatestSessionCloses() ([]model.SessionClose, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	closes := make([]model.SessionClose, 0, len(r.closes))
+	for _, close := range r.closes {
+		closes = append(closes, close)
+	}
+	sort.Slice(closes, func(i, j int) bool { return closes[i].Symbol < closes[j].Symbol })
+	return closes, nil
+}
@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCloseMemoryRepository_KeepsLatestCloses(t *testing.T) {
+	// Arrange
+	repo := NewSessionCloseMemoryRepository()
+	monday := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	// Act
+	require.NoError(t, repo.SaveSessionCloses([]model.SessionClose{
+		{Symbol: "MSFT", SessionDate: tuesday, ClosePrice: decimal.RequireFromString("410.20")},
+		{Symbol: "AAPL", SessionDate: tuesday, ClosePrice: decimal.RequireFromString("175.50")},
+	}))
+	require.NoError(t, repo.SaveSessionCloses([]model.SessionClose{
+		{Symbol: "AAPL", SessionDate: monday, ClosePrice: decimal.RequireFromString("174.10")},
+	}))
+	closes, err := repo.GetLatestSessionCloses()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, closes, 2)
+	assert.Equal(t, "AAPL", closes[0].Symbol)
+	assert.Equal(t, "175.5", closes[0].ClosePrice.String())
+	assert.Equal(t, "MSFT", closes[1].Symbol)
+}
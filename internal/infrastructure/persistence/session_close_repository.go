@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/repository"
+	"github.com/RodriguesYan/hub-market-data-service/pkg/database"
+)
+
+// sessionCloseColumns is the number of values written per close
+const sessionCloseColumns = 7
+
+type SessionCloseRepository struct {
+	db database.Database
+}
+
+func NewSessionCloseRepository(db database.Database) repository.ISessionCloseRepository {
+	return &SessionCloseRepository{db: db}
+}
+
+// SaveSessionCloses upserts the closes in a single statement, so a rollover is persisted
+// entirely or not at all
+func (r *SessionCloseRepository) SaveSessionCloses(closes []model.SessionClose) error {
+	if len(closes) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(closes))
+	args := make([]interface{}, 0, len(closes)*sessionCloseColumns)
+
+	for i, close := range closes {
+		placeholders := make([]string, sessionCloseColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", i*sessionCloseColumns+j+1)
+		}
+		values[i] = "(" + strings.Join(placeholders, ", ") + ")"
+		args = append(args, close.Symbol, close.SessionDate.Format("2006-01-02"), close.ClosePrice,
+			close.Bid, close.Ask, close.BidSize, close.AskSize)
+	}
+
+	query := fmt.Sprintf(`INSERT INTO session_closes
+		(symbol, session_date, close_price, close_bid, close_ask, close_bid_size, close_ask_size) VALUES %s
+		ON CONFLICT (symbol, session_date) DO UPDATE SET
+			close_price = EXCLUDED.close_price,
+			close_bid = EXCLUDED.close_bid,
+			close_ask = EXCLUDED.close_ask,
+			close_bid_size = EXCLUDED.close_bid_size,
+			close_ask_size = EXCLUDED.close_ask_size`,
+		strings.Join(values, ","))
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("failed to save %d session closes: %w", len(closes), err)
+	}
+
+	return nil
+}
+
+// GetLatestSessionCloses returns the most recent close of every symbol
+func (r *SessionCloseRepository) GetLatestSessionCloses() ([]model.SessionClose, error) {
+	query := `SELECT DISTINCT ON (symbol) symbol, session_date, close_price,
+			close_bid, close_ask, close_bid_size, close_ask_size
+		FROM session_closes ORDER BY symbol, session_date DESC`
+
+	var rows []dto.SessionCloseDTO
+	if err := r.db.Select(&rows, query); err != nil {
+		return nil, fmt.Errorf("failed to fetch session closes: %w", err)
+	}
+
+	closes := make([]model.SessionClose, len(rows))
+	for i, row := range rows {
+		closes[i] = dto.ToSessionCloseDomain(row)
+	}
+
+	return closes, nil
+}
@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/dto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCloseRepository_SaveSessionCloses(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewSessionCloseRepository(mockDB)
+	sessionDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	closes := []model.SessionClose{
+		{
+			Symbol: "AAPL", SessionDate: sessionDate, ClosePrice: decimal.RequireFromString("175.50"),
+			Bid: decimal.RequireFromString("175.48"), Ask: decimal.RequireFromString("175.52"), BidSize: 300, AskSize: 500,
+		},
+		{Symbol: "EURUSD", SessionDate: sessionDate, ClosePrice: decimal.RequireFromString("1.08457")},
+	}
+
+	mockDB.On("Exec", mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "INSERT INTO session_closes") &&
+			strings.Contains(query, "close_bid, close_ask, close_bid_size, close_ask_size") &&
+			strings.Contains(query, "($1, $2, $3, $4, $5, $6, $7),($8, $9, $10, $11, $12, $13, $14)") &&
+			strings.Contains(query, "ON CONFLICT (symbol, session_date)")
+	}), []interface{}{
+		"AAPL", "2026-03-10", closes[0].ClosePrice, closes[0].Bid, closes[0].Ask, int64(300), int64(500),
+		"EURUSD", "2026-03-10", closes[1].ClosePrice, closes[1].Bid, closes[1].Ask, int64(0), int64(0),
+	}).Return(driver.RowsAffected(2), nil)
+
+	// Act
+	err := repo.SaveSessionCloses(closes)
+
+	// Assert
+	assert.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSessionCloseRepository_SaveSessionCloses_Empty(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewSessionCloseRepository(mockDB)
+
+	// Act
+	err := repo.SaveSessionCloses(nil)
+
+	// Assert
+	assert.NoError(t, err)
+	mockDB.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything)
+}
+
+func TestSessionCloseRepository_SaveSessionCloses_DatabaseError(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewSessionCloseRepository(mockDB)
+	closes := []model.SessionClose{{Symbol: "AAPL", SessionDate: time.Now(), ClosePrice: decimal.NewFromInt(1)}}
+
+	mockDB.On("Exec", mock.Anything, mock.Anything).Return(driver.RowsAffected(0), errors.New("connection refused"))
+
+	// Act
+	err := repo.SaveSessionCloses(closes)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to save 1 session closes")
+}
+
+func TestSessionCloseRepository_GetLatestSessionCloses(t *testing.T) {
+	// Arrange
+	mockDB := &MockDatabase{}
+	repo := NewSessionCloseRepository(mockDB)
+	rows := []dto.SessionCloseDTO{
+		{
+			Symbol: "AAPL", SessionDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), ClosePrice: decimal.RequireFromString("175.50"),
+			Bid: decimal.RequireFromString("175.48"), Ask: decimal.RequireFromString("175.52"), BidSize: 300, AskSize: 500,
+		},
+	}
+
+	mockDB.On("Select", mock.AnythingOfType("*[]dto.SessionCloseDTO"), mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, "DISTINCT ON (symbol)")
+	}), mock.Anything).Return(nil, rows)
+
+	// Act
+	closes, err := repo.GetLatestSessionCloses()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, closes, 1)
+	assert.Equal(t, "AAPL", closes[0].Symbol)
+	assert.Equal(t, "2026-03-10", closes[0].SessionDate.Format("2006-01-02"))
+	assert.True(t, closes[0].ClosePrice.Equal(decimal.RequireFromString("175.5")))
+	assert.True(t, closes[0].Bid.Equal(decimal.RequireFromString("175.48")))
+	assert.True(t, closes[0].Ask.Equal(decimal.RequireFromString("175.52")))
+	assert.Equal(t, int64(500), closes[0].AskSize)
+}
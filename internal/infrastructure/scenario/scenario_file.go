@@ -0,0 +1,145 @@
+package scenario
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+type scenarioFile struct {
+	Name        string       `yaml:"name"`
+	Description string       `yaml:"description"`
+	Events      []eventEntry `yaml:"events"`
+}
+
+type eventEntry struct {
+	At           string   `yaml:"at"`
+	Type         string   `yaml:"type"`
+	Symbols      []string `yaml:"symbols"`
+	AssetClasses []string `yaml:"asset_classes"`
+	Factor       string   `yaml:"factor"`
+	Percent      float64  `yaml:"percent"`
+	Duration     string   `yaml:"duration"`
+	Multiplier   float64  `yaml:"multiplier"`
+	Message      string   `yaml:"message"`
+}
+
+// LoadScenarioFile reads a scenario file
+func LoadScenarioFile(path string) (model.Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return model.Scenario{}, fmt.Errorf("failed to read scenario file %s: %w", path, err)
+	}
+
+	scenario, err := ParseScenario(data)
+	if err != nil {
+		return model.Scenario{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return scenario, nil
+}
+
+// LoadScenarioDir reads every .yaml and .yml scenario file of dir, keyed by scenario name
+func LoadScenarioDir(dir string) (map[string]model.Scenario, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario directory %s: %w", dir, err)
+	}
+
+	scenarios := make(map[string]model.Scenario)
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+			continue
+		}
+
+		scenario, err := LoadScenarioFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := scenarios[scenario.Name]; exists {
+			return nil, fmt.Errorf("duplicate scenario %s in %s", scenario.Name, dir)
+		}
+		scenarios[scenario.Name] = scenario
+	}
+
+	return scenarios, nil
+}
+
+// ParseScenario parses a YAML scenario. Event times and durations use Go duration syntax,
+// e.g. "90s" or "5m".
+func ParseScenario(data []byte) (model.Scenario, error) {
+	var file scenarioFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return model.Scenario{}, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	scenario := model.Scenario{
+		Name:        file.Name,
+		Description: file.Description,
+		Events:      make([]model.ScenarioEvent, len(file.Events)),
+	}
+	for i, entry := range file.Events {
+		event, err := entry.toEvent()
+		if err != nil {
+			return model.Scenario{}, fmt.Errorf("scenario %s event %d: %w", file.Name, i+1, err)
+		}
+		scenario.Events[i] = event
+	}
+
+	if err := scenario.Validate(); err != nil {
+		return model.Scenario{}, err
+	}
+	return scenario, nil
+}
+
+func (e eventEntry) toEvent() (model.ScenarioEvent, error) {
+	event := model.ScenarioEvent{
+		Type:       model.ScenarioEventType(strings.ToUpper(strings.TrimSpace(e.Type))),
+		Target:     model.ScenarioTarget{Factor: e.Factor},
+		Percent:    e.Percent,
+		Multiplier: e.Multiplier,
+		Message:    e.Message,
+	}
+
+	var err error
+	if event.At, err = parseDuration("at", e.At); err != nil {
+		return model.ScenarioEvent{}, err
+	}
+	if event.Duration, err = parseDuration("duration", e.Duration); err != nil {
+		return model.ScenarioEvent{}, err
+	}
+
+	for _, rawSymbol := range e.Symbols {
+		symbol, err := model.ParseSymbol(rawSymbol)
+		if err != nil {
+			return model.ScenarioEvent{}, err
+		}
+		event.Target.Symbols = append(event.Target.Symbols, symbol.String())
+	}
+	for _, rawClass := range e.AssetClasses {
+		assetClass, err := model.ParseAssetClass(rawClass)
+		if err != nil {
+			return model.ScenarioEvent{}, err
+		}
+		event.Target.AssetClasses = append(event.Target.AssetClasses, assetClass)
+	}
+
+	return event, nil
+}
+
+func parseDuration(field, raw string) (time.Duration, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", field, raw)
+	}
+	return duration, nil
+}
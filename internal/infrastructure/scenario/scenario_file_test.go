@@ -0,0 +1,90 @@
+package scenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScenario(t *testing.T) {
+	// Arrange
+	data := []byte(`
+name: gap
+description: AAPL gaps up
+events:
+  - at: 1m
+    type: resume
+    symbols: [aapl]
+  - at: 30s
+    type: shock
+    symbols: [aapl]
+    percent: 8
+  - at: 1m
+    type: drift
+    asset_classes: [etf]
+    percent: -2
+    duration: 5m
+`)
+
+	// Act
+	scenario, err := ParseScenario(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "gap", scenario.Name)
+	require.Len(t, scenario.Events, 3)
+
+	shock := scenario.Events[0]
+	assert.Equal(t, model.ScenarioEventShock, shock.Type)
+	assert.Equal(t, 30*time.Second, shock.At)
+	assert.Equal(t, []string{"AAPL"}, shock.Target.Symbols)
+	assert.Equal(t, 8.0, shock.Percent)
+
+	// Events at the same time keep their file order
+	assert.Equal(t, model.ScenarioEventResume, scenario.Events[1].Type)
+	drift := scenario.Events[2]
+	assert.Equal(t, []model.AssetClass{model.AssetClassETF}, drift.Target.AssetClasses)
+	assert.Equal(t, 5*time.Minute, drift.Duration)
+	assert.Equal(t, 6*time.Minute, scenario.Length())
+}
+
+func TestParseScenario_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no name":             `events: [{type: shock, percent: 1}]`,
+		"no events":           `name: empty`,
+		"unknown type":        `{name: s, events: [{type: explode}]}`,
+		"bad time":            `{name: s, events: [{at: soon, type: shock}]}`,
+		"invalid symbol":      `{name: s, events: [{type: halt, symbols: ["AA PL"]}]}`,
+		"unknown asset class": `{name: s, events: [{type: halt, asset_classes: [WARRANT]}]}`,
+		"two targets":         `{name: s, events: [{type: shock, symbols: [AAPL], factor: MARKET}]}`,
+		"drift without time":  `{name: s, events: [{type: drift, percent: 5}]}`,
+		"zero multiplier":     `{name: s, events: [{type: volatility}]}`,
+		"halt a factor":       `{name: s, events: [{type: halt, factor: MARKET}]}`,
+		"negative prices":     `{name: s, events: [{type: shock, percent: -100}]}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := ParseScenario([]byte(data))
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadScenarioDir_ShippedScenarios(t *testing.T) {
+	// Act
+	scenarios, err := LoadScenarioDir("../../../deployments/scenarios")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, scenarios, "market_crash")
+	assert.Contains(t, scenarios, "market_rally")
+	assert.Contains(t, scenarios, "earnings_gap")
+	assert.Contains(t, scenarios, "volatility_spike")
+}
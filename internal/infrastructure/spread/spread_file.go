@@ -0,0 +1,117 @@
+package spread
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+type spreadFile struct {
+	AssetClasses map[string]spreadEntry `yaml:"asset_classes"`
+	Symbols      map[string]spreadEntry `yaml:"symbols"`
+}
+
+type spreadEntry struct {
+	SpreadBps   string `yaml:"spread_bps"`
+	MinTicks    int64  `yaml:"min_ticks"`
+	MaxWidening string `yaml:"max_widening"`
+	MaxLots     int64  `yaml:"max_lots"`
+
+	// LotSize is no longer a spread setting; lot sizes are instrument metadata
+	LotSize int64 `yaml:"lot_size"`
+}
+
+// Spreads is the content of a spread model file. Every model is a partial override: unset
+// fields keep the default of the asset class.
+type Spreads struct {
+	AssetClasses map[model.AssetClass]model.SpreadModel
+	Symbols      map[string]model.SpreadModel
+}
+
+// LoadSpreadFile reads the spread model configuration file
+func LoadSpreadFile(path string) (*Spreads, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spread file %s: %w", path, err)
+	}
+
+	return ParseSpreads(data)
+}
+
+// ParseSpreads parses the YAML spread model configuration
+func ParseSpreads(data []byte) (*Spreads, error) {
+	var file spreadFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse spread file: %w", err)
+	}
+
+	spreads := &Spreads{
+		AssetClasses: make(map[model.AssetClass]model.SpreadModel, len(file.AssetClasses)),
+		Symbols:      make(map[string]model.SpreadModel, len(file.Symbols)),
+	}
+
+	for rawClass, entry := range file.AssetClasses {
+		assetClass, err := model.ParseAssetClass(rawClass)
+		if err != nil {
+			return nil, err
+		}
+		spreadModel, err := entry.toSpreadModel()
+		if err != nil {
+			return nil, fmt.Errorf("asset class %s: %w", assetClass, err)
+		}
+		spreads.AssetClasses[assetClass] = spreadModel
+	}
+
+	for rawSymbol, entry := range file.Symbols {
+		symbol, err := model.ParseSymbol(rawSymbol)
+		if err != nil {
+			return nil, err
+		}
+		spreadModel, err := entry.toSpreadModel()
+		if err != nil {
+			return nil, fmt.Errorf("symbol %s: %w", symbol, err)
+		}
+		spreads.Symbols[symbol.String()] = spreadModel
+	}
+
+	return spreads, nil
+}
+
+func (e spreadEntry) toSpreadModel() (model.SpreadModel, error) {
+	spreadModel := model.SpreadModel{
+		MinTicks: e.MinTicks,
+		MaxLots:  e.MaxLots,
+	}
+	if e.LotSize != 0 {
+		return model.SpreadModel{}, errors.New("lot_size is set by the instrument, remove it from the spread model")
+	}
+	if e.MinTicks < 0 || e.MaxLots < 0 {
+		return model.SpreadModel{}, errors.New("min_ticks and max_lots must not be negative")
+	}
+
+	var err error
+	if spreadModel.SpreadBps, err = parseNonNegative("spread_bps", e.SpreadBps); err != nil {
+		return model.SpreadModel{}, err
+	}
+	if spreadModel.MaxWidening, err = parseNonNegative("max_widening", e.MaxWidening); err != nil {
+		return model.SpreadModel{}, err
+	}
+
+	return spreadModel, nil
+}
+
+func parseNonNegative(field, raw string) (decimal.Decimal, error) {
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+
+	value, err := decimal.NewFromString(raw)
+	if err != nil || value.IsNegative() {
+		return decimal.Zero, fmt.Errorf("invalid %s %q", field, raw)
+	}
+	return value, nil
+}
@@ -0,0 +1,68 @@
+package spread
+
+import (
+	"testing"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpreads(t *testing.T) {
+	// Arrange
+	data := []byte(`
+asset_classes:
+  stock:
+    spread_bps: 3
+    max_lots: 10
+symbols:
+  tsla:
+    spread_bps: 6.5
+    min_ticks: 2
+`)
+
+	// Act
+	spreads, err := ParseSpreads(data)
+
+	// Assert
+	require.NoError(t, err)
+	stock := spreads.AssetClasses[model.AssetClassStock]
+	assert.Equal(t, "3", stock.SpreadBps.String())
+	assert.Equal(t, int64(10), stock.MaxLots)
+	assert.Zero(t, stock.MinTicks)
+
+	tesla := spreads.Symbols["TSLA"]
+	assert.Equal(t, "6.5", tesla.SpreadBps.String())
+	assert.Equal(t, int64(2), tesla.MinTicks)
+}
+
+func TestParseSpreads_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown asset class":   `asset_classes: {WARRANT: {spread_bps: 1}}`,
+		"invalid symbol":        `symbols: {"AA PL": {spread_bps: 1}}`,
+		"bad spread":            `symbols: {AAPL: {spread_bps: wide}}`,
+		"negative spread":       `symbols: {AAPL: {spread_bps: -1}}`,
+		"negative max lots":     `asset_classes: {STOCK: {max_lots: -5}}`,
+		"negative max widening": `asset_classes: {STOCK: {max_widening: -0.5}}`,
+		"lot size":              `asset_classes: {STOCK: {lot_size: 100}}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := ParseSpreads([]byte(data))
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadSpreadFile_ShippedModels(t *testing.T) {
+	// Act
+	spreads, err := LoadSpreadFile("../../../deployments/spreads/spread_models.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, spreads.AssetClasses)
+}
@@ -0,0 +1,63 @@
+package volatility
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+type volatilityFile struct {
+	DefaultVolatility *float64           `yaml:"default_volatility"`
+	Skew              *float64           `yaml:"skew"`
+	TermSlope         *float64           `yaml:"term_slope"`
+	RiskFreeRate      *float64           `yaml:"risk_free_rate"`
+	Underlyings       map[string]float64 `yaml:"underlyings"`
+}
+
+// LoadVolatilityFile reads the volatility surface configuration file
+func LoadVolatilityFile(path string) (model.VolatilitySurface, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return model.VolatilitySurface{}, fmt.Errorf("failed to read volatility file %s: %w", path, err)
+	}
+
+	return ParseVolatilitySurface(data)
+}
+
+// ParseVolatilitySurface parses the YAML volatility surface configuration. Fields it does not
+// set keep the value of model.DefaultVolatilitySurface.
+func ParseVolatilitySurface(data []byte) (model.VolatilitySurface, error) {
+	var file volatilityFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return model.VolatilitySurface{}, fmt.Errorf("failed to parse volatility file: %w", err)
+	}
+
+	surface := model.DefaultVolatilitySurface()
+	if file.DefaultVolatility != nil {
+		surface.DefaultVolatility = *file.DefaultVolatility
+	}
+	if file.Skew != nil {
+		surface.Skew = *file.Skew
+	}
+	if file.TermSlope != nil {
+		surface.TermSlope = *file.TermSlope
+	}
+	if file.RiskFreeRate != nil {
+		surface.RiskFreeRate = *file.RiskFreeRate
+	}
+
+	for rawSymbol, volatility := range file.Underlyings {
+		symbol, err := model.ParseSymbol(rawSymbol)
+		if err != nil {
+			return model.VolatilitySurface{}, err
+		}
+		surface.Underlyings[symbol.String()] = volatility
+	}
+
+	if err := surface.Validate(); err != nil {
+		return model.VolatilitySurface{}, err
+	}
+	return surface, nil
+}
@@ -0,0 +1,58 @@
+package volatility
+
+import (
+	"testing"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVolatilitySurface(t *testing.T) {
+	// Arrange
+	data := []byte(`
+default_volatility: 0.25
+skew: -0.2
+underlyings:
+  tsla: 0.6
+`)
+
+	// Act
+	surface, err := ParseVolatilitySurface(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, surface.DefaultVolatility)
+	assert.Equal(t, -0.2, surface.Skew)
+	assert.Equal(t, model.DefaultVolatilitySurface().TermSlope, surface.TermSlope)
+	assert.Equal(t, model.DefaultVolatilitySurface().RiskFreeRate, surface.RiskFreeRate)
+	assert.Equal(t, map[string]float64{"TSLA": 0.6}, surface.Underlyings)
+}
+
+func TestParseVolatilitySurface_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not yaml":                   `default_volatility: [`,
+		"invalid symbol":             `{underlyings: {"AA PL": 0.3}}`,
+		"zero default volatility":    `{default_volatility: 0}`,
+		"negative symbol volatility": `{underlyings: {AAPL: -0.1}}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := ParseVolatilitySurface([]byte(data))
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadVolatilityFile_ShippedSurface(t *testing.T) {
+	// Act
+	surface, err := LoadVolatilityFile("../../../deployments/volatility/volatility_surface.yaml")
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, surface.Underlyings)
+}
@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var publicMethodPrefixes = []string{
+	"/grpc.reflection.",
+	"/grpc.health.",
+}
+
+// adminMethodPrefixes are only callable by principals with the admin role
+var adminMethodPrefixes = []string{
+	"/hub_investments.MarketDataAdminService/",
+}
+
+// AssetResolver looks up the asset a symbol belongs to, used to enforce asset type entitlements
+type AssetResolver interface {
+	GetAssetBySymbol(symbol string) (model.AssetQuote, bool)
+}
+
+type singleSymbolRequest interface {
+	GetSymbol() string
+}
+
+type multiSymbolRequest interface {
+	GetSymbols() []string
+}
+
+type actionRequest interface {
+	GetAction() string
+}
+
+// AuthInterceptor authenticates every call and enforces the symbol and asset type
+// entitlements of the caller on any request carrying symbols
+type AuthInterceptor struct {
+	authenticator *auth.Authenticator
+	assetResolver AssetResolver
+}
+
+func NewAuthInterceptor(authenticator *auth.Authenticator, assetResolver AssetResolver) *AuthInterceptor {
+	return &AuthInterceptor{
+		authenticator: authenticator,
+		assetResolver: assetResolver,
+	}
+}
+
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		principal, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorizeMethod(principal, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		if err := i.authorize(principal, req); err != nil {
+			return nil, err
+		}
+
+		return handler(auth.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		principal, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		if err := authorizeMethod(principal, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedServerStream{
+			ServerStream: ss,
+			ctx:          auth.WithPrincipal(ss.Context(), principal),
+			principal:    principal,
+			interceptor:  i,
+		})
+	}
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context, method string) (*auth.Principal, error) {
+	principal, err := i.authenticator.Authenticate(ctx)
+	if err != nil {
+		log.Printf("Authentication failed for %s: %v", method, err)
+		return nil, status.Error(codes.Unauthenticated, "invalid or missing credentials")
+	}
+
+	return principal, nil
+}
+
+func authorizeMethod(principal *auth.Principal, method string) error {
+	if hasMethodPrefix(method, adminMethodPrefixes) && !principal.HasRole(auth.RoleAdmin) {
+		log.Printf("Client %s without the admin role called %s", principal.ClientID, method)
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("client %s is not allowed to call %s", principal.ClientID, method))
+	}
+	return nil
+}
+
+func (i *AuthInterceptor) authorize(principal *auth.Principal, req interface{}) error {
+	if action, ok := req.(actionRequest); ok && action.GetAction() == "unsubscribe" {
+		return nil
+	}
+
+	for _, rawSymbol := range requestedSymbols(req) {
+		// Malformed symbols are rejected by the handlers, there is nothing to authorize
+		symbol, err := model.ParseSymbol(rawSymbol)
+		if err != nil {
+			continue
+		}
+		if err := i.authorizeSymbol(principal, symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *AuthInterceptor) authorizeSymbol(principal *auth.Principal, symbol model.Symbol) error {
+	entitlements := principal.Entitlements
+
+	if !entitlements.AllowsSymbol(symbol.String()) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("client %s is not entitled to symbol %s", principal.ClientID, symbol))
+	}
+
+	if !entitlements.RestrictsAssetTypes() {
+		return nil
+	}
+
+	asset, exists := i.assetResolver.GetAssetBySymbol(symbol.String())
+	if !exists || !entitlements.AllowsAssetType(asset.AssetClass.String()) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("client %s is not entitled to the asset type of %s", principal.ClientID, symbol))
+	}
+
+	return nil
+}
+
+func requestedSymbols(req interface{}) []string {
+	var symbols []string
+
+	if r, ok := req.(singleSymbolRequest); ok && r.GetSymbol() != "" {
+		symbols = append(symbols, r.GetSymbol())
+	}
+	if r, ok := req.(multiSymbolRequest); ok {
+		symbols = append(symbols, r.GetSymbols()...)
+	}
+
+	return symbols
+}
+
+func isPublicMethod(fullMethod string) bool {
+	return hasMethodPrefix(fullMethod, publicMethodPrefixes)
+}
+
+func hasMethodPrefix(fullMethod string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizedServerStream exposes the principal through the stream context and
+// checks every message received from the client against the caller's entitlements
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	principal   *auth.Principal
+	interceptor *AuthInterceptor
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.interceptor.authorize(s.principal, m)
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAuthInterceptor(t *testing.T) *AuthInterceptor {
+	validator, err := auth.NewJWTValidator("interceptor-secret", nil, "", "")
+	require.NoError(t, err)
+	return NewAuthInterceptor(auth.NewAuthenticator(validator, nil), domainService.NewAssetDataService(time.Now()))
+}
+
+func bearerContext(t *testing.T, assetTypes, symbols []string, roles ...string) context.Context {
+	claims := auth.MarketDataClaims{
+		ClientID:   "test-client",
+		AssetTypes: assetTypes,
+		Symbols:    symbols,
+		Roles:      roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("interceptor-secret"))
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptor_Unary_Unauthenticated(t *testing.T) {
+	// Arrange
+	interceptor := newTestAuthInterceptor(t).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/hub_investments.MarketDataService/GetMarketData"}
+
+	// Act
+	_, err := interceptor(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	// Assert
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthInterceptor_Unary_EnforcesEntitlements(t *testing.T) {
+	// Arrange
+	interceptor := newTestAuthInterceptor(t).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/hub_investments.MarketDataService/GetBatchMarketData"}
+	ctx := bearerContext(t, []string{"STOCK"}, nil)
+
+	var handlerPrincipal *auth.Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerPrincipal, _ = auth.PrincipalFromContext(ctx)
+		return &pb.GetBatchMarketDataResponse{}, nil
+	}
+
+	// Act
+	_, allowedErr := interceptor(ctx, &pb.GetBatchMarketDataRequest{Symbols: []string{"AAPL", "MSFT"}}, info, handler)
+	_, deniedErr := interceptor(ctx, &pb.GetBatchMarketDataRequest{Symbols: []string{"AAPL", "SPY"}}, info, handler)
+
+	// Assert
+	assert.NoError(t, allowedErr)
+	require.NotNil(t, handlerPrincipal)
+	assert.Equal(t, "test-client", handlerPrincipal.ClientID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(deniedErr))
+}
+
+func TestAuthInterceptor_Stream_DeniesUnentitledSubscription(t *testing.T) {
+	// Arrange
+	interceptor := newTestAuthInterceptor(t).Stream()
+	info := &grpc.StreamServerInfo{FullMethod: "/hub_investments.MarketDataService/StreamQuotes", IsClientStream: true, IsServerStream: true}
+
+	mockStream := &MockStreamQuotesServer{ctx: bearerContext(t, nil, []string{"AAPL"})}
+	mockStream.On("RecvMsg", mock.AnythingOfType("*marketdatapb.StreamQuotesRequest")).Run(func(args mock.Arguments) {
+		req := args.Get(0).(*pb.StreamQuotesRequest)
+		req.Action = "subscribe"
+		req.Symbols = []string{"AAPL", "TSLA"}
+	}).Return(nil).Once()
+
+	// Act
+	err := interceptor(nil, mockStream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		principal, ok := auth.PrincipalFromContext(stream.Context())
+		assert.True(t, ok)
+		assert.Equal(t, "test-client", principal.ClientID)
+		return stream.RecvMsg(&pb.StreamQuotesRequest{})
+	})
+
+	// Assert
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockStream.AssertExpectations(t)
+}
+
+func TestAuthInterceptor_Unary_RequiresAdminRole(t *testing.T) {
+	// Arrange
+	interceptor := newTestAuthInterceptor(t).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/hub_investments.MarketDataAdminService/HaltSymbol"}
+	req := &pb.HaltSymbolRequest{Symbol: "AAPL"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.HaltSymbolResponse{}, nil
+	}
+
+	// Act
+	_, clientErr := interceptor(bearerContext(t, nil, nil), req, info, handler)
+	_, adminErr := interceptor(bearerContext(t, nil, nil, auth.RoleAdmin), req, info, handler)
+
+	// Assert
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientErr))
+	assert.NoError(t, adminErr)
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// currencyConversion converts the prices of responses into the currency requested by the
+// client, at the FX rates of the engine serving the request. The zero value converts nothing.
+type currencyConversion struct {
+	target string
+	rates  *service.FXRateService
+}
+
+// newCurrencyConversion validates the requested currency; an empty one converts nothing
+func newCurrencyConversion(rawCurrency string, engine *service.PriceOscillationService) (currencyConversion, error) {
+	if rawCurrency == "" {
+		return currencyConversion{}, nil
+	}
+
+	currency, err := model.ParseCurrency(rawCurrency)
+	if err != nil {
+		return currencyConversion{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rates := engine.FXRates()
+	if _, err := rates.Rate(model.DefaultCurrency, currency); err != nil {
+		return currencyConversion{}, status.Error(codes.InvalidArgument, fmt.Sprintf("currency %s is not supported", currency))
+	}
+
+	return currencyConversion{target: currency, rates: rates}, nil
+}
+
+// rate returns the rate from currency into the target currency, or false when prices in
+// currency are not converted
+func (c currencyConversion) rate(currency string) (decimal.Decimal, bool, error) {
+	if currency == "" {
+		currency = model.DefaultCurrency
+	}
+	if c.target == "" || currency == c.target {
+		return decimal.Zero, false, nil
+	}
+
+	rate, err := c.rates.Rate(currency, c.target)
+	if err != nil {
+		return decimal.Zero, false, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return rate, true, nil
+}
+
+// assetQuote maps the quote with its prices in the target currency
+func (c currencyConversion) assetQuote(quote model.AssetQuote) (*pb.AssetQuote, error) {
+	rate, converts, err := c.rate(quote.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if !converts {
+		return toPBAssetQuote(quote), nil
+	}
+
+	pbQuote := toPBAssetQuote(quote.InCurrency(c.target, rate))
+	pbQuote.InstrumentCurrency = quote.Currency
+	pbQuote.FxRateDecimal = rate.String()
+	return pbQuote, nil
+}
+
+// marketData maps stored market data and the simulated quote of the instrument, when it has
+// one, with their prices in the target currency. The stored prices of a simulated instrument
+// are in the currency it is simulated in.
+func (c currencyConversion) marketData(data model.MarketDataModel, quote model.AssetQuote, live bool) (*pb.MarketData, error) {
+	if live && quote.Currency != "" {
+		data.Currency = quote.Currency
+	}
+
+	rate, converts, err := c.rate(data.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	instrumentCurrency := data.Currency
+	if converts {
+		data = data.InCurrency(c.target, rate)
+	}
+	pbMarketData := toPBMarketData(data)
+	if converts {
+		pbMarketData.InstrumentCurrency = instrumentCurrency
+		pbMarketData.FxRateDecimal = rate.String()
+	}
+
+	if !live || quote.Bid.IsZero() {
+		return pbMarketData, nil
+	}
+
+	if converts {
+		quote = quote.InCurrency(c.target, rate)
+	}
+	return withLiveQuote(pbMarketData, quote), nil
+}
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarketDataAdminGRPCServer exposes the simulator controls. Access is restricted to the
+// admin role by the auth interceptor.
+type MarketDataAdminGRPCServer struct {
+	pb.UnimplementedMarketDataAdminServiceServer
+	priceOscillationService *service.PriceOscillationService
+	sandboxes               *service.SandboxService
+}
+
+// NewMarketDataAdminGRPCServer creates the admin server; sandboxes may be nil when they are
+// disabled
+func NewMarketDataAdminGRPCServer(
+	priceOscillationService *service.PriceOscillationService,
+	sandboxes *service.SandboxService,
+) *MarketDataAdminGRPCServer {
+	return &MarketDataAdminGRPCServer{
+		priceOscillationService: priceOscillationService,
+		sandboxes:               sandboxes,
+	}
+}
+
+// engineFor returns the engine of the sandbox selected by the request metadata, or the
+// shared engine
+func (s *MarketDataAdminGRPCServer) engineFor(ctx context.Context) (*service.PriceOscillationService, error) {
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	return engine, err
+}
+
+func (s *MarketDataAdminGRPCServer) HaltSymbol(ctx context.Context, req *pb.HaltSymbolRequest) (*pb.HaltSymbolResponse, error) {
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("gRPC HaltSymbol called for symbol: %s", symbol)
+
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	halt, err := engine.HaltSymbol(symbol, req.Message)
+	if err != nil {
+		return nil, toHaltStatusError(symbol, err)
+	}
+
+	return &pb.HaltSymbolResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Trading halted for %s", symbol),
+		},
+		Halt: toPBTradingHalt(halt),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) ResumeSymbol(ctx context.Context, req *pb.ResumeSymbolRequest) (*pb.ResumeSymbolResponse, error) {
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("gRPC ResumeSymbol called for symbol: %s", symbol)
+
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	halt, err := engine.ResumeSymbol(symbol)
+	if err != nil {
+		return nil, toHaltStatusError(symbol, err)
+	}
+
+	return &pb.ResumeSymbolResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Trading resumed for %s", symbol),
+		},
+		Halt: toPBTradingHalt(halt),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) ListHalts(ctx context.Context, req *pb.ListHaltsRequest) (*pb.ListHaltsResponse, error) {
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	halts := engine.Halts()
+	pbHalts := make([]*pb.TradingHalt, 0, len(halts))
+	for _, halt := range halts {
+		pbHalts = append(pbHalts, toPBTradingHalt(halt))
+	}
+
+	return &pb.ListHaltsResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("%d symbols halted", len(halts)),
+		},
+		Halts: pbHalts,
+	}, nil
+}
+
+func toHaltStatusError(symbol model.Symbol, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnknownSymbol):
+		return status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	case errors.Is(err, service.ErrSymbolHalted), errors.Is(err, service.ErrSymbolNotHalted):
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("%s: %v", symbol, err))
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
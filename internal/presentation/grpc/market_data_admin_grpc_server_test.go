@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAdminServer(t *testing.T) *MarketDataAdminGRPCServer {
+	priceOscillationService := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	t.Cleanup(priceOscillationService.Stop)
+	return NewMarketDataAdminGRPCServer(priceOscillationService, nil)
+}
+
+func TestHaltSymbol_Success(t *testing.T) {
+	// Arrange
+	server := newTestAdminServer(t)
+
+	// Act
+	haltResp, haltErr := server.HaltSymbol(context.Background(), &pb.HaltSymbolRequest{Symbol: "aapl", Message: "pending news"})
+	listResp, listErr := server.ListHalts(context.Background(), &pb.ListHaltsRequest{})
+	resumeResp, resumeErr := server.ResumeSymbol(context.Background(), &pb.ResumeSymbolRequest{Symbol: "AAPL"})
+
+	// Assert
+	require.NoError(t, haltErr)
+	assert.True(t, haltResp.ApiResponse.Success)
+	assert.Equal(t, "AAPL", haltResp.Halt.Symbol)
+	assert.Equal(t, pb.HaltReason_HALT_REASON_ADMIN, haltResp.Halt.Reason)
+	assert.Equal(t, "pending news", haltResp.Halt.Message)
+	assert.NotEmpty(t, haltResp.Halt.HaltedAt)
+	assert.Empty(t, haltResp.Halt.ResumeAt)
+
+	require.NoError(t, listErr)
+	require.Len(t, listResp.Halts, 1)
+	assert.Equal(t, "AAPL", listResp.Halts[0].Symbol)
+
+	require.NoError(t, resumeErr)
+	assert.Equal(t, "AAPL", resumeResp.Halt.Symbol)
+}
+
+func TestHaltSymbol_Errors(t *testing.T) {
+	// Arrange
+	server := newTestAdminServer(t)
+	ctx := context.Background()
+
+	// Act
+	_, invalidErr := server.HaltSymbol(ctx, &pb.HaltSymbolRequest{Symbol: "AAPL$"})
+	_, unknownErr := server.HaltSymbol(ctx, &pb.HaltSymbolRequest{Symbol: "UNKNOWN"})
+	_, notHaltedErr := server.ResumeSymbol(ctx, &pb.ResumeSymbolRequest{Symbol: "AAPL"})
+	_, _ = server.HaltSymbol(ctx, &pb.HaltSymbolRequest{Symbol: "AAPL"})
+	_, alreadyHaltedErr := server.HaltSymbol(ctx, &pb.HaltSymbolRequest{Symbol: "AAPL"})
+
+	// Assert
+	assert.Equal(t, codes.InvalidArgument, status.Code(invalidErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownErr))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(notHaltedErr))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(alreadyHaltedErr))
+}
+
+func TestStartScenario_Definition(t *testing.T) {
+	// Arrange
+	server := newTestAdminServer(t)
+	ctx := context.Background()
+	definition := `
+name: slow_drift
+events:
+  - at: 1m
+    type: drift
+    asset_classes: [ETF]
+    percent: 2
+    duration: 10m
+`
+
+	// Act
+	startResp, startErr := server.StartScenario(ctx, &pb.StartScenarioRequest{Definition: definition})
+	statusResp, statusErr := server.GetScenarioStatus(ctx, &pb.GetScenarioStatusRequest{})
+	stopResp, stopErr := server.StopScenario(ctx, &pb.StopScenarioRequest{})
+	stoppedResp, _ := server.GetScenarioStatus(ctx, &pb.GetScenarioStatusRequest{})
+
+	// Assert
+	require.NoError(t, startErr)
+	assert.Equal(t, "slow_drift", startResp.Scenario.Name)
+	assert.Equal(t, int32(0), startResp.Scenario.EventsApplied)
+	assert.Equal(t, int32(1), startResp.Scenario.EventsTotal)
+
+	require.NoError(t, statusErr)
+	assert.Equal(t, "slow_drift", statusResp.Scenario.Name)
+	assert.False(t, statusResp.Scenario.Completed)
+
+	require.NoError(t, stopErr)
+	assert.Equal(t, "slow_drift", stopResp.Scenario.Name)
+	assert.Nil(t, stoppedResp.Scenario)
+}
+
+func TestStartScenario_Errors(t *testing.T) {
+	// Arrange
+	server := newTestAdminServer(t)
+	ctx := context.Background()
+
+	// Act
+	_, emptyErr := server.StartScenario(ctx, &pb.StartScenarioRequest{})
+	_, unknownErr := server.StartScenario(ctx, &pb.StartScenarioRequest{Name: "market_crash"})
+	_, invalidErr := server.StartScenario(ctx, &pb.StartScenarioRequest{Definition: "name: broken"})
+	_, unknownFactorErr := server.StartScenario(ctx, &pb.StartScenarioRequest{
+		Definition: "{name: rotation, events: [{type: shock, factor: TECH, percent: 1}]}",
+	})
+	_, notRunningErr := server.StopScenario(ctx, &pb.StopScenarioRequest{})
+
+	// Assert
+	assert.Equal(t, codes.InvalidArgument, status.Code(emptyErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownErr))
+	assert.Equal(t, codes.InvalidArgument, status.Code(invalidErr))
+	assert.Equal(t, codes.InvalidArgument, status.Code(unknownFactorErr))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(notRunningErr))
+}
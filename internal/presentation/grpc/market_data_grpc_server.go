@@ -2,33 +2,43 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/usecase"
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
 	"github.com/RodriguesYan/hub-proto-contracts/common"
-	pb "github.com/RodriguesYan/hub-proto-contracts/monolith"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type MarketDataGRPCServer struct {
 	pb.UnimplementedMarketDataServiceServer
-	getMarketDataUsecase    usecase.IGetMarketDataUsecase
-	priceOscillationService *service.PriceOscillationService
+	getMarketDataUsecase      usecase.IGetMarketDataUsecase
+	getBatchMarketDataUsecase usecase.IGetBatchMarketDataUsecase
+	priceOscillationService   *service.PriceOscillationService
+	sandboxes                 *service.SandboxService
 }
 
+// NewMarketDataGRPCServer creates the market data server; sandboxes may be nil when they
+// are disabled
 func NewMarketDataGRPCServer(
 	getMarketDataUsecase usecase.IGetMarketDataUsecase,
+	getBatchMarketDataUsecase usecase.IGetBatchMarketDataUsecase,
 	priceOscillationService *service.PriceOscillationService,
+	sandboxes *service.SandboxService,
 ) *MarketDataGRPCServer {
 	return &MarketDataGRPCServer{
-		getMarketDataUsecase:    getMarketDataUsecase,
-		priceOscillationService: priceOscillationService,
+		getMarketDataUsecase:      getMarketDataUsecase,
+		getBatchMarketDataUsecase: getBatchMarketDataUsecase,
+		priceOscillationService:   priceOscillationService,
+		sandboxes:                 sandboxes,
 	}
 }
 
@@ -39,7 +49,23 @@ func (s *MarketDataGRPCServer) GetMarketData(ctx context.Context, req *pb.GetMar
 
 	log.Printf("gRPC GetMarketData called for symbol: %s", req.Symbol)
 
+	engine, sandbox, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion, err := newCurrencyConversion(req.TargetCurrency, engine)
+	if err != nil {
+		return nil, err
+	}
+	if sandbox != "" {
+		return s.getSandboxMarketData(engine, req.Symbol, conversion)
+	}
+
 	marketData, err := s.getMarketDataUsecase.Execute([]string{req.Symbol})
+	if errors.Is(err, model.ErrInvalidSymbol) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		log.Printf("Failed to get market data for symbol %s: %v", req.Symbol, err)
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get market data: %v", err))
@@ -49,18 +75,17 @@ func (s *MarketDataGRPCServer) GetMarketData(ctx context.Context, req *pb.GetMar
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", req.Symbol))
 	}
 
-	data := marketData[0]
+	pbMarketData, err := s.toPBLiveMarketData(marketData[0], conversion)
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.GetMarketDataResponse{
 		ApiResponse: &common.APIResponse{
 			Success: true,
 			Message: "Market data retrieved successfully",
 		},
-		MarketData: &pb.MarketData{
-			Symbol:       data.Symbol,
-			CompanyName:  data.Name,
-			CurrentPrice: float64(data.LastQuote),
-		},
+		MarketData: pbMarketData,
 	}, nil
 }
 
@@ -69,46 +94,407 @@ func (s *MarketDataGRPCServer) GetBatchMarketData(ctx context.Context, req *pb.G
 		return nil, status.Error(codes.InvalidArgument, "at least one symbol is required")
 	}
 
-	log.Printf("gRPC GetBatchMarketData called for symbols: %v", req.Symbols)
+	log.Printf("gRPC GetBatchMarketData called for %d symbols", len(req.Symbols))
 
-	marketData, err := s.getMarketDataUsecase.Execute(req.Symbols)
+	engine, sandbox, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion, err := newCurrencyConversion(req.TargetCurrency, engine)
+	if err != nil {
+		return nil, err
+	}
+	if sandbox != "" {
+		return s.getSandboxBatchMarketData(engine, req.Symbols, conversion)
+	}
+
+	result, err := s.getBatchMarketDataUsecase.Execute(req.Symbols)
+	if errors.Is(err, usecase.ErrBatchTooLarge) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		log.Printf("Failed to get batch market data: %v", err)
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get market data: %v", err))
 	}
 
+	marketData := result.Found()
 	pbMarketData := make([]*pb.MarketData, 0, len(marketData))
 	for _, data := range marketData {
-		pbMarketData = append(pbMarketData, &pb.MarketData{
-			Symbol:       data.Symbol,
-			CompanyName:  data.Name,
-			CurrentPrice: float64(data.LastQuote),
-		})
+		pbData, err := s.toPBLiveMarketData(data, conversion)
+		if err != nil {
+			return nil, err
+		}
+		pbMarketData = append(pbMarketData, pbData)
 	}
 
 	return &pb.GetBatchMarketDataResponse{
 		ApiResponse: &common.APIResponse{
 			Success: true,
-			Message: fmt.Sprintf("Retrieved %d market data items", len(marketData)),
+			Message: fmt.Sprintf("Retrieved %d of %d market data items", len(marketData), len(result.Results)),
 		},
 		MarketData: pbMarketData,
+		Results:    toPBSymbolResults(result.Results),
 	}, nil
 }
 
+func toPBSymbolResults(results []usecase.SymbolResult) []*pb.SymbolResult {
+	pbResults := make([]*pb.SymbolResult, 0, len(results))
+	for _, symbolResult := range results {
+		pbResults = append(pbResults, &pb.SymbolResult{
+			RequestedSymbol: symbolResult.RequestedSymbol,
+			Symbol:          symbolResult.Symbol.String(),
+			Status:          toPBSymbolStatus(symbolResult.Status),
+			ErrorMessage:    symbolResult.Reason,
+		})
+	}
+	return pbResults
+}
+
+func toPBMarketData(data model.MarketDataModel) *pb.MarketData {
+	assetClass := toPBAssetClass(data.AssetClass)
+
+	return &pb.MarketData{
+		Symbol:              data.Symbol,
+		CompanyName:         data.Name,
+		CurrentPrice:        data.LastQuote.InexactFloat64(),
+		Category:            int32(assetClass),
+		AssetClass:          assetClass,
+		CurrentPriceDecimal: data.FormatPrice(data.LastQuote),
+		PricePrecision:      data.PricePrecision,
+		TickSizeDecimal:     formatTickSize(data.Instrument),
+		LotSize:             data.LotSize,
+		Currency:            data.Currency,
+	}
+}
+
+// toPBLiveMarketData maps market data loaded from the repository with the simulated quote of
+// the instrument, in the currency of the conversion
+func (s *MarketDataGRPCServer) toPBLiveMarketData(data model.MarketDataModel, conversion currencyConversion) (*pb.MarketData, error) {
+	quote, live := s.priceOscillationService.Quote(model.Symbol(data.Symbol))
+	return conversion.marketData(data, quote, live)
+}
+
+// withLiveQuote adds the simulated bid and ask, the session volume and VWAP and the asset
+// class fields of quote to market data loaded from the repository
+func withLiveQuote(pbMarketData *pb.MarketData, quote model.AssetQuote) *pb.MarketData {
+	pbMarketData.BidDecimal = quote.FormatPrice(quote.Bid)
+	pbMarketData.AskDecimal = quote.FormatPrice(quote.Ask)
+	pbMarketData.BidSize = quote.BidSize
+	pbMarketData.AskSize = quote.AskSize
+	pbMarketData.Volume = quote.Volume
+	pbMarketData.VwapDecimal = model.FormatVWAP(quote.VWAP, quote.PricePrecision)
+	return withAssetClassFields(pbMarketData, quote)
+}
+
+func toPBAssetQuote(quote model.AssetQuote) *pb.AssetQuote {
+	var sessionDate string
+	if !quote.SessionDate.IsZero() {
+		sessionDate = quote.SessionDate.Format("2006-01-02")
+	}
+
+	fields := toAssetClassFields(quote)
+
+	return &pb.AssetQuote{
+		Symbol:               quote.Symbol,
+		Name:                 quote.Name,
+		AssetType:            quote.AssetClass.String(),
+		AssetClass:           toPBAssetClass(quote.AssetClass),
+		CurrentPrice:         quote.CurrentPrice.InexactFloat64(),
+		BasePrice:            quote.BasePrice.InexactFloat64(),
+		Change:               quote.Change.InexactFloat64(),
+		ChangePercent:        quote.ChangePercent.InexactFloat64(),
+		LastUpdated:          quote.LastUpdated.Format(time.RFC3339),
+		Volume:               quote.Volume,
+		MarketCap:            quote.MarketCap,
+		CurrentPriceDecimal:  quote.FormatPrice(quote.CurrentPrice),
+		BasePriceDecimal:     quote.FormatPrice(quote.BasePrice),
+		ChangeDecimal:        quote.FormatPrice(quote.Change),
+		ChangePercentDecimal: quote.ChangePercent.StringFixed(4),
+		PricePrecision:       quote.PricePrecision,
+		OpenPriceDecimal:     quote.FormatPrice(quote.OpenPrice),
+		HighPriceDecimal:     quote.FormatPrice(quote.HighPrice),
+		LowPriceDecimal:      quote.FormatPrice(quote.LowPrice),
+		PreviousCloseDecimal: quote.FormatPrice(quote.PreviousClose),
+		SessionDate:          sessionDate,
+		BidDecimal:           quote.FormatPrice(quote.Bid),
+		AskDecimal:           quote.FormatPrice(quote.Ask),
+		BidSize:              quote.BidSize,
+		AskSize:              quote.AskSize,
+		VwapDecimal:          model.FormatVWAP(quote.VWAP, quote.PricePrecision),
+		TickSizeDecimal:      formatTickSize(quote.Instrument),
+		LotSize:              quote.LotSize,
+		Currency:             quote.Currency,
+		BaseCurrency:         fields.baseCurrency,
+		YieldDecimal:         fields.yieldDecimal,
+		CouponRateDecimal:    fields.couponRateDecimal,
+		CouponFrequency:      fields.couponFrequency,
+		MaturityDate:         fields.maturityDate,
+		Constituents:         fields.constituents,
+		NavDecimal:           fields.navDecimal,
+	}
+}
+
+// formatTickSize renders the tick size with the price precision, e.g. "0.01"
+func formatTickSize(instrument model.Instrument) string {
+	return model.FormatPrice(instrument.Tick(), instrument.PricePrecision)
+}
+
+// assetClassFields are the response fields that only apply to the asset class of a quote
+type assetClassFields struct {
+	baseCurrency      string
+	yieldDecimal      string
+	couponRateDecimal string
+	couponFrequency   int32
+	maturityDate      string
+	constituents      []string
+	navDecimal        string
+}
+
+func toAssetClassFields(quote model.AssetQuote) assetClassFields {
+	fields := assetClassFields{
+		baseCurrency: quote.BaseCurrency,
+		constituents: quote.Constituents,
+		navDecimal:   model.FormatNAV(quote.NAV, quote.PricePrecision),
+	}
+	if !quote.Bond.IsZero() {
+		fields.yieldDecimal = quote.Yield.StringFixed(model.YieldPrecision)
+		fields.couponRateDecimal = quote.Bond.CouponRate.String()
+		fields.couponFrequency = int32(quote.Bond.CouponFrequency)
+		fields.maturityDate = quote.Bond.Maturity.Format("2006-01-02")
+	}
+	return fields
+}
+
+// withAssetClassFields adds the asset class fields of quote to market data
+func withAssetClassFields(pbMarketData *pb.MarketData, quote model.AssetQuote) *pb.MarketData {
+	fields := toAssetClassFields(quote)
+	pbMarketData.BaseCurrency = fields.baseCurrency
+	pbMarketData.YieldDecimal = fields.yieldDecimal
+	pbMarketData.CouponRateDecimal = fields.couponRateDecimal
+	pbMarketData.CouponFrequency = fields.couponFrequency
+	pbMarketData.MaturityDate = fields.maturityDate
+	pbMarketData.Constituents = fields.constituents
+	pbMarketData.NavDecimal = fields.navDecimal
+	return pbMarketData
+}
+
+var pbAssetClasses = map[model.AssetClass]pb.AssetClass{
+	model.AssetClassStock:  pb.AssetClass_ASSET_CLASS_STOCK,
+	model.AssetClassETF:    pb.AssetClass_ASSET_CLASS_ETF,
+	model.AssetClassREIT:   pb.AssetClass_ASSET_CLASS_REIT,
+	model.AssetClassBond:   pb.AssetClass_ASSET_CLASS_BOND,
+	model.AssetClassCrypto: pb.AssetClass_ASSET_CLASS_CRYPTO,
+	model.AssetClassFX:     pb.AssetClass_ASSET_CLASS_FX,
+	model.AssetClassIndex:  pb.AssetClass_ASSET_CLASS_INDEX,
+	model.AssetClassOption: pb.AssetClass_ASSET_CLASS_OPTION,
+}
+
+func toPBAssetClass(assetClass model.AssetClass) pb.AssetClass {
+	if pbAssetClass, exists := pbAssetClasses[assetClass]; exists {
+		return pbAssetClass
+	}
+	return pb.AssetClass_ASSET_CLASS_UNSPECIFIED
+}
+
+func toPBSymbolStatus(symbolStatus usecase.SymbolStatus) pb.SymbolStatus {
+	switch symbolStatus {
+	case usecase.SymbolStatusFound:
+		return pb.SymbolStatus_SYMBOL_STATUS_FOUND
+	case usecase.SymbolStatusNotFound:
+		return pb.SymbolStatus_SYMBOL_STATUS_NOT_FOUND
+	case usecase.SymbolStatusInvalid:
+		return pb.SymbolStatus_SYMBOL_STATUS_INVALID
+	default:
+		return pb.SymbolStatus_SYMBOL_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *MarketDataGRPCServer) GetMarketStatus(ctx context.Context, req *pb.GetMarketStatusRequest) (*pb.GetMarketStatusResponse, error) {
+	symbols, err := model.ParseSymbols(req.Symbols)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeStatuses := engine.ExchangeStatuses()
+	pbExchanges := make([]*pb.ExchangeMarketStatus, 0, len(exchangeStatuses))
+	for _, exchangeStatus := range exchangeStatuses {
+		pbExchanges = append(pbExchanges, &pb.ExchangeMarketStatus{
+			Exchange:   exchangeStatus.Exchange,
+			Name:       exchangeStatus.Name,
+			Timezone:   exchangeStatus.Timezone,
+			Status:     toPBMarketStatus(exchangeStatus.Status),
+			NextChange: formatOptionalTime(exchangeStatus.NextChange),
+		})
+	}
+
+	pbSymbols := make([]*pb.SymbolMarketStatus, 0, len(symbols))
+	for _, symbol := range symbols {
+		pbSymbols = append(pbSymbols, toPBSymbolMarketStatus(engine.MarketStatus(symbol)))
+	}
+
+	return &pb.GetMarketStatusResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: "Market status retrieved successfully",
+		},
+		Exchanges: pbExchanges,
+		Symbols:   pbSymbols,
+	}, nil
+}
+
+func toPBSymbolMarketStatus(marketStatus model.SymbolMarketStatus) *pb.SymbolMarketStatus {
+	pbStatus := &pb.SymbolMarketStatus{
+		Symbol:     marketStatus.Symbol,
+		Exchange:   marketStatus.Exchange,
+		Status:     toPBMarketStatus(marketStatus.Status),
+		NextChange: formatOptionalTime(marketStatus.NextChange),
+	}
+	if marketStatus.Halt != nil {
+		pbStatus.Halt = toPBTradingHalt(*marketStatus.Halt)
+	}
+	return pbStatus
+}
+
+func toPBTradingHalt(halt model.TradingHalt) *pb.TradingHalt {
+	pbHalt := &pb.TradingHalt{
+		Symbol:   halt.Symbol,
+		Reason:   toPBHaltReason(halt.Reason),
+		Message:  halt.Message,
+		HaltedAt: halt.HaltedAt.Format(time.RFC3339),
+		ResumeAt: formatOptionalTime(halt.ResumeAt),
+	}
+	if !halt.ReferencePrice.IsZero() {
+		pbHalt.ReferencePriceDecimal = halt.ReferencePrice.String()
+		pbHalt.LimitPriceDecimal = halt.LimitPrice.String()
+	}
+	return pbHalt
+}
+
+func toPBCorporateAction(action model.CorporateAction) *pb.CorporateAction {
+	pbAction := &pb.CorporateAction{
+		Symbol:             action.Symbol,
+		Type:               toPBCorporateActionType(action.Type),
+		ExDate:             action.ExDate.Format("2006-01-02"),
+		SplitFrom:          action.SplitFrom,
+		SplitTo:            action.SplitTo,
+		NewSymbol:          action.NewSymbol,
+		PriceFactorDecimal: action.PriceFactor.String(),
+	}
+	if !action.DividendAmount.IsZero() {
+		pbAction.DividendAmountDecimal = action.DividendAmount.String()
+	}
+	return pbAction
+}
+
+var pbCorporateActionTypes = map[model.CorporateActionType]pb.CorporateActionType{
+	model.CorporateActionSplit:        pb.CorporateActionType_CORPORATE_ACTION_TYPE_SPLIT,
+	model.CorporateActionCashDividend: pb.CorporateActionType_CORPORATE_ACTION_TYPE_CASH_DIVIDEND,
+	model.CorporateActionSymbolChange: pb.CorporateActionType_CORPORATE_ACTION_TYPE_SYMBOL_CHANGE,
+}
+
+func toPBCorporateActionType(actionType model.CorporateActionType) pb.CorporateActionType {
+	if pbActionType, exists := pbCorporateActionTypes[actionType]; exists {
+		return pbActionType
+	}
+	return pb.CorporateActionType_CORPORATE_ACTION_TYPE_UNSPECIFIED
+}
+
+var pbHaltReasons = map[model.HaltReason]pb.HaltReason{
+	model.HaltReasonAdmin:     pb.HaltReason_HALT_REASON_ADMIN,
+	model.HaltReasonLimitUp:   pb.HaltReason_HALT_REASON_LIMIT_UP,
+	model.HaltReasonLimitDown: pb.HaltReason_HALT_REASON_LIMIT_DOWN,
+}
+
+func toPBHaltReason(reason model.HaltReason) pb.HaltReason {
+	if pbHaltReason, exists := pbHaltReasons[reason]; exists {
+		return pbHaltReason
+	}
+	return pb.HaltReason_HALT_REASON_UNSPECIFIED
+}
+
+var pbMarketStatuses = map[model.MarketStatus]pb.MarketStatus{
+	model.MarketStatusOpen:       pb.MarketStatus_MARKET_STATUS_OPEN,
+	model.MarketStatusClosed:     pb.MarketStatus_MARKET_STATUS_CLOSED,
+	model.MarketStatusPreMarket:  pb.MarketStatus_MARKET_STATUS_PRE_MARKET,
+	model.MarketStatusPostMarket: pb.MarketStatus_MARKET_STATUS_POST_MARKET,
+	model.MarketStatusHalted:     pb.MarketStatus_MARKET_STATUS_HALTED,
+}
+
+func toPBMarketStatus(marketStatus model.MarketStatus) pb.MarketStatus {
+	if pbMarketStatus, exists := pbMarketStatuses[marketStatus]; exists {
+		return pbMarketStatus
+	}
+	return pb.MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// GetAssetDetails describes a simulated instrument, with its latest quote
 func (s *MarketDataGRPCServer) GetAssetDetails(ctx context.Context, req *pb.GetAssetDetailsRequest) (*pb.GetAssetDetailsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "GetAssetDetails is not yet implemented")
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("gRPC GetAssetDetails called for symbol: %s", symbol)
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, exists := engine.Quote(symbol)
+	if !exists {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	}
+
+	return &pb.GetAssetDetailsResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: "Asset details retrieved successfully",
+		},
+		Asset: &pb.AssetDetails{
+			Symbol:      quote.Symbol,
+			CompanyName: quote.Name,
+			MarketCap:   float64(quote.MarketCap),
+			Exchange:    engine.MarketStatus(symbol).Exchange,
+			AssetClass:  toPBAssetClass(quote.AssetClass),
+			Quote:       toPBAssetQuote(quote),
+		},
+	}, nil
 }
 
 func (s *MarketDataGRPCServer) StreamQuotes(stream pb.MarketDataService_StreamQuotesServer) error {
 	ctx := stream.Context()
 
-	subscribedSymbols := make(map[string]bool)
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return err
+	}
+
+	// The subscription state is owned by the send loop below; the receive goroutine only
+	// forwards requests, so no state is shared between the two goroutines
+	subscribedSymbols := make(map[model.Symbol]bool)
+	// Symbols last reported as halted, so the status that ends a halt is sent as "resume"
+	haltedSymbols := make(map[string]bool)
 	var subscriberID string
-	var priceChannel <-chan map[string]*model.AssetQuote
+	var priceChannel <-chan service.MarketUpdate
+	// Currency of the quotes sent on the stream, set by the last subscribe request that names one
+	var conversion currencyConversion
 
 	errChan := make(chan error, 1)
-	// Use interface{} to allow sending receive-only channels
-	channelUpdateChan := make(chan interface{}, 1)
+	requestChan := make(chan *pb.StreamQuotesRequest)
 
 	go func() {
 		for {
@@ -124,54 +510,34 @@ func (s *MarketDataGRPCServer) StreamQuotes(stream pb.MarketDataService_StreamQu
 				return
 			}
 
-			switch req.Action {
-			case "subscribe":
-				for _, symbol := range req.Symbols {
-					if !subscribedSymbols[symbol] {
-						subscribedSymbols[symbol] = true
-					}
-				}
-
-				if subscriberID == "" {
-					var newChannel <-chan map[string]*model.AssetQuote
-					subscriberID, newChannel = s.priceOscillationService.Subscribe(subscribedSymbols)
-					log.Printf("New subscription created: %s for symbols: %v", subscriberID, req.Symbols)
-					channelUpdateChan <- newChannel
-				} else {
-					s.priceOscillationService.Unsubscribe(subscriberID)
-					var newChannel <-chan map[string]*model.AssetQuote
-					subscriberID, newChannel = s.priceOscillationService.Subscribe(subscribedSymbols)
-					log.Printf("Updated subscription: %s for symbols: %v", subscriberID, req.Symbols)
-					channelUpdateChan <- newChannel
-				}
-
-			case "unsubscribe":
-				for _, symbol := range req.Symbols {
-					delete(subscribedSymbols, symbol)
-				}
-
-				if len(subscribedSymbols) == 0 && subscriberID != "" {
-					s.priceOscillationService.Unsubscribe(subscriberID)
-					subscriberID = ""
-					channelUpdateChan <- nil
-					log.Println("All symbols unsubscribed, closing subscription")
-				} else if subscriberID != "" {
-					s.priceOscillationService.Unsubscribe(subscriberID)
-					var newChannel <-chan map[string]*model.AssetQuote
-					subscriberID, newChannel = s.priceOscillationService.Subscribe(subscribedSymbols)
-					log.Printf("Updated subscription after unsubscribe: %s", subscriberID)
-					channelUpdateChan <- newChannel
-				}
+			select {
+			case requestChan <- req:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
 
+	resubscribe := func() {
+		if subscriberID != "" {
+			engine.Unsubscribe(subscriberID)
+			subscriberID = ""
+			priceChannel = nil
+		}
+		if len(subscribedSymbols) > 0 {
+			subscriberID, priceChannel = engine.Subscribe(subscribedSymbols)
+			log.Printf("Subscription %s now covers %d symbols", subscriberID, len(subscribedSymbols))
+		} else {
+			log.Println("All symbols unsubscribed, closing subscription")
+		}
+	}
+
 	heartbeatTicker := time.NewTicker(30 * time.Second)
 	defer heartbeatTicker.Stop()
 
 	defer func() {
 		if subscriberID != "" {
-			s.priceOscillationService.Unsubscribe(subscriberID)
+			engine.Unsubscribe(subscriberID)
 			log.Printf("Cleaned up subscription: %s", subscriberID)
 		}
 	}()
@@ -189,13 +555,59 @@ func (s *MarketDataGRPCServer) StreamQuotes(stream pb.MarketDataService_StreamQu
 			}
 			return nil
 
-		case update := <-channelUpdateChan:
-			if update == nil {
-				priceChannel = nil
-				log.Println("❌ Price channel set to nil (unsubscribed)")
-			} else {
-				priceChannel = update.(<-chan map[string]*model.AssetQuote)
-				log.Println("✅ Price channel updated and ready to receive quotes")
+		case req := <-requestChan:
+			switch req.Action {
+			case "subscribe":
+				var invalidSymbols []string
+				var addedSymbols []model.Symbol
+				for _, rawSymbol := range req.Symbols {
+					symbol, err := model.ParseSymbol(rawSymbol)
+					if err != nil {
+						invalidSymbols = append(invalidSymbols, err.Error())
+						continue
+					}
+					if !subscribedSymbols[symbol] {
+						addedSymbols = append(addedSymbols, symbol)
+					}
+					subscribedSymbols[symbol] = true
+				}
+				if req.TargetCurrency != "" {
+					if streamConversion, err := newCurrencyConversion(req.TargetCurrency, engine); err != nil {
+						invalidSymbols = append(invalidSymbols, status.Convert(err).Message())
+					} else {
+						conversion = streamConversion
+					}
+				}
+				resubscribe()
+
+				// Later status messages are only sent on changes, so every new symbol
+				// starts with its current status
+				for _, symbol := range addedSymbols {
+					if err := s.sendMarketStatus(stream, engine.MarketStatus(symbol), haltedSymbols); err != nil {
+						return err
+					}
+				}
+
+				for _, errorMessage := range invalidSymbols {
+					if err := stream.Send(&pb.StreamQuotesResponse{
+						Type:         "error",
+						ErrorMessage: errorMessage,
+					}); err != nil {
+						log.Printf("Failed to send error message: %v", err)
+						return err
+					}
+				}
+
+			case "unsubscribe":
+				for _, rawSymbol := range req.Symbols {
+					if symbol, err := model.ParseSymbol(rawSymbol); err == nil {
+						delete(subscribedSymbols, symbol)
+						delete(haltedSymbols, symbol.String())
+					}
+				}
+				if subscriberID != "" {
+					resubscribe()
+				}
 			}
 
 		case <-heartbeatTicker.C:
@@ -206,29 +618,36 @@ func (s *MarketDataGRPCServer) StreamQuotes(stream pb.MarketDataService_StreamQu
 				return err
 			}
 
-		case quotes, ok := <-priceChannel:
+		case update, ok := <-priceChannel:
 			if !ok {
 				log.Println("Price channel closed")
 				return nil
 			}
 
-			log.Printf("📤 Received %d quotes from price channel", len(quotes))
-
-			for _, quote := range quotes {
-				pbQuote := &pb.AssetQuote{
-					Symbol:        quote.Symbol,
-					Name:          quote.Name,
-					AssetType:     string(quote.Type),
-					CurrentPrice:  quote.CurrentPrice,
-					BasePrice:     quote.BasePrice,
-					Change:        quote.Change,
-					ChangePercent: quote.ChangePercent,
-					LastUpdated:   quote.LastUpdated.Format(time.RFC3339),
-					Volume:        quote.Volume,
-					MarketCap:     quote.MarketCap,
+			for _, marketStatus := range update.Statuses {
+				if err := s.sendMarketStatus(stream, marketStatus, haltedSymbols); err != nil {
+					return err
 				}
+			}
 
-				log.Printf("📤 Sending quote to gRPC stream: %s @ $%.2f", quote.Symbol, quote.CurrentPrice)
+			renamed, err := s.sendCorporateActions(stream, update.CorporateActions, subscribedSymbols, haltedSymbols)
+			if err != nil {
+				return err
+			}
+			if renamed {
+				resubscribe()
+			}
+
+			log.Printf("📤 Received %d quotes from price channel", len(update.Quotes))
+
+			for _, quote := range update.Quotes {
+				pbQuote, err := conversion.assetQuote(quote)
+				if err != nil {
+					log.Printf("Failed to convert quote for %s: %v", quote.Symbol, err)
+					continue
+				}
+
+				log.Printf("📤 Sending quote to gRPC stream: %s @ $%s", quote.Symbol, quote.FormatPrice(quote.CurrentPrice))
 
 				if err := stream.Send(&pb.StreamQuotesResponse{
 					Type:  "quote",
@@ -243,3 +662,70 @@ func (s *MarketDataGRPCServer) StreamQuotes(stream pb.MarketDataService_StreamQu
 		}
 	}
 }
+
+// sendCorporateActions sends every action of an update once, although a symbol change is
+// keyed by both its symbols, and moves the subscription of a renamed symbol to its new
+// symbol. It reports whether the subscribed symbols changed.
+func (s *MarketDataGRPCServer) sendCorporateActions(
+	stream pb.MarketDataService_StreamQuotesServer,
+	actionsBySymbol map[string][]model.CorporateAction,
+	subscribedSymbols map[model.Symbol]bool,
+	haltedSymbols map[string]bool,
+) (bool, error) {
+	var actions []model.CorporateAction
+	sent := make(map[int64]bool)
+	for _, symbolActions := range actionsBySymbol {
+		for _, action := range symbolActions {
+			if !sent[action.ID] {
+				sent[action.ID] = true
+				actions = append(actions, action)
+			}
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].ID < actions[j].ID })
+
+	renamed := false
+	for _, action := range actions {
+		if err := stream.Send(&pb.StreamQuotesResponse{
+			Type:            "corporate_action",
+			CorporateAction: toPBCorporateAction(action),
+		}); err != nil {
+			log.Printf("Failed to send corporate action: %v", err)
+			return false, err
+		}
+
+		symbol := model.Symbol(action.Symbol)
+		if action.Type == model.CorporateActionSymbolChange && subscribedSymbols[symbol] {
+			delete(subscribedSymbols, symbol)
+			delete(haltedSymbols, action.Symbol)
+			subscribedSymbols[model.Symbol(action.NewSymbol)] = true
+			renamed = true
+		}
+	}
+	return renamed, nil
+}
+
+func (s *MarketDataGRPCServer) sendMarketStatus(
+	stream pb.MarketDataService_StreamQuotesServer,
+	marketStatus model.SymbolMarketStatus,
+	haltedSymbols map[string]bool,
+) error {
+	messageType := "market_status"
+	switch {
+	case marketStatus.Status == model.MarketStatusHalted:
+		messageType = "halt"
+		haltedSymbols[marketStatus.Symbol] = true
+	case haltedSymbols[marketStatus.Symbol]:
+		messageType = "resume"
+		delete(haltedSymbols, marketStatus.Symbol)
+	}
+
+	if err := stream.Send(&pb.StreamQuotesResponse{
+		Type:         messageType,
+		MarketStatus: toPBSymbolMarketStatus(marketStatus),
+	}); err != nil {
+		log.Printf("Failed to send market status: %v", err)
+		return err
+	}
+	return nil
+}
@@ -3,16 +3,21 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"testing"
 	"time"
 
 	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/usecase"
 	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
 	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
-	pb "github.com/RodriguesYan/hub-proto-contracts/monolith"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -31,6 +36,27 @@ func (m *MockGetMarketDataUseCase) Execute(symbols []string) ([]model.MarketData
 	return args.Get(0).([]model.MarketDataModel), args.Error(1)
 }
 
+// MockGetBatchMarketDataUseCase is a mock implementation of IGetBatchMarketDataUsecase
+type MockGetBatchMarketDataUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetBatchMarketDataUseCase) Execute(symbols []string) (*usecase.BatchMarketDataResult, error) {
+	args := m.Called(symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.BatchMarketDataResult), args.Error(1)
+}
+
+func (m *MockGetBatchMarketDataUseCase) Normalize(symbols []string) (*usecase.BatchMarketDataResult, error) {
+	args := m.Called(symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.BatchMarketDataResult), args.Error(1)
+}
+
 // MockStreamQuotesServer is a mock implementation of MarketDataService_StreamQuotesServer
 type MockStreamQuotesServer struct {
 	mock.Mock
@@ -82,11 +108,11 @@ func (m *MockStreamQuotesServer) SetTrailer(md metadata.MD) {
 func TestNewMarketDataGRPCServer(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	// Act
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	// Assert
 	assert.NotNil(t, server)
@@ -97,12 +123,12 @@ func TestNewMarketDataGRPCServer(t *testing.T) {
 func TestGetMarketData_Success(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 150.25, Category: 1},
+		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("150.25"), AssetClass: model.AssetClassStock},
 	}
 
 	mockUseCase.On("Execute", []string{"AAPL"}).Return(expectedData, nil)
@@ -121,24 +147,127 @@ func TestGetMarketData_Success(t *testing.T) {
 	assert.Equal(t, "Apple Inc.", resp.MarketData.CompanyName)
 	assert.Equal(t, float64(150.25), resp.MarketData.CurrentPrice)
 
+	// The simulated top of book is added to the stored market data
+	quote, _ := priceOscillationService.Quote("AAPL")
+	assert.Equal(t, model.FormatPrice(quote.Bid, quote.PricePrecision), resp.MarketData.BidDecimal)
+	assert.Equal(t, model.FormatPrice(quote.Ask, quote.PricePrecision), resp.MarketData.AskDecimal)
+	assert.Equal(t, quote.BidSize, resp.MarketData.BidSize)
+	assert.Equal(t, quote.AskSize, resp.MarketData.AskSize)
+
 	mockUseCase.AssertExpectations(t)
 }
 
+// TestGetMarketData_InstrumentMetadata tests that prices are rendered in whole ticks with the
+// instrument metadata
+func TestGetMarketData_InstrumentMetadata(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	priceOscillationService := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockUseCase.On("Execute", []string{"ES"}).Return([]model.MarketDataModel{{
+		Symbol: "ES", Name: "E-mini S&P 500", LastQuote: decimal.RequireFromString("5123.37"), AssetClass: model.AssetClassIndex,
+		Instrument: model.Instrument{TickSize: decimal.RequireFromString("0.25"), PricePrecision: 2, LotSize: 1, Currency: "USD"},
+	}}, nil)
+
+	// Act
+	resp, err := server.GetMarketData(context.Background(), &pb.GetMarketDataRequest{Symbol: "ES"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "5123.25", resp.MarketData.CurrentPriceDecimal)
+	assert.Equal(t, "0.25", resp.MarketData.TickSizeDecimal)
+	assert.Equal(t, int64(1), resp.MarketData.LotSize)
+	assert.Equal(t, "USD", resp.MarketData.Currency)
+}
+
+// TestGetMarketData_TargetCurrency tests that prices are converted at the simulated FX rate
+func TestGetMarketData_TargetCurrency(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	priceOscillationService := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockUseCase.On("Execute", []string{"AAPL"}).Return([]model.MarketDataModel{{
+		Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("150.25"), AssetClass: model.AssetClassStock,
+		Instrument: model.AssetClassStock.DefaultInstrument(),
+	}}, nil)
+	rate, err := priceOscillationService.FXRates().Rate("USD", "BRL")
+	require.NoError(t, err)
+	quote, _ := priceOscillationService.Quote("AAPL")
+
+	// Act
+	resp, err := server.GetMarketData(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL", TargetCurrency: "brl"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "BRL", resp.MarketData.Currency)
+	assert.Equal(t, "USD", resp.MarketData.InstrumentCurrency)
+	assert.Equal(t, rate.String(), resp.MarketData.FxRateDecimal)
+	assert.Equal(t, decimal.RequireFromString("150.25").Mul(rate).StringFixed(2), resp.MarketData.CurrentPriceDecimal)
+	assert.Equal(t, quote.Bid.Mul(rate).StringFixed(2), resp.MarketData.BidDecimal)
+}
+
+// TestGetMarketData_SimulatedCurrency tests that the stored prices of a simulated instrument
+// are converted from the currency it is simulated in
+func TestGetMarketData_SimulatedCurrency(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	priceOscillationService := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockUseCase.On("Execute", []string{"PETR4.SA"}).Return([]model.MarketDataModel{{
+		Symbol: "PETR4.SA", Name: "Petrobras", LastQuote: decimal.RequireFromString("38.45"), AssetClass: model.AssetClassStock,
+		Instrument: model.AssetClassStock.DefaultInstrument(),
+	}}, nil)
+	rate, err := priceOscillationService.FXRates().Rate("BRL", "USD")
+	require.NoError(t, err)
+	quote, _ := priceOscillationService.Quote("PETR4.SA")
+
+	// Act
+	resp, err := server.GetMarketData(context.Background(), &pb.GetMarketDataRequest{Symbol: "PETR4.SA", TargetCurrency: "USD"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "USD", resp.MarketData.Currency)
+	assert.Equal(t, "BRL", resp.MarketData.InstrumentCurrency)
+	assert.Equal(t, rate.String(), resp.MarketData.FxRateDecimal)
+	assert.Equal(t, decimal.RequireFromString("38.45").Mul(rate).StringFixed(2), resp.MarketData.CurrentPriceDecimal)
+	assert.Equal(t, quote.Bid.Mul(rate).StringFixed(2), resp.MarketData.BidDecimal)
+}
+
+// TestGetMarketData_InvalidTargetCurrency tests that unknown currencies are rejected
+func TestGetMarketData_InvalidTargetCurrency(t *testing.T) {
+	// Arrange
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{},
+		service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now())), nil)
+
+	for _, currency := range []string{"REAL", "CHF"} {
+		// Act
+		_, err := server.GetMarketData(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL", TargetCurrency: currency})
+
+		// Assert
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), currency)
+	}
+}
+
 // TestGetBatchMarketData_Success tests successful batch market data retrieval
 func TestGetBatchMarketData_Success(t *testing.T) {
 	// Arrange
-	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	mockBatchUseCase := &MockGetBatchMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, mockBatchUseCase, priceOscillationService, nil)
 
 	symbols := []string{"AAPL", "GOOGL"}
-	expectedData := []model.MarketDataModel{
-		{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: 150.25, Category: 1},
-		{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: 2750.50, Category: 1},
-	}
+	result := &usecase.BatchMarketDataResult{Results: []usecase.SymbolResult{
+		{RequestedSymbol: "AAPL", Symbol: "AAPL", Status: usecase.SymbolStatusFound,
+			MarketData: &model.MarketDataModel{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("150.25"), AssetClass: model.AssetClassStock}},
+		{RequestedSymbol: "GOOGL", Symbol: "GOOGL", Status: usecase.SymbolStatusFound,
+			MarketData: &model.MarketDataModel{Symbol: "GOOGL", Name: "Alphabet Inc.", LastQuote: decimal.RequireFromString("2750.50"), AssetClass: model.AssetClassStock, Instrument: model.AssetClassStock.DefaultInstrument()}},
+	}}
 
-	mockUseCase.On("Execute", symbols).Return(expectedData, nil)
+	mockBatchUseCase.On("Execute", symbols).Return(result, nil)
 
 	req := &pb.GetBatchMarketDataRequest{Symbols: symbols}
 	ctx := context.Background()
@@ -153,18 +282,78 @@ func TestGetBatchMarketData_Success(t *testing.T) {
 	assert.Equal(t, "AAPL", resp.MarketData[0].Symbol)
 	assert.Equal(t, "Apple Inc.", resp.MarketData[0].CompanyName)
 	assert.Equal(t, float64(150.25), resp.MarketData[0].CurrentPrice)
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_STOCK, resp.MarketData[0].AssetClass)
+	assert.Equal(t, int32(1), resp.MarketData[0].Category)
 	assert.Equal(t, "GOOGL", resp.MarketData[1].Symbol)
+	assert.Equal(t, "2750.50", resp.MarketData[1].CurrentPriceDecimal)
+	assert.Equal(t, 2, len(resp.Results))
 
-	mockUseCase.AssertExpectations(t)
+	mockBatchUseCase.AssertExpectations(t)
+}
+
+// TestGetBatchMarketData_PartialResults tests that missing and invalid symbols are reported per symbol
+func TestGetBatchMarketData_PartialResults(t *testing.T) {
+	// Arrange
+	mockBatchUseCase := &MockGetBatchMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, mockBatchUseCase, priceOscillationService, nil)
+
+	symbols := []string{"aapl", "UNKNOWN", "BAD$"}
+	result := &usecase.BatchMarketDataResult{Results: []usecase.SymbolResult{
+		{RequestedSymbol: "aapl", Symbol: "AAPL", Status: usecase.SymbolStatusFound,
+			MarketData: &model.MarketDataModel{Symbol: "AAPL", Name: "Apple Inc.", LastQuote: decimal.RequireFromString("150.25"), AssetClass: model.AssetClassStock}},
+		{RequestedSymbol: "UNKNOWN", Symbol: "UNKNOWN", Status: usecase.SymbolStatusNotFound},
+		{RequestedSymbol: "BAD$", Status: usecase.SymbolStatusInvalid, Reason: "symbol contains invalid character '$'"},
+	}}
+
+	mockBatchUseCase.On("Execute", symbols).Return(result, nil)
+
+	// Act
+	resp, err := server.GetBatchMarketData(context.Background(), &pb.GetBatchMarketDataRequest{Symbols: symbols})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resp.MarketData))
+	assert.Equal(t, 3, len(resp.Results))
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_FOUND, resp.Results[0].Status)
+	assert.Equal(t, "aapl", resp.Results[0].RequestedSymbol)
+	assert.Equal(t, "AAPL", resp.Results[0].Symbol)
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_NOT_FOUND, resp.Results[1].Status)
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_INVALID, resp.Results[2].Status)
+	assert.NotEmpty(t, resp.Results[2].ErrorMessage)
+
+	mockBatchUseCase.AssertExpectations(t)
+}
+
+// TestGetBatchMarketData_TooLarge tests that oversized batches are rejected
+func TestGetBatchMarketData_TooLarge(t *testing.T) {
+	// Arrange
+	mockBatchUseCase := &MockGetBatchMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, mockBatchUseCase, priceOscillationService, nil)
+
+	symbols := []string{"AAPL", "GOOGL", "MSFT"}
+	mockBatchUseCase.On("Execute", symbols).Return(nil, usecase.ErrBatchTooLarge)
+
+	// Act
+	resp, err := server.GetBatchMarketData(context.Background(), &pb.GetBatchMarketDataRequest{Symbols: symbols})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockBatchUseCase.AssertExpectations(t)
 }
 
 // TestGetMarketData_EmptySymbol tests with empty symbol
 func TestGetMarketData_EmptySymbol(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	req := &pb.GetMarketDataRequest{Symbol: ""}
 	ctx := context.Background()
@@ -185,9 +374,9 @@ func TestGetMarketData_EmptySymbol(t *testing.T) {
 func TestGetMarketData_NotFound(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockUseCase.On("Execute", []string{"INVALID"}).Return([]model.MarketDataModel{}, nil)
 
@@ -212,9 +401,9 @@ func TestGetMarketData_NotFound(t *testing.T) {
 func TestGetMarketData_UseCaseError(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	useCaseError := errors.New("database connection failed")
 
@@ -243,9 +432,9 @@ func TestGetMarketData_UseCaseError(t *testing.T) {
 func TestGetBatchMarketData_EmptySymbols(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	req := &pb.GetBatchMarketDataRequest{Symbols: []string{}}
 	ctx := context.Background()
@@ -266,14 +455,14 @@ func TestGetBatchMarketData_EmptySymbols(t *testing.T) {
 func TestStreamQuotes_Subscribe(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	// Start the price oscillation service
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -292,7 +481,7 @@ func TestStreamQuotes_Subscribe(t *testing.T) {
 	mockStream.On("Recv").Return(nil, io.EOF).Once()
 
 	// Expect at least one quote to be sent
-	mockStream.On("Send", mock.AnythingOfType("*monolith.StreamQuotesResponse")).Return(nil).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(nil).Maybe()
 
 	// Act
 	err := server.StreamQuotes(mockStream)
@@ -302,17 +491,61 @@ func TestStreamQuotes_Subscribe(t *testing.T) {
 	mockStream.AssertExpectations(t)
 }
 
+// TestStreamQuotes_TargetCurrency tests that streamed quotes are converted into the currency
+// named by the subscribe request
+func TestStreamQuotes_TargetCurrency(t *testing.T) {
+	// Arrange
+	clock := simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+	priceOscillationService := service.NewPriceOscillationServiceWithOptions(domainService.NewAssetDataService(time.Now()), service.PriceOscillationOptions{
+		Clock:  clock,
+		Random: simulation.NewRandom(7),
+	})
+	priceOscillationService.Start()
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	quotes := make(chan *pb.AssetQuote, 10)
+	mockStream := &MockStreamQuotesServer{ctx: ctx}
+	mockStream.On("Recv").Return(&pb.StreamQuotesRequest{Action: "subscribe", Symbols: []string{"AAPL"}, TargetCurrency: "EUR"}, nil).Once()
+	mockStream.On("Recv").Run(func(mock.Arguments) { <-ctx.Done() }).Return(nil, context.Canceled).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Run(func(args mock.Arguments) {
+		if response := args.Get(0).(*pb.StreamQuotesResponse); response.Type == "quote" {
+			quotes <- response.Quote
+		}
+	}).Return(nil).Maybe()
+
+	// Act
+	go func() { _ = server.StreamQuotes(mockStream) }()
+	require.Eventually(t, func() bool { return priceOscillationService.SubscriberCount() == 1 }, time.Second, 5*time.Millisecond)
+	clock.Advance(service.DefaultTickInterval)
+
+	// Assert
+	select {
+	case quote := <-quotes:
+		rate, err := priceOscillationService.FXRates().Rate("USD", "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, "AAPL", quote.Symbol)
+		assert.Equal(t, "EUR", quote.Currency)
+		assert.Equal(t, "USD", quote.InstrumentCurrency)
+		assert.Equal(t, rate.String(), quote.FxRateDecimal)
+	case <-time.After(time.Second):
+		t.Fatal("no quote was streamed")
+	}
+}
+
 // TestStreamQuotes_Unsubscribe tests unsubscribing from quotes
 func TestStreamQuotes_Unsubscribe(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -339,7 +572,7 @@ func TestStreamQuotes_Unsubscribe(t *testing.T) {
 	mockStream.On("Recv").Return(nil, io.EOF).Once()
 
 	// Expect quotes to be sent
-	mockStream.On("Send", mock.AnythingOfType("*monolith.StreamQuotesResponse")).Return(nil).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(nil).Maybe()
 
 	// Act
 	err := server.StreamQuotes(mockStream)
@@ -353,13 +586,13 @@ func TestStreamQuotes_Unsubscribe(t *testing.T) {
 func TestStreamQuotes_InvalidAction(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -379,7 +612,7 @@ func TestStreamQuotes_InvalidAction(t *testing.T) {
 
 	// Server ignores invalid actions, so no Send is expected for the invalid action
 	// No quotes should be sent since no subscription was made
-	mockStream.On("Send", mock.AnythingOfType("*monolith.StreamQuotesResponse")).Return(nil).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(nil).Maybe()
 
 	// Act
 	err := server.StreamQuotes(mockStream)
@@ -393,13 +626,13 @@ func TestStreamQuotes_InvalidAction(t *testing.T) {
 func TestStreamQuotes_SendError(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -419,7 +652,7 @@ func TestStreamQuotes_SendError(t *testing.T) {
 
 	// Send returns error
 	sendError := errors.New("failed to send")
-	mockStream.On("Send", mock.AnythingOfType("*monolith.StreamQuotesResponse")).Return(sendError).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(sendError).Maybe()
 
 	// Act
 	err := server.StreamQuotes(mockStream)
@@ -434,13 +667,13 @@ func TestStreamQuotes_SendError(t *testing.T) {
 func TestStreamQuotes_ContextCancellation(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	mockStream := &MockStreamQuotesServer{
@@ -466,7 +699,7 @@ func TestStreamQuotes_ContextCancellation(t *testing.T) {
 	mockStream.On("Recv").Return(nil, context.Canceled).Maybe()
 
 	// Expect quotes to be sent before cancellation
-	mockStream.On("Send", mock.AnythingOfType("*monolith.StreamQuotesResponse")).Return(nil).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(nil).Maybe()
 
 	// Act
 	err := server.StreamQuotes(mockStream)
@@ -480,13 +713,13 @@ func TestStreamQuotes_ContextCancellation(t *testing.T) {
 func TestStreamQuotes_Heartbeat(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -509,7 +742,7 @@ func TestStreamQuotes_Heartbeat(t *testing.T) {
 
 	// Expect heartbeat message to be sent
 	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
-		return resp.Type == "heartbeat" || resp.Type == "quote"
+		return resp.Type == "heartbeat" || resp.Type == "quote" || resp.Type == "market_status"
 	})).Return(nil).Maybe()
 
 	// Act
@@ -526,13 +759,13 @@ func TestStreamQuotes_Heartbeat(t *testing.T) {
 func TestStreamQuotes_MultipleSymbols(t *testing.T) {
 	// Arrange
 	mockUseCase := &MockGetMarketDataUseCase{}
-	assetDataService := domainService.NewAssetDataService()
+	assetDataService := domainService.NewAssetDataService(time.Now())
 	priceOscillationService := service.NewPriceOscillationService(assetDataService)
 
 	priceOscillationService.Start()
 	defer priceOscillationService.Stop()
 
-	server := NewMarketDataGRPCServer(mockUseCase, priceOscillationService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
 
 	mockStream := &MockStreamQuotesServer{
 		ctx: context.Background(),
@@ -550,6 +783,16 @@ func TestStreamQuotes_MultipleSymbols(t *testing.T) {
 	// Second Recv returns EOF
 	mockStream.On("Recv").Return(nil, io.EOF).Once()
 
+	// Every new symbol starts with its market status
+	statusSymbols := make(map[string]bool)
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
+		return resp.Type == "market_status"
+	})).Run(func(args mock.Arguments) {
+		marketStatus := args.Get(0).(*pb.StreamQuotesResponse).MarketStatus
+		assert.Equal(t, pb.MarketStatus_MARKET_STATUS_OPEN, marketStatus.Status)
+		statusSymbols[marketStatus.Symbol] = true
+	}).Return(nil).Times(3)
+
 	// Expect quotes for all symbols to be sent
 	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
 		return resp.Type == "quote" || resp.Type == "heartbeat"
@@ -558,7 +801,269 @@ func TestStreamQuotes_MultipleSymbols(t *testing.T) {
 	// Act
 	err := server.StreamQuotes(mockStream)
 
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"AAPL": true, "GOOGL": true, "MSFT": true}, statusSymbols)
+	mockStream.AssertExpectations(t)
+}
+
+// TestGetMarketStatus tests that exchange and symbol statuses come from the exchange calendars
+func TestGetMarketStatus(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationServiceWithOptions(assetDataService, service.PriceOscillationOptions{
+		MarketHours: domainService.NewDefaultMarketHoursService(),
+	})
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	// Act
+	resp, err := server.GetMarketStatus(context.Background(), &pb.GetMarketStatusRequest{Symbols: []string{"aapl"}})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, resp.ApiResponse.Success)
+	// Exchanges are ordered by code: CRYPTO, FX, US
+	require.Len(t, resp.Exchanges, 3)
+	us := resp.Exchanges[2]
+	assert.Equal(t, "US", us.Exchange)
+	assert.Equal(t, "America/New_York", us.Timezone)
+	assert.NotEqual(t, pb.MarketStatus_MARKET_STATUS_UNSPECIFIED, us.Status)
+	assert.NotEmpty(t, us.NextChange)
+	assert.Equal(t, pb.MarketStatus_MARKET_STATUS_OPEN, resp.Exchanges[0].Status)
+
+	assert.Len(t, resp.Symbols, 1)
+	assert.Equal(t, "AAPL", resp.Symbols[0].Symbol)
+	assert.Equal(t, "US", resp.Symbols[0].Exchange)
+	assert.Equal(t, us.Status, resp.Symbols[0].Status)
+}
+
+// TestGetMarketStatus_InvalidSymbol tests that malformed symbols are rejected as invalid arguments
+func TestGetMarketStatus_InvalidSymbol(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	// Act
+	resp, err := server.GetMarketStatus(context.Background(), &pb.GetMarketStatusRequest{Symbols: []string{"AAPL$"}})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestGetMarketData_InvalidSymbol tests that malformed symbols are rejected as invalid arguments
+func TestGetMarketData_InvalidSymbol(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockUseCase.On("Execute", []string{"AAPL$"}).Return(nil, fmt.Errorf("%w: bad character", model.ErrInvalidSymbol))
+
+	// Act
+	resp, err := server.GetMarketData(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL$"})
+
+	// Assert
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertExpectations(t)
+}
+
+// TestStreamQuotes_InvalidSymbol tests that malformed symbols in a subscription are reported on the stream
+func TestStreamQuotes_InvalidSymbol(t *testing.T) {
+	// Arrange
+	mockUseCase := &MockGetMarketDataUseCase{}
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	server := NewMarketDataGRPCServer(mockUseCase, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockStream := &MockStreamQuotesServer{
+		ctx: context.Background(),
+	}
+
+	errorSent := make(chan struct{})
+	isError := mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool { return resp.Type == "error" })
+
+	mockStream.On("Recv").Return(&pb.StreamQuotesRequest{
+		Action:  "subscribe",
+		Symbols: []string{"aapl", "NOT A SYMBOL"},
+	}, nil).Once()
+	mockStream.On("Recv").Run(func(args mock.Arguments) {
+		select {
+		case <-errorSent:
+		case <-time.After(2 * time.Second):
+		}
+	}).Return(nil, io.EOF).Once()
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
+		return resp.Type == "market_status"
+	})).Return(nil).Once()
+	mockStream.On("Send", isError).Run(func(args mock.Arguments) {
+		assert.Contains(t, args.Get(0).(*pb.StreamQuotesResponse).ErrorMessage, "NOT A SYMBOL")
+		close(errorSent)
+	}).Return(nil).Once()
+
+	// Act
+	err := server.StreamQuotes(mockStream)
+
 	// Assert
 	assert.NoError(t, err)
 	mockStream.AssertExpectations(t)
 }
+
+func TestStreamQuotes_HaltAndResume(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+	_, err := priceOscillationService.HaltSymbol("AAPL", "pending news")
+	assert.NoError(t, err)
+
+	resumed := make(chan time.Time)
+	mockStream := &MockStreamQuotesServer{ctx: context.Background()}
+	mockStream.On("Recv").Return(&pb.StreamQuotesRequest{Action: "subscribe", Symbols: []string{"AAPL"}}, nil).Once()
+	mockStream.On("Recv").WaitUntil(resumed).Return(nil, io.EOF).Once()
+
+	// A symbol halted before the subscription starts with a halt message
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
+		return resp.Type == "halt"
+	})).Run(func(args mock.Arguments) {
+		marketStatus := args.Get(0).(*pb.StreamQuotesResponse).MarketStatus
+		assert.Equal(t, pb.MarketStatus_MARKET_STATUS_HALTED, marketStatus.Status)
+		assert.Equal(t, pb.HaltReason_HALT_REASON_ADMIN, marketStatus.Halt.Reason)
+		assert.Equal(t, "pending news", marketStatus.Halt.Message)
+
+		_, err := priceOscillationService.ResumeSymbol("AAPL")
+		assert.NoError(t, err)
+	}).Return(nil).Once()
+
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
+		return resp.Type == "resume"
+	})).Run(func(args mock.Arguments) {
+		marketStatus := args.Get(0).(*pb.StreamQuotesResponse).MarketStatus
+		assert.Equal(t, pb.MarketStatus_MARKET_STATUS_OPEN, marketStatus.Status)
+		assert.Nil(t, marketStatus.Halt)
+		close(resumed)
+	}).Return(nil).Once()
+
+	// Act
+	err = server.StreamQuotes(mockStream)
+
+	// Assert
+	assert.NoError(t, err)
+	mockStream.AssertExpectations(t)
+}
+
+// TestSendCorporateActions tests that a symbol change keyed by both its symbols is sent once
+// and moves the subscription to the new symbol
+func TestSendCorporateActions(t *testing.T) {
+	// Arrange
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, nil, nil)
+	exDate := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+	split := model.CorporateAction{ID: 1, Symbol: "AAPL", Type: model.CorporateActionSplit, ExDate: exDate,
+		SplitFrom: 1, SplitTo: 4, PriceFactor: decimal.RequireFromString("0.25")}
+	rename := model.CorporateAction{ID: 2, Symbol: "NFLX", Type: model.CorporateActionSymbolChange, ExDate: exDate,
+		NewSymbol: "NFLY", PriceFactor: decimal.NewFromInt(1)}
+	subscribedSymbols := map[model.Symbol]bool{"AAPL": true, "NFLX": true, "NFLY": true}
+	haltedSymbols := map[string]bool{"NFLX": true}
+
+	var sent []*pb.CorporateAction
+	mockStream := &MockStreamQuotesServer{}
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamQuotesResponse) bool {
+		return resp.Type == "corporate_action"
+	})).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(0).(*pb.StreamQuotesResponse).CorporateAction)
+	}).Return(nil)
+
+	// Act
+	renamed, err := server.sendCorporateActions(mockStream, map[string][]model.CorporateAction{
+		"AAPL": {split},
+		"NFLX": {rename},
+		"NFLY": {rename},
+	}, subscribedSymbols, haltedSymbols)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, renamed)
+	require.Len(t, sent, 2)
+	assert.Equal(t, pb.CorporateActionType_CORPORATE_ACTION_TYPE_SPLIT, sent[0].Type)
+	assert.Equal(t, "2026-03-11", sent[0].ExDate)
+	assert.Equal(t, int64(4), sent[0].SplitTo)
+	assert.Equal(t, "0.25", sent[0].PriceFactorDecimal)
+	assert.Empty(t, sent[0].DividendAmountDecimal)
+	assert.Equal(t, pb.CorporateActionType_CORPORATE_ACTION_TYPE_SYMBOL_CHANGE, sent[1].Type)
+	assert.Equal(t, "NFLY", sent[1].NewSymbol)
+	assert.Equal(t, map[model.Symbol]bool{"AAPL": true, "NFLY": true}, subscribedSymbols)
+	assert.Empty(t, haltedSymbols)
+}
+
+func TestGetAssetDetails(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	// Act
+	resp, err := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "aapl"})
+	_, notFoundErr := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "UNKNOWN"})
+	_, invalidErr := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "AA PL"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, resp.ApiResponse.Success)
+	assert.Equal(t, "AAPL", resp.Asset.Symbol)
+	assert.Equal(t, "Apple Inc.", resp.Asset.CompanyName)
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_STOCK, resp.Asset.AssetClass)
+	assert.NotEmpty(t, resp.Asset.Quote.BidDecimal)
+	assert.NotEmpty(t, resp.Asset.Quote.AskDecimal)
+	assert.Positive(t, resp.Asset.Quote.BidSize)
+	assert.Equal(t, "0.01", resp.Asset.Quote.TickSizeDecimal)
+	assert.Equal(t, int64(100), resp.Asset.Quote.LotSize)
+	assert.Equal(t, "USD", resp.Asset.Quote.Currency)
+
+	assert.Equal(t, codes.NotFound, status.Code(notFoundErr))
+	assert.Equal(t, codes.InvalidArgument, status.Code(invalidErr))
+}
+
+// TestGetAssetDetails_AssetClassFields tests that bonds, indices and pairs carry the fields of
+// their asset class
+func TestGetAssetDetails_AssetClassFields(t *testing.T) {
+	// Arrange
+	priceOscillationService := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	// Act
+	bond, err := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "UST10Y"})
+	require.NoError(t, err)
+	index, err := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "MEGA7"})
+	require.NoError(t, err)
+	crypto, err := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "btc-usd"})
+	require.NoError(t, err)
+	etf, err := server.GetAssetDetails(context.Background(), &pb.GetAssetDetailsRequest{Symbol: "QQQ"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_BOND, bond.Asset.AssetClass)
+	assert.Regexp(t, `^4\.\d{4}$`, bond.Asset.Quote.YieldDecimal)
+	assert.Equal(t, "4.25", bond.Asset.Quote.CouponRateDecimal)
+	assert.Equal(t, int32(2), bond.Asset.Quote.CouponFrequency)
+	assert.Equal(t, "2035-11-15", bond.Asset.Quote.MaturityDate)
+
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_INDEX, index.Asset.AssetClass)
+	assert.Contains(t, index.Asset.Quote.Constituents, "NVDA")
+	assert.Empty(t, index.Asset.Quote.YieldDecimal)
+
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_CRYPTO, crypto.Asset.AssetClass)
+	assert.Equal(t, "BTC", crypto.Asset.Quote.BaseCurrency)
+	assert.Empty(t, crypto.Asset.Quote.NavDecimal)
+
+	assert.Equal(t, pb.AssetClass_ASSET_CLASS_ETF, etf.Asset.AssetClass)
+	assert.Contains(t, etf.Asset.Quote.Constituents, "NFLX")
+	assert.Regexp(t, `^\d+\.\d{4}$`, etf.Asset.Quote.NavDecimal)
+}
@@ -0,0 +1,271 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultDepthLevels is the number of levels per side returned when a request does not ask
+// for a specific depth
+const DefaultDepthLevels = 10
+
+func (s *MarketDataGRPCServer) GetMarketDepth(ctx context.Context, req *pb.GetMarketDepthRequest) (*pb.GetMarketDepthResponse, error) {
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := depthLevels(engine, req.Levels)
+	if err != nil {
+		return nil, err
+	}
+
+	book, exists := engine.OrderBook(symbol)
+	if !exists {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	}
+
+	return &pb.GetMarketDepthResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: "Market depth retrieved successfully",
+		},
+		Book: toPBOrderBook(book.Top(levels)),
+	}, nil
+}
+
+// depthLevels validates the requested number of levels per side of the books of engine
+func depthLevels(engine *service.PriceOscillationService, requested int32) (int, error) {
+	maxLevels := engine.BookDepth()
+
+	switch {
+	case requested < 0 || int(requested) > maxLevels:
+		return 0, status.Error(codes.InvalidArgument, fmt.Sprintf("levels must be between 1 and %d", maxLevels))
+	case requested == 0:
+		return min(DefaultDepthLevels, maxLevels), nil
+	default:
+		return int(requested), nil
+	}
+}
+
+// StreamMarketDepth sends a snapshot of the book of every subscribed symbol, then updates
+// with the levels that changed since the last message sent for that symbol. The number of
+// levels is set by the first subscribe request of the stream.
+func (s *MarketDataGRPCServer) StreamMarketDepth(stream pb.MarketDataService_StreamMarketDepthServer) error {
+	ctx := stream.Context()
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return err
+	}
+
+	// Owned by the send loop below, like the subscription state of StreamQuotes
+	subscribedSymbols := make(map[model.Symbol]bool)
+	sentBooks := make(map[string]model.OrderBook)
+	levels := 0
+	var subscriberID string
+	var bookChannel <-chan service.MarketUpdate
+
+	errChan := make(chan error, 1)
+	requestChan := make(chan *pb.StreamMarketDepthRequest)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				errChan <- nil
+				return
+			}
+			if err != nil {
+				log.Printf("Error receiving from depth stream: %v", err)
+				errChan <- err
+				return
+			}
+
+			select {
+			case requestChan <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	resubscribe := func() {
+		if subscriberID != "" {
+			engine.UnsubscribeDepth(subscriberID)
+			subscriberID = ""
+			bookChannel = nil
+		}
+		if len(subscribedSymbols) > 0 {
+			subscriberID, bookChannel = engine.SubscribeDepth(subscribedSymbols)
+		}
+	}
+
+	heartbeatTicker := time.NewTicker(30 * time.Second)
+	defer heartbeatTicker.Stop()
+
+	defer func() {
+		if subscriberID != "" {
+			engine.UnsubscribeDepth(subscriberID)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errChan:
+			return err
+
+		case req := <-requestChan:
+			switch req.Action {
+			case "subscribe":
+				if levels == 0 {
+					requestedLevels, err := depthLevels(engine, req.Levels)
+					if err != nil {
+						return err
+					}
+					levels = requestedLevels
+				}
+
+				var errorMessages []string
+				var addedSymbols []model.Symbol
+				for _, rawSymbol := range req.Symbols {
+					symbol, err := model.ParseSymbol(rawSymbol)
+					if err != nil {
+						errorMessages = append(errorMessages, err.Error())
+						continue
+					}
+					if _, exists := engine.Quote(symbol); !exists {
+						errorMessages = append(errorMessages, fmt.Sprintf("symbol %s not found", symbol))
+						continue
+					}
+					if !subscribedSymbols[symbol] {
+						addedSymbols = append(addedSymbols, symbol)
+					}
+					subscribedSymbols[symbol] = true
+				}
+				resubscribe()
+
+				for _, symbol := range addedSymbols {
+					book, _ := engine.OrderBook(symbol)
+					if err := s.sendDepthSnapshot(stream, book.Top(levels), sentBooks); err != nil {
+						return err
+					}
+				}
+
+				for _, errorMessage := range errorMessages {
+					if err := stream.Send(&pb.StreamMarketDepthResponse{
+						Type:         "error",
+						ErrorMessage: errorMessage,
+					}); err != nil {
+						return err
+					}
+				}
+
+			case "unsubscribe":
+				for _, rawSymbol := range req.Symbols {
+					if symbol, err := model.ParseSymbol(rawSymbol); err == nil {
+						delete(subscribedSymbols, symbol)
+						delete(sentBooks, symbol.String())
+					}
+				}
+				if subscriberID != "" {
+					resubscribe()
+				}
+			}
+
+		case <-heartbeatTicker.C:
+			if err := stream.Send(&pb.StreamMarketDepthResponse{Type: "heartbeat"}); err != nil {
+				return err
+			}
+
+		case update, ok := <-bookChannel:
+			if !ok {
+				return nil
+			}
+
+			for symbol, book := range update.Books {
+				top := book.Top(levels)
+				delta := top.DeltaFrom(sentBooks[symbol])
+				sentBooks[symbol] = top
+				if delta.IsEmpty() {
+					continue
+				}
+
+				if err := stream.Send(&pb.StreamMarketDepthResponse{
+					Type:   "update",
+					Update: toPBOrderBookUpdate(delta, book.Instrument),
+				}); err != nil {
+					log.Printf("Failed to send depth update: %v", err)
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *MarketDataGRPCServer) sendDepthSnapshot(
+	stream pb.MarketDataService_StreamMarketDepthServer,
+	book model.OrderBook,
+	sentBooks map[string]model.OrderBook,
+) error {
+	if err := stream.Send(&pb.StreamMarketDepthResponse{
+		Type: "snapshot",
+		Book: toPBOrderBook(book),
+	}); err != nil {
+		log.Printf("Failed to send depth snapshot: %v", err)
+		return err
+	}
+
+	sentBooks[book.Symbol] = book
+	return nil
+}
+
+func toPBOrderBook(book model.OrderBook) *pb.OrderBook {
+	return &pb.OrderBook{
+		Symbol:         book.Symbol,
+		Sequence:       book.Sequence,
+		Bids:           toPBPriceLevels(book.Bids, book.Instrument),
+		Asks:           toPBPriceLevels(book.Asks, book.Instrument),
+		PricePrecision: book.PricePrecision,
+		UpdatedAt:      book.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toPBOrderBookUpdate(delta model.OrderBookDelta, instrument model.Instrument) *pb.OrderBookUpdate {
+	return &pb.OrderBookUpdate{
+		Symbol:    delta.Symbol,
+		Sequence:  delta.Sequence,
+		Bids:      toPBPriceLevels(delta.Bids, instrument),
+		Asks:      toPBPriceLevels(delta.Asks, instrument),
+		UpdatedAt: delta.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toPBPriceLevels(levels []model.PriceLevel, instrument model.Instrument) []*pb.PriceLevel {
+	pbLevels := make([]*pb.PriceLevel, len(levels))
+	for i, level := range levels {
+		pbLevels[i] = &pb.PriceLevel{
+			PriceDecimal: instrument.FormatPrice(level.Price),
+			Size:         level.Size,
+		}
+	}
+	return pbLevels
+}
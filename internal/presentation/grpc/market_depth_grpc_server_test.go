@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MockStreamMarketDepthServer is a mock implementation of MarketDataService_StreamMarketDepthServer
+type MockStreamMarketDepthServer struct {
+	mock.Mock
+	ctx context.Context
+}
+
+func (m *MockStreamMarketDepthServer) Send(response *pb.StreamMarketDepthResponse) error {
+	args := m.Called(response)
+	return args.Error(0)
+}
+
+func (m *MockStreamMarketDepthServer) Recv() (*pb.StreamMarketDepthRequest, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.StreamMarketDepthRequest), args.Error(1)
+}
+
+func (m *MockStreamMarketDepthServer) Context() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+	return m.ctx
+}
+
+func (m *MockStreamMarketDepthServer) SendMsg(msg interface{}) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+func (m *MockStreamMarketDepthServer) RecvMsg(msg interface{}) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+func (m *MockStreamMarketDepthServer) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+func (m *MockStreamMarketDepthServer) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+func (m *MockStreamMarketDepthServer) SetTrailer(md metadata.MD) {
+}
+
+func TestGetMarketDepth(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	// Act
+	resp, err := server.GetMarketDepth(context.Background(), &pb.GetMarketDepthRequest{Symbol: "aapl"})
+	top, topErr := server.GetMarketDepth(context.Background(), &pb.GetMarketDepthRequest{Symbol: "AAPL", Levels: 3})
+	_, tooDeepErr := server.GetMarketDepth(context.Background(), &pb.GetMarketDepthRequest{Symbol: "AAPL", Levels: 500})
+	_, notFoundErr := server.GetMarketDepth(context.Background(), &pb.GetMarketDepthRequest{Symbol: "UNKNOWN"})
+
+	// Assert
+	quote, _ := priceOscillationService.Quote("AAPL")
+	assert.NoError(t, err)
+	assert.True(t, resp.ApiResponse.Success)
+	assert.Equal(t, "AAPL", resp.Book.Symbol)
+	assert.Len(t, resp.Book.Bids, DefaultDepthLevels)
+	assert.Len(t, resp.Book.Asks, DefaultDepthLevels)
+	assert.Equal(t, quote.Bid.StringFixed(quote.PricePrecision), resp.Book.Bids[0].PriceDecimal)
+	assert.Equal(t, quote.Ask.StringFixed(quote.PricePrecision), resp.Book.Asks[0].PriceDecimal)
+
+	assert.NoError(t, topErr)
+	assert.Len(t, top.Book.Bids, 3)
+	assert.Equal(t, codes.InvalidArgument, status.Code(tooDeepErr))
+	assert.Equal(t, codes.NotFound, status.Code(notFoundErr))
+}
+
+func TestStreamMarketDepth_SnapshotAndErrors(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockStream := &MockStreamMarketDepthServer{ctx: context.Background()}
+	mockStream.On("Recv").Return(&pb.StreamMarketDepthRequest{
+		Action:  "subscribe",
+		Symbols: []string{"AAPL", "UNKNOWN"},
+		Levels:  5,
+	}, nil).Once()
+	mockStream.On("Recv").Return(nil, io.EOF).Once()
+
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamMarketDepthResponse) bool {
+		return resp.Type == "snapshot"
+	})).Run(func(args mock.Arguments) {
+		book := args.Get(0).(*pb.StreamMarketDepthResponse).Book
+		assert.Equal(t, "AAPL", book.Symbol)
+		assert.Len(t, book.Bids, 5)
+		assert.Len(t, book.Asks, 5)
+	}).Return(nil).Once()
+
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamMarketDepthResponse) bool {
+		return resp.Type == "error"
+	})).Run(func(args mock.Arguments) {
+		assert.Contains(t, args.Get(0).(*pb.StreamMarketDepthResponse).ErrorMessage, "UNKNOWN")
+	}).Return(nil).Once()
+
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamMarketDepthResponse")).Return(nil).Maybe()
+
+	// Act
+	err := server.StreamMarketDepth(mockStream)
+
+	// Assert
+	assert.NoError(t, err)
+	mockStream.AssertExpectations(t)
+}
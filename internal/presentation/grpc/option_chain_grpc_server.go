@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MarketDataGRPCServer) GetOptionChain(ctx context.Context, req *pb.GetOptionChainRequest) (*pb.GetOptionChainResponse, error) {
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var expiry time.Time
+	if req.ExpiryDate != "" {
+		expiry, err = time.Parse(time.DateOnly, req.ExpiryDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("expiry_date %q must be YYYY-MM-DD", req.ExpiryDate))
+		}
+	}
+
+	strikesPerSide := int(req.StrikesPerSide)
+	if strikesPerSide == 0 {
+		strikesPerSide = service.DefaultStrikesPerSide
+	}
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := engine.OptionChains().Chain(symbol, expiry, strikesPerSide)
+	switch {
+	case errors.Is(err, service.ErrUnknownSymbol):
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	case errors.Is(err, service.ErrNoOptions), errors.Is(err, service.ErrUnknownExpiry):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidStrikesPerSide):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetOptionChainResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Retrieved %d strikes expiring %s", len(chain.Calls), chain.Expiry.Format(time.DateOnly)),
+		},
+		Chain: toPBOptionChain(chain),
+	}, nil
+}
+
+func toPBOptionChain(chain model.OptionChain) *pb.OptionChain {
+	expiries := make([]string, len(chain.Expiries))
+	for i, expiry := range chain.Expiries {
+		expiries[i] = expiry.Format(time.DateOnly)
+	}
+
+	return &pb.OptionChain{
+		Underlying:             chain.Underlying.String(),
+		UnderlyingPriceDecimal: chain.UnderlyingPrice.String(),
+		ExpiryDate:             chain.Expiry.Format(time.DateOnly),
+		ExpiryDates:            expiries,
+		Calls:                  toPBOptionQuotes(chain.Calls),
+		Puts:                   toPBOptionQuotes(chain.Puts),
+		RiskFreeRate:           chain.RiskFreeRate,
+		Timestamp:              chain.Timestamp.Format(time.RFC3339Nano),
+	}
+}
+
+func toPBOptionQuotes(quotes []model.OptionQuote) []*pb.OptionQuote {
+	precision := model.AssetClassOption.DefaultPricePrecision()
+
+	pbQuotes := make([]*pb.OptionQuote, len(quotes))
+	for i, quote := range quotes {
+		optionType := pb.OptionType_OPTION_TYPE_CALL
+		if quote.Contract.Type == model.OptionTypePut {
+			optionType = pb.OptionType_OPTION_TYPE_PUT
+		}
+
+		pbQuotes[i] = &pb.OptionQuote{
+			Symbol:            quote.Contract.Symbol(),
+			Type:              optionType,
+			StrikeDecimal:     quote.Contract.Strike.String(),
+			ExpiryDate:        quote.Contract.Expiry.Format(time.DateOnly),
+			PriceDecimal:      model.FormatPrice(quote.Price, precision),
+			BidDecimal:        model.FormatPrice(quote.Bid, precision),
+			AskDecimal:        model.FormatPrice(quote.Ask, precision),
+			BidSize:           quote.BidSize,
+			AskSize:           quote.AskSize,
+			Delta:             quote.Delta,
+			Gamma:             quote.Gamma,
+			Theta:             quote.Theta,
+			Vega:              quote.Vega,
+			ImpliedVolatility: quote.ImpliedVolatility,
+		}
+	}
+	return pbQuotes
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetOptionChain(t *testing.T) {
+	// Arrange
+	priceOscillationService := service.NewPriceOscillationServiceWithOptions(domainService.NewAssetDataService(time.Now()), service.PriceOscillationOptions{
+		Clock: simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)),
+	})
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+	quote, _ := priceOscillationService.Quote("AAPL")
+
+	// Act
+	resp, err := server.GetOptionChain(context.Background(), &pb.GetOptionChainRequest{Symbol: "aapl", ExpiryDate: "2026-04-17", StrikesPerSide: 3})
+	nearest, nearestErr := server.GetOptionChain(context.Background(), &pb.GetOptionChainRequest{Symbol: "AAPL"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, resp.ApiResponse.Success)
+	chain := resp.Chain
+	assert.Equal(t, "AAPL", chain.Underlying)
+	assert.Equal(t, quote.CurrentPrice.String(), chain.UnderlyingPriceDecimal)
+	assert.Equal(t, "2026-04-17", chain.ExpiryDate)
+	assert.Len(t, chain.ExpiryDates, service.DefaultOptionExpiries)
+	require.Len(t, chain.Calls, 7)
+	require.Len(t, chain.Puts, 7)
+
+	call, put := chain.Calls[3], chain.Puts[3]
+	assert.Equal(t, pb.OptionType_OPTION_TYPE_CALL, call.Type)
+	assert.Equal(t, pb.OptionType_OPTION_TYPE_PUT, put.Type)
+	assert.Equal(t, call.StrikeDecimal, put.StrikeDecimal)
+	assert.Regexp(t, `^AAPL260417C\d{8}$`, call.Symbol)
+	assert.Regexp(t, `^\d+\.\d{2}$`, call.PriceDecimal)
+	assert.InDelta(t, 0.5, call.Delta, 0.15)
+	assert.InDelta(t, -0.5, put.Delta, 0.15)
+	assert.Positive(t, call.Gamma)
+	assert.Negative(t, call.Theta)
+	assert.Positive(t, call.Vega)
+	assert.InDelta(t, 0.3, call.ImpliedVolatility, 0.05)
+
+	require.NoError(t, nearestErr)
+	assert.Equal(t, "2026-03-20", nearest.Chain.ExpiryDate)
+	assert.Len(t, nearest.Chain.Calls, 2*service.DefaultStrikesPerSide+1)
+}
+
+func TestGetOptionChain_Errors(t *testing.T) {
+	// Arrange
+	priceOscillationService := service.NewPriceOscillationServiceWithOptions(domainService.NewAssetDataService(time.Now()), service.PriceOscillationOptions{
+		Clock: simulation.NewVirtualClock(time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)),
+	})
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	tests := map[string]struct {
+		request  *pb.GetOptionChainRequest
+		expected codes.Code
+	}{
+		"invalid symbol":    {&pb.GetOptionChainRequest{Symbol: ""}, codes.InvalidArgument},
+		"invalid expiry":    {&pb.GetOptionChainRequest{Symbol: "AAPL", ExpiryDate: "17/04/2026"}, codes.InvalidArgument},
+		"too many strikes":  {&pb.GetOptionChainRequest{Symbol: "AAPL", StrikesPerSide: service.MaxStrikesPerSide + 1}, codes.InvalidArgument},
+		"unknown symbol":    {&pb.GetOptionChainRequest{Symbol: "UNKNOWN"}, codes.NotFound},
+		"no options listed": {&pb.GetOptionChainRequest{Symbol: "EURUSD"}, codes.NotFound},
+		"unlisted expiry":   {&pb.GetOptionChainRequest{Symbol: "AAPL", ExpiryDate: "2026-03-13"}, codes.NotFound},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := server.GetOptionChain(context.Background(), test.request)
+
+			// Assert
+			assert.Equal(t, test.expected, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// streamRetryAfter is the retry hint returned when a client holds too many streams,
+// since there is no way to know when one of its streams will be closed
+const streamRetryAfter = 5 * time.Second
+
+var rateLimitedMethods = map[string]bool{
+	pb.MarketDataService_GetMarketData_FullMethodName:      true,
+	pb.MarketDataService_GetAssetDetails_FullMethodName:    true,
+	pb.MarketDataService_GetBatchMarketData_FullMethodName: true,
+	pb.MarketDataService_GetMarketStatus_FullMethodName:    true,
+	pb.MarketDataService_GetMarketDepth_FullMethodName:     true,
+	pb.MarketDataService_GetRecentTrades_FullMethodName:    true,
+	pb.MarketDataService_GetOptionChain_FullMethodName:     true,
+}
+
+// RateLimitInterceptor applies per-client token bucket limits to unary market data
+// calls and caps concurrent streams and symbols per stream
+type RateLimitInterceptor struct {
+	limiter             *ratelimit.ClientRateLimiter
+	streamQuota         *ratelimit.StreamQuota
+	maxSymbolsPerStream int
+}
+
+func NewRateLimitInterceptor(
+	limiter *ratelimit.ClientRateLimiter,
+	streamQuota *ratelimit.StreamQuota,
+	maxSymbolsPerStream int,
+) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		limiter:             limiter,
+		streamQuota:         streamQuota,
+		maxSymbolsPerStream: maxSymbolsPerStream,
+	}
+}
+
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !rateLimitedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		clientID := clientIdentity(ctx)
+		if allowed, retryAfter := i.limiter.Allow(clientID); !allowed {
+			log.Printf("Rate limit exceeded for %s on %s, retry after %s", clientID, info.FullMethod, retryAfter)
+			return nil, resourceExhaustedError(
+				fmt.Sprintf("rate limit exceeded for client %s", clientID),
+				retryAfter,
+			)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		clientID := clientIdentity(ss.Context())
+
+		if !i.streamQuota.Acquire(clientID) {
+			log.Printf("Stream quota exceeded for %s on %s", clientID, info.FullMethod)
+			return resourceExhaustedError(
+				fmt.Sprintf("client %s has too many concurrent streams", clientID),
+				streamRetryAfter,
+			)
+		}
+		defer i.streamQuota.Release(clientID)
+
+		return handler(srv, &symbolQuotaServerStream{
+			ServerStream:      ss,
+			clientID:          clientID,
+			maxSymbols:        i.maxSymbolsPerStream,
+			subscribedSymbols: make(map[model.Symbol]bool),
+		})
+	}
+}
+
+// clientIdentity identifies the caller by its authenticated principal, falling back to the peer IP
+func clientIdentity(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return "client:" + principal.ClientID
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return "ip:" + host
+	}
+
+	return "anonymous"
+}
+
+func resourceExhaustedError(message string, retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, message)
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// symbolQuotaServerStream tracks the symbols subscribed on a stream and rejects
+// subscriptions that would exceed the per-stream symbol cap
+type symbolQuotaServerStream struct {
+	grpc.ServerStream
+	clientID          string
+	maxSymbols        int
+	subscribedSymbols map[model.Symbol]bool
+}
+
+func (s *symbolQuotaServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	action, ok := m.(actionRequest)
+	if !ok {
+		return nil
+	}
+	request, ok := m.(multiSymbolRequest)
+	if !ok {
+		return nil
+	}
+
+	switch action.GetAction() {
+	case "subscribe":
+		pending := make(map[model.Symbol]bool)
+		for _, rawSymbol := range request.GetSymbols() {
+			symbol, err := model.ParseSymbol(rawSymbol)
+			if err != nil {
+				continue
+			}
+			if !s.subscribedSymbols[symbol] {
+				pending[symbol] = true
+			}
+		}
+
+		if s.maxSymbols > 0 && len(s.subscribedSymbols)+len(pending) > s.maxSymbols {
+			return s.symbolQuotaError(len(s.subscribedSymbols) + len(pending))
+		}
+
+		for symbol := range pending {
+			s.subscribedSymbols[symbol] = true
+		}
+
+	case "unsubscribe":
+		for _, rawSymbol := range request.GetSymbols() {
+			if symbol, err := model.ParseSymbol(rawSymbol); err == nil {
+				delete(s.subscribedSymbols, symbol)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *symbolQuotaServerStream) symbolQuotaError(requested int) error {
+	st := status.New(codes.ResourceExhausted,
+		fmt.Sprintf("stream would subscribe %d symbols, the limit is %d", requested, s.maxSymbols))
+
+	detailed, err := st.WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     s.clientID,
+			Description: fmt.Sprintf("at most %d symbols per stream", s.maxSymbols),
+		}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitInterceptor_Unary_ReturnsRetryInfo(t *testing.T) {
+	// Arrange
+	interceptor := NewRateLimitInterceptor(
+		ratelimit.NewClientRateLimiter(1, 1, time.Minute),
+		ratelimit.NewStreamQuota(1),
+		10,
+	).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: pb.MarketDataService_GetMarketData_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.GetMarketDataResponse{}, nil
+	}
+
+	// Act
+	_, firstErr := interceptor(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL"}, info, handler)
+	_, secondErr := interceptor(context.Background(), &pb.GetMarketDataRequest{Symbol: "AAPL"}, info, handler)
+
+	// Assert
+	assert.NoError(t, firstErr)
+
+	st := status.Convert(secondErr)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Greater(t, retryInfo.RetryDelay.AsDuration(), time.Duration(0))
+}
+
+func TestRateLimitInterceptor_Stream_LimitsConcurrentStreams(t *testing.T) {
+	// Arrange
+	streamQuota := ratelimit.NewStreamQuota(1)
+	interceptor := NewRateLimitInterceptor(ratelimit.NewClientRateLimiter(1, 1, time.Minute), streamQuota, 10).Stream()
+	info := &grpc.StreamServerInfo{FullMethod: pb.MarketDataService_StreamQuotes_FullMethodName}
+
+	// Act
+	var nestedErr error
+	err := interceptor(nil, &MockStreamQuotesServer{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+		nestedErr = interceptor(nil, &MockStreamQuotesServer{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(nestedErr))
+	assert.Equal(t, 0, streamQuota.ActiveStreams("anonymous"))
+}
+
+func TestRateLimitInterceptor_Stream_LimitsSymbolsPerStream(t *testing.T) {
+	// Arrange
+	interceptor := NewRateLimitInterceptor(ratelimit.NewClientRateLimiter(1, 1, time.Minute), ratelimit.NewStreamQuota(5), 2).Stream()
+	info := &grpc.StreamServerInfo{FullMethod: pb.MarketDataService_StreamQuotes_FullMethodName}
+
+	mockStream := &MockStreamQuotesServer{}
+	requests := []*pb.StreamQuotesRequest{
+		{Action: "subscribe", Symbols: []string{"AAPL", "MSFT"}},
+		{Action: "unsubscribe", Symbols: []string{"MSFT"}},
+		{Action: "subscribe", Symbols: []string{"aapl", "TSLA"}},
+		{Action: "subscribe", Symbols: []string{"NVDA"}},
+	}
+	for _, request := range requests {
+		request := request
+		mockStream.On("RecvMsg", mock.AnythingOfType("*marketdatapb.StreamQuotesRequest")).Run(func(args mock.Arguments) {
+			req := args.Get(0).(*pb.StreamQuotesRequest)
+			req.Action = request.Action
+			req.Symbols = request.Symbols
+		}).Return(nil).Once()
+	}
+
+	// Act
+	var errs []error
+	err := interceptor(nil, mockStream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		for range requests {
+			errs = append(errs, stream.RecvMsg(&pb.StreamQuotesRequest{}))
+		}
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, errs[2])
+	assert.Equal(t, codes.ResourceExhausted, status.Code(errs[3]))
+}
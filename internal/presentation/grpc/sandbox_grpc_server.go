@@ -0,0 +1,265 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/usecase"
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// SandboxMetadataKey is the request metadata key naming the sandbox a request is served from
+const SandboxMetadataKey = "x-sandbox"
+
+// sandboxOwner is the client whose sandboxes the request may use: the authenticated client,
+// or nobody in particular when authentication is disabled
+func sandboxOwner(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.ClientID
+	}
+	return ""
+}
+
+// selectEngine returns the engine of the sandbox named by the request metadata together with
+// the sandbox name, or the shared engine and an empty name when the request names none. The
+// sandboxes of other clients are not found.
+func selectEngine(
+	ctx context.Context,
+	shared *service.PriceOscillationService,
+	sandboxes *service.SandboxService,
+) (*service.PriceOscillationService, string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(SandboxMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return shared, "", nil
+	}
+
+	name := values[0]
+	if sandboxes == nil {
+		return nil, "", status.Error(codes.FailedPrecondition, "sandboxes are disabled")
+	}
+
+	engine, err := sandboxes.Engine(name, sandboxOwner(ctx))
+	if errors.Is(err, service.ErrUnknownSandbox) {
+		return nil, "", status.Error(codes.NotFound, fmt.Sprintf("sandbox %s not found", name))
+	}
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
+	return engine, name, nil
+}
+
+// getSandboxMarketData serves market data from the simulated quote of a sandbox; sandboxes
+// only know the instruments they simulate
+func (s *MarketDataGRPCServer) getSandboxMarketData(
+	engine *service.PriceOscillationService,
+	rawSymbol string,
+	conversion currencyConversion,
+) (*pb.GetMarketDataResponse, error) {
+	symbol, err := model.ParseSymbol(rawSymbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quote, exists := engine.Quote(symbol)
+	if !exists {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	}
+
+	pbMarketData, err := toPBSandboxMarketData(quote, conversion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMarketDataResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: "Market data retrieved successfully",
+		},
+		MarketData: pbMarketData,
+	}, nil
+}
+
+// getSandboxBatchMarketData serves a batch from the simulated quotes of a sandbox, with the
+// symbols it does not simulate reported as not found. The batch is checked and normalized by
+// the batch use case, like the batches served from the stored market data.
+func (s *MarketDataGRPCServer) getSandboxBatchMarketData(
+	engine *service.PriceOscillationService,
+	rawSymbols []string,
+	conversion currencyConversion,
+) (*pb.GetBatchMarketDataResponse, error) {
+	batch, err := s.getBatchMarketDataUsecase.Normalize(rawSymbols)
+	if errors.Is(err, usecase.ErrBatchTooLarge) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get market data: %v", err))
+	}
+
+	pbMarketData := make([]*pb.MarketData, 0, len(batch.Results))
+	for i := range batch.Results {
+		result := &batch.Results[i]
+		if result.Status == usecase.SymbolStatusInvalid {
+			continue
+		}
+
+		quote, exists := engine.Quote(result.Symbol)
+		if !exists {
+			result.Status = usecase.SymbolStatusNotFound
+			continue
+		}
+
+		pbData, err := toPBSandboxMarketData(quote, conversion)
+		if err != nil {
+			return nil, err
+		}
+		result.Status = usecase.SymbolStatusFound
+		pbMarketData = append(pbMarketData, pbData)
+	}
+
+	return &pb.GetBatchMarketDataResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Retrieved %d of %d market data items", len(pbMarketData), len(batch.Results)),
+		},
+		MarketData: pbMarketData,
+		Results:    toPBSymbolResults(batch.Results),
+	}, nil
+}
+
+// toPBSandboxMarketData maps the simulated quote of a sandbox, with its prices in the currency
+// of the conversion
+func toPBSandboxMarketData(quote model.AssetQuote, conversion currencyConversion) (*pb.MarketData, error) {
+	rate, converts, err := conversion.rate(quote.Currency)
+	if err != nil {
+		return nil, err
+	}
+	instrumentCurrency := ""
+	fxRate := ""
+	if converts {
+		instrumentCurrency = quote.Currency
+		fxRate = rate.String()
+		quote = quote.InCurrency(conversion.target, rate)
+	}
+
+	assetClass := toPBAssetClass(quote.AssetClass)
+	return withAssetClassFields(&pb.MarketData{
+		Symbol:              quote.Symbol,
+		CompanyName:         quote.Name,
+		CurrentPrice:        quote.CurrentPrice.InexactFloat64(),
+		PreviousClose:       quote.PreviousClose.InexactFloat64(),
+		OpenPrice:           quote.OpenPrice.InexactFloat64(),
+		HighPrice:           quote.HighPrice.InexactFloat64(),
+		LowPrice:            quote.LowPrice.InexactFloat64(),
+		Change:              quote.Change.InexactFloat64(),
+		ChangePercent:       quote.ChangePercent.InexactFloat64(),
+		Volume:              quote.Volume,
+		LastUpdated:         quote.LastUpdated.Format(time.RFC3339),
+		Category:            int32(assetClass),
+		AssetClass:          assetClass,
+		CurrentPriceDecimal: quote.FormatPrice(quote.CurrentPrice),
+		PricePrecision:      quote.PricePrecision,
+		BidDecimal:          quote.FormatPrice(quote.Bid),
+		AskDecimal:          quote.FormatPrice(quote.Ask),
+		BidSize:             quote.BidSize,
+		AskSize:             quote.AskSize,
+		VwapDecimal:         model.FormatVWAP(quote.VWAP, quote.PricePrecision),
+		TickSizeDecimal:     formatTickSize(quote.Instrument),
+		LotSize:             quote.LotSize,
+		Currency:            quote.Currency,
+		InstrumentCurrency:  instrumentCurrency,
+		FxRateDecimal:       fxRate,
+	}, quote), nil
+}
+
+func (s *MarketDataAdminGRPCServer) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequest) (*pb.CreateSandboxResponse, error) {
+	if s.sandboxes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "sandboxes are disabled")
+	}
+
+	log.Printf("gRPC CreateSandbox called for sandbox: %s", req.Name)
+
+	sandbox, err := s.sandboxes.Create(req.Name, sandboxOwner(ctx), req.Seed)
+	switch {
+	case errors.Is(err, service.ErrInvalidSandboxName):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrSandboxExists):
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("sandbox %s already exists", req.Name))
+	case errors.Is(err, service.ErrTooManySandboxes):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CreateSandboxResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Sandbox %s created", sandbox.Name),
+		},
+		Sandbox: toPBSandbox(sandbox),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRequest) (*pb.DestroySandboxResponse, error) {
+	if s.sandboxes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "sandboxes are disabled")
+	}
+
+	log.Printf("gRPC DestroySandbox called for sandbox: %s", req.Name)
+
+	sandbox, err := s.sandboxes.Destroy(req.Name, sandboxOwner(ctx))
+	if errors.Is(err, service.ErrUnknownSandbox) {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("sandbox %s not found", req.Name))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DestroySandboxResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Sandbox %s destroyed", sandbox.Name),
+		},
+		Sandbox: toPBSandbox(sandbox),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) ListSandboxes(ctx context.Context, req *pb.ListSandboxesRequest) (*pb.ListSandboxesResponse, error) {
+	var sandboxes []service.Sandbox
+	if s.sandboxes != nil {
+		sandboxes = s.sandboxes.List(sandboxOwner(ctx))
+	}
+
+	pbSandboxes := make([]*pb.Sandbox, 0, len(sandboxes))
+	for _, sandbox := range sandboxes {
+		pbSandboxes = append(pbSandboxes, toPBSandbox(sandbox))
+	}
+
+	return &pb.ListSandboxesResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("%d sandboxes running", len(sandboxes)),
+		},
+		Sandboxes: pbSandboxes,
+	}, nil
+}
+
+func toPBSandbox(sandbox service.Sandbox) *pb.Sandbox {
+	return &pb.Sandbox{
+		Name:       sandbox.Name,
+		Seed:       sandbox.Seed,
+		CreatedAt:  sandbox.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: sandbox.LastUsed.Format(time.RFC3339),
+		ExpiresAt:  sandbox.ExpiresAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,342 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/usecase"
+	"github.com/RodriguesYan/hub-market-data-service/internal/auth"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestSandboxServers(t *testing.T) (*MarketDataGRPCServer, *MarketDataAdminGRPCServer, *service.PriceOscillationService) {
+	shared := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	t.Cleanup(shared.Stop)
+
+	sandboxes := service.NewSandboxService(func(random *simulation.Random) (*service.PriceOscillationService, error) {
+		return service.NewPriceOscillationServiceWithOptions(domainService.NewAssetDataService(time.Now()), service.PriceOscillationOptions{Random: random}), nil
+	}, time.Hour, 5, nil)
+	t.Cleanup(sandboxes.DestroyAll)
+
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, shared, sandboxes)
+	return server, NewMarketDataAdminGRPCServer(shared, sandboxes), shared
+}
+
+func sandboxContext(name string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(SandboxMetadataKey, name))
+}
+
+func TestSandbox_IsolatesHaltsAndPrices(t *testing.T) {
+	// Arrange
+	server, adminServer, shared := newTestSandboxServers(t)
+	createResp, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa-alice", Seed: 7})
+	require.NoError(t, err)
+
+	// Act
+	_, haltErr := adminServer.HaltSymbol(sandboxContext("qa-alice"), &pb.HaltSymbolRequest{Symbol: "AAPL", Message: "QA"})
+	_, scenarioErr := adminServer.StartScenario(sandboxContext("qa-alice"), &pb.StartScenarioRequest{
+		Definition: "{name: gap, events: [{type: shock, symbols: [MSFT], percent: 10}]}",
+	})
+	marketDataResp, marketDataErr := server.GetMarketData(sandboxContext("qa-alice"), &pb.GetMarketDataRequest{Symbol: "msft"})
+	listResp, listErr := adminServer.ListSandboxes(context.Background(), &pb.ListSandboxesRequest{})
+
+	// Assert
+	assert.Equal(t, "qa-alice", createResp.Sandbox.Name)
+	assert.Equal(t, int64(7), createResp.Sandbox.Seed)
+
+	require.NoError(t, haltErr)
+	require.NoError(t, scenarioErr)
+	assert.Equal(t, model.MarketStatusOpen, shared.MarketStatus("AAPL").Status)
+	_, sharedScenarioRunning := shared.ScenarioStatus()
+	assert.False(t, sharedScenarioRunning)
+
+	// The sandbox serves its own simulated quote, moved by its scenario
+	engine, err := adminServer.sandboxes.Engine("qa-alice", "")
+	require.NoError(t, err)
+	sandboxQuote, _ := engine.Quote("MSFT")
+	sharedQuote, _ := shared.Quote("MSFT")
+	assert.False(t, sandboxQuote.BasePrice.Equal(sharedQuote.BasePrice))
+
+	require.NoError(t, marketDataErr)
+	assert.Equal(t, "MSFT", marketDataResp.MarketData.Symbol)
+	assert.Equal(t, model.FormatPrice(sandboxQuote.CurrentPrice, sandboxQuote.PricePrecision), marketDataResp.MarketData.CurrentPriceDecimal)
+	assert.Equal(t, model.FormatPrice(sandboxQuote.Bid, sandboxQuote.PricePrecision), marketDataResp.MarketData.BidDecimal)
+
+	require.NoError(t, listErr)
+	require.Len(t, listResp.Sandboxes, 1)
+	assert.Equal(t, "qa-alice", listResp.Sandboxes[0].Name)
+}
+
+func TestSandbox_ServesBatchDetailsAndStatus(t *testing.T) {
+	// Arrange
+	shared := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	t.Cleanup(shared.Stop)
+
+	var sandboxAssets *domainService.AssetDataService
+	sandboxes := service.NewSandboxService(func(random *simulation.Random) (*service.PriceOscillationService, error) {
+		sandboxAssets = domainService.NewAssetDataService(time.Now())
+		return service.NewPriceOscillationServiceWithOptions(sandboxAssets, service.PriceOscillationOptions{Random: random}), nil
+	}, time.Hour, 5, nil)
+	t.Cleanup(sandboxes.DestroyAll)
+
+	// The batch use case only normalizes sandbox batches, it never reads the repository
+	batchUsecase := usecase.NewGetBatchMarketDataUseCase(nil, 4)
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, batchUsecase, shared, sandboxes)
+	adminServer := NewMarketDataAdminGRPCServer(shared, sandboxes)
+	_, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa", Seed: 7})
+	require.NoError(t, err)
+	_, err = adminServer.HaltSymbol(sandboxContext("qa"), &pb.HaltSymbolRequest{Symbol: "AAPL", Message: "QA"})
+	require.NoError(t, err)
+	sandboxQuote, _ := sandboxAssets.Update("MSFT", func(quote model.AssetQuote) model.AssetQuote {
+		return quote.WithPrice(quote.CurrentPrice.Mul(decimal.RequireFromString("1.1")), time.Now())
+	})
+
+	// Act
+	batchResp, batchErr := server.GetBatchMarketData(sandboxContext("qa"), &pb.GetBatchMarketDataRequest{
+		Symbols: []string{"msft", "MSFT", "ZZZZ", "BAD SYMBOL"},
+	})
+	_, tooLargeErr := server.GetBatchMarketData(sandboxContext("qa"), &pb.GetBatchMarketDataRequest{
+		Symbols: []string{"AAPL", "MSFT", "GOOGL", "AMZN", "NVDA"},
+	})
+	detailsResp, detailsErr := server.GetAssetDetails(sandboxContext("qa"), &pb.GetAssetDetailsRequest{Symbol: "MSFT"})
+	statusResp, statusErr := server.GetMarketStatus(sandboxContext("qa"), &pb.GetMarketStatusRequest{Symbols: []string{"AAPL"}})
+	sharedStatusResp, sharedStatusErr := server.GetMarketStatus(context.Background(), &pb.GetMarketStatusRequest{Symbols: []string{"AAPL"}})
+
+	// Assert
+	sandboxPrice := model.FormatPrice(sandboxQuote.CurrentPrice, sandboxQuote.PricePrecision)
+	sharedQuote, _ := shared.Quote("MSFT")
+	require.NotEqual(t, model.FormatPrice(sharedQuote.CurrentPrice, sharedQuote.PricePrecision), sandboxPrice)
+
+	// The batch is served from the sandbox quotes without reading the stored market data
+	require.NoError(t, batchErr)
+	require.Len(t, batchResp.MarketData, 1)
+	assert.Equal(t, sandboxPrice, batchResp.MarketData[0].CurrentPriceDecimal)
+	require.Len(t, batchResp.Results, 3)
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_FOUND, batchResp.Results[0].Status)
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_NOT_FOUND, batchResp.Results[1].Status)
+	assert.Equal(t, "ZZZZ", batchResp.Results[1].Symbol)
+	assert.Equal(t, pb.SymbolStatus_SYMBOL_STATUS_INVALID, batchResp.Results[2].Status)
+	assert.Equal(t, codes.InvalidArgument, status.Code(tooLargeErr))
+
+	require.NoError(t, detailsErr)
+	assert.Equal(t, sandboxPrice, detailsResp.Asset.Quote.CurrentPriceDecimal)
+
+	require.NoError(t, statusErr)
+	require.Len(t, statusResp.Symbols, 1)
+	assert.Equal(t, pb.MarketStatus_MARKET_STATUS_HALTED, statusResp.Symbols[0].Status)
+	require.NoError(t, sharedStatusErr)
+	assert.Equal(t, pb.MarketStatus_MARKET_STATUS_OPEN, sharedStatusResp.Symbols[0].Status)
+}
+
+func TestSandbox_ServesDepthAndTrades(t *testing.T) {
+	// Arrange
+	shared := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	t.Cleanup(shared.Stop)
+
+	var sandboxAssets *domainService.AssetDataService
+	var sandboxTrades *service.TradeTapeService
+	sandboxes := service.NewSandboxService(func(random *simulation.Random) (*service.PriceOscillationService, error) {
+		sandboxAssets = domainService.NewAssetDataService(time.Now())
+		sandboxTrades = service.NewTradeTapeService(domainService.NewDefaultSpreadService(), 100, random.Fork())
+		return service.NewPriceOscillationServiceWithOptions(sandboxAssets, service.PriceOscillationOptions{
+			Trades: sandboxTrades,
+			Random: random,
+		}), nil
+	}, time.Hour, 5, nil)
+	t.Cleanup(sandboxes.DestroyAll)
+
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, shared, sandboxes)
+	adminServer := NewMarketDataAdminGRPCServer(shared, sandboxes)
+	_, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa", Seed: 7})
+	require.NoError(t, err)
+	engine, err := sandboxes.Engine("qa", "")
+	require.NoError(t, err)
+
+	previous, _ := engine.Quote("MSFT")
+	sandboxQuote, _ := sandboxAssets.Update("MSFT", func(quote model.AssetQuote) model.AssetQuote {
+		moved := quote.WithPrice(quote.CurrentPrice.Mul(decimal.RequireFromString("1.1")), time.Now())
+		return moved.WithBidAsk(moved.CurrentPrice.Sub(decimal.RequireFromString("0.05")), moved.CurrentPrice.Add(decimal.RequireFromString("0.05")), 100, 100)
+	})
+	_, printed := sandboxTrades.Print(previous, sandboxQuote, time.Now())
+	require.NotEmpty(t, printed)
+
+	// Act
+	depthResp, depthErr := server.GetMarketDepth(sandboxContext("qa"), &pb.GetMarketDepthRequest{Symbol: "MSFT", Levels: 1})
+	sharedDepthResp, sharedDepthErr := server.GetMarketDepth(context.Background(), &pb.GetMarketDepthRequest{Symbol: "MSFT", Levels: 1})
+	tradesResp, tradesErr := server.GetRecentTrades(sandboxContext("qa"), &pb.GetRecentTradesRequest{Symbol: "MSFT"})
+	sharedTradesResp, sharedTradesErr := server.GetRecentTrades(context.Background(), &pb.GetRecentTradesRequest{Symbol: "MSFT"})
+
+	// Assert
+	require.NoError(t, depthErr)
+	require.NoError(t, sharedDepthErr)
+	sandboxBid := model.FormatPrice(sandboxQuote.Bid, sandboxQuote.PricePrecision)
+	assert.Equal(t, sandboxBid, depthResp.Book.Bids[0].PriceDecimal)
+	assert.NotEqual(t, sandboxBid, sharedDepthResp.Book.Bids[0].PriceDecimal)
+
+	require.NoError(t, tradesErr)
+	require.Len(t, tradesResp.Trades, len(printed))
+	assert.Equal(t, printed[len(printed)-1].ID, tradesResp.Trades[0].TradeId)
+	require.NoError(t, sharedTradesErr)
+	assert.Empty(t, sharedTradesResp.Trades)
+}
+
+func TestSandbox_StreamsDepthAndTrades(t *testing.T) {
+	// Arrange
+	server, adminServer, shared := newTestSandboxServers(t)
+	_, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa"})
+	require.NoError(t, err)
+	engine, err := adminServer.sandboxes.Engine("qa", "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(sandboxContext("qa"))
+	defer cancel()
+
+	depthStream := &MockStreamMarketDepthServer{ctx: ctx}
+	depthStream.On("Recv").Return(&pb.StreamMarketDepthRequest{Action: "subscribe", Symbols: []string{"AAPL"}}, nil).Once()
+	depthStream.On("Recv").Run(func(mock.Arguments) { <-ctx.Done() }).Return(nil, context.Canceled).Maybe()
+	depthStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamMarketDepthResponse")).Return(nil).Maybe()
+
+	tradeStream := &MockStreamTradesServer{ctx: ctx}
+	tradeStream.On("Recv").Return(&pb.StreamTradesRequest{Action: "subscribe", Symbols: []string{"AAPL"}}, nil).Once()
+	tradeStream.On("Recv").Run(func(mock.Arguments) { <-ctx.Done() }).Return(nil, context.Canceled).Maybe()
+	tradeStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamTradesResponse")).Return(nil).Maybe()
+
+	// Act
+	depthDone := make(chan error, 1)
+	tradesDone := make(chan error, 1)
+	go func() { depthDone <- server.StreamMarketDepth(depthStream) }()
+	go func() { tradesDone <- server.StreamTrades(tradeStream) }()
+
+	// Assert: both streams subscribe to the sandbox engine, not the shared one
+	require.Eventually(t, func() bool { return engine.SubscriberCount() == 2 }, time.Second, 5*time.Millisecond)
+	assert.Zero(t, shared.SubscriberCount())
+
+	cancel()
+	<-depthDone
+	<-tradesDone
+	assert.Zero(t, engine.SubscriberCount())
+
+	_, depthErr := server.GetMarketDepth(sandboxContext("nobody"), &pb.GetMarketDepthRequest{Symbol: "AAPL"})
+	_, tradesErr := server.GetRecentTrades(sandboxContext("nobody"), &pb.GetRecentTradesRequest{Symbol: "AAPL"})
+	assert.Equal(t, codes.NotFound, status.Code(depthErr))
+	assert.Equal(t, codes.NotFound, status.Code(tradesErr))
+}
+
+func TestSandbox_Errors(t *testing.T) {
+	// Arrange
+	server, adminServer, _ := newTestSandboxServers(t)
+	_, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa"})
+	require.NoError(t, err)
+
+	// Act
+	_, existsErr := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa"})
+	_, invalidErr := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "q a"})
+	_, unknownErr := server.GetMarketData(sandboxContext("nobody"), &pb.GetMarketDataRequest{Symbol: "AAPL"})
+	_, unknownSymbolErr := server.GetMarketData(sandboxContext("qa"), &pb.GetMarketDataRequest{Symbol: "ZZZZ"})
+	_, unknownBatchErr := server.GetBatchMarketData(sandboxContext("nobody"), &pb.GetBatchMarketDataRequest{Symbols: []string{"AAPL"}})
+	_, unknownDetailsErr := server.GetAssetDetails(sandboxContext("nobody"), &pb.GetAssetDetailsRequest{Symbol: "AAPL"})
+	_, unknownStatusErr := server.GetMarketStatus(sandboxContext("nobody"), &pb.GetMarketStatusRequest{})
+	_, destroyErr := adminServer.DestroySandbox(context.Background(), &pb.DestroySandboxRequest{Name: "qa"})
+	_, destroyedErr := server.GetMarketData(sandboxContext("qa"), &pb.GetMarketDataRequest{Symbol: "AAPL"})
+	_, destroyAgainErr := adminServer.DestroySandbox(context.Background(), &pb.DestroySandboxRequest{Name: "qa"})
+
+	// Assert
+	assert.Equal(t, codes.AlreadyExists, status.Code(existsErr))
+	assert.Equal(t, codes.InvalidArgument, status.Code(invalidErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownSymbolErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownBatchErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownDetailsErr))
+	assert.Equal(t, codes.NotFound, status.Code(unknownStatusErr))
+	require.NoError(t, destroyErr)
+	assert.Equal(t, codes.NotFound, status.Code(destroyedErr))
+	assert.Equal(t, codes.NotFound, status.Code(destroyAgainErr))
+}
+
+func TestSandbox_ScopedToOwner(t *testing.T) {
+	// Arrange
+	server, adminServer, _ := newTestSandboxServers(t)
+	alice := auth.WithPrincipal(context.Background(), &auth.Principal{ClientID: "qa-alice"})
+	bob := auth.WithPrincipal(context.Background(), &auth.Principal{ClientID: "qa-bob"})
+	_, err := adminServer.CreateSandbox(alice, &pb.CreateSandboxRequest{Name: "qa"})
+	require.NoError(t, err)
+
+	inSandbox := func(ctx context.Context) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(SandboxMetadataKey, "qa"))
+	}
+
+	// Act
+	_, bobSelectErr := server.GetMarketData(inSandbox(bob), &pb.GetMarketDataRequest{Symbol: "AAPL"})
+	_, bobDestroyErr := adminServer.DestroySandbox(bob, &pb.DestroySandboxRequest{Name: "qa"})
+	bobList, bobListErr := adminServer.ListSandboxes(bob, &pb.ListSandboxesRequest{})
+	_, aliceSelectErr := server.GetMarketData(inSandbox(alice), &pb.GetMarketDataRequest{Symbol: "AAPL"})
+	_, aliceDestroyErr := adminServer.DestroySandbox(alice, &pb.DestroySandboxRequest{Name: "qa"})
+
+	// Assert: other clients do not see the sandbox
+	assert.Equal(t, codes.NotFound, status.Code(bobSelectErr))
+	assert.Equal(t, codes.NotFound, status.Code(bobDestroyErr))
+	require.NoError(t, bobListErr)
+	assert.Empty(t, bobList.Sandboxes)
+	assert.NoError(t, aliceSelectErr)
+	assert.NoError(t, aliceDestroyErr)
+}
+
+func TestSandbox_Disabled(t *testing.T) {
+	// Arrange
+	shared := service.NewPriceOscillationService(domainService.NewAssetDataService(time.Now()))
+	defer shared.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, shared, nil)
+	adminServer := NewMarketDataAdminGRPCServer(shared, nil)
+
+	// Act
+	_, createErr := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa"})
+	_, selectErr := server.GetMarketData(sandboxContext("qa"), &pb.GetMarketDataRequest{Symbol: "AAPL"})
+
+	// Assert
+	assert.Equal(t, codes.FailedPrecondition, status.Code(createErr))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(selectErr))
+}
+
+func TestStreamQuotes_Sandbox(t *testing.T) {
+	// Arrange
+	server, adminServer, _ := newTestSandboxServers(t)
+	_, err := adminServer.CreateSandbox(context.Background(), &pb.CreateSandboxRequest{Name: "qa"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(sandboxContext("qa"))
+	defer cancel()
+	mockStream := &MockStreamQuotesServer{ctx: ctx}
+	mockStream.On("Recv").Return(&pb.StreamQuotesRequest{Action: "subscribe", Symbols: []string{"AAPL"}}, nil).Once()
+	mockStream.On("Recv").Run(func(mock.Arguments) { <-ctx.Done() }).Return(nil, context.Canceled).Maybe()
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamQuotesResponse")).Return(nil).Maybe()
+
+	// Act: destroying the sandbox ends its streams
+	done := make(chan error, 1)
+	go func() { done <- server.StreamQuotes(mockStream) }()
+
+	engine, err := adminServer.sandboxes.Engine("qa", "")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return engine.SubscriberCount() == 1 }, time.Second, 5*time.Millisecond)
+	_, err = adminServer.DestroySandbox(context.Background(), &pb.DestroySandboxRequest{Name: "qa"})
+	require.NoError(t, err)
+
+	// Assert
+	select {
+	case streamErr := <-done:
+		assert.NoError(t, streamErr)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not end when its sandbox was destroyed")
+	}
+}
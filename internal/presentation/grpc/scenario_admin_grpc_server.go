@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/scenario"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MarketDataAdminGRPCServer) StartScenario(ctx context.Context, req *pb.StartScenarioRequest) (*pb.StartScenarioResponse, error) {
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var scenarioStatus service.ScenarioStatus
+
+	switch {
+	case req.Name != "":
+		log.Printf("gRPC StartScenario called for scenario: %s", req.Name)
+		scenarioStatus, err = engine.StartNamedScenario(req.Name)
+	case req.Definition != "":
+		var definition model.Scenario
+		definition, err = scenario.ParseScenario([]byte(req.Definition))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		log.Printf("gRPC StartScenario called with definition: %s", definition.Name)
+		scenarioStatus, err = engine.StartScenario(definition)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "a scenario name or definition is required")
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUnknownScenario):
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("scenario %s not found", req.Name))
+	case errors.Is(err, model.ErrInvalidScenario):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.StartScenarioResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Scenario %s started", scenarioStatus.Name),
+		},
+		Scenario: toPBScenarioStatus(scenarioStatus),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) StopScenario(ctx context.Context, req *pb.StopScenarioRequest) (*pb.StopScenarioResponse, error) {
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarioStatus, err := engine.StopScenario()
+	if errors.Is(err, service.ErrNoScenarioRunning) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.StopScenarioResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Scenario %s stopped", scenarioStatus.Name),
+		},
+		Scenario: toPBScenarioStatus(scenarioStatus),
+	}, nil
+}
+
+func (s *MarketDataAdminGRPCServer) GetScenarioStatus(ctx context.Context, req *pb.GetScenarioStatusRequest) (*pb.GetScenarioStatusResponse, error) {
+	engine, err := s.engineFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetScenarioStatusResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: "No scenario running",
+		},
+		AvailableScenarios: engine.ScenarioNames(),
+	}
+
+	if scenarioStatus, running := engine.ScenarioStatus(); running {
+		response.ApiResponse.Message = fmt.Sprintf("Scenario %s running", scenarioStatus.Name)
+		response.Scenario = toPBScenarioStatus(scenarioStatus)
+	}
+
+	return response, nil
+}
+
+func toPBScenarioStatus(scenarioStatus service.ScenarioStatus) *pb.ScenarioStatus {
+	return &pb.ScenarioStatus{
+		Name:          scenarioStatus.Name,
+		Description:   scenarioStatus.Description,
+		StartedAt:     scenarioStatus.StartedAt.Format(time.RFC3339),
+		EventsApplied: int32(scenarioStatus.EventsApplied),
+		EventsTotal:   int32(scenarioStatus.EventsTotal),
+		Completed:     scenarioStatus.Completed,
+	}
+}
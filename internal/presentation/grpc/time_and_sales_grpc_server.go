@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	"github.com/RodriguesYan/hub-market-data-service/internal/domain/model"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/RodriguesYan/hub-proto-contracts/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRecentTrades is the number of trades returned when a request does not set a limit
+const DefaultRecentTrades = 50
+
+func (s *MarketDataGRPCServer) GetRecentTrades(ctx context.Context, req *pb.GetRecentTradesRequest) (*pb.GetRecentTradesResponse, error) {
+	symbol, err := model.ParseSymbol(req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTrades := engine.TradeHistory()
+	limit := int(req.Limit)
+	switch {
+	case limit < 0 || limit > maxTrades:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("limit must be between 1 and %d", maxTrades))
+	case limit == 0:
+		limit = min(DefaultRecentTrades, maxTrades)
+	}
+
+	if _, exists := engine.Quote(symbol); !exists {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("symbol %s not found", symbol))
+	}
+
+	trades := engine.RecentTrades(symbol, limit)
+	pbTrades := make([]*pb.Trade, len(trades))
+	for i, trade := range trades {
+		pbTrades[i] = toPBTrade(trade)
+	}
+
+	return &pb.GetRecentTradesResponse{
+		ApiResponse: &common.APIResponse{
+			Success: true,
+			Message: fmt.Sprintf("Retrieved %d trades", len(trades)),
+		},
+		Trades: pbTrades,
+	}, nil
+}
+
+// StreamTrades sends every trade printed for the subscribed symbols, oldest first
+func (s *MarketDataGRPCServer) StreamTrades(stream pb.MarketDataService_StreamTradesServer) error {
+	ctx := stream.Context()
+
+	engine, _, err := selectEngine(ctx, s.priceOscillationService, s.sandboxes)
+	if err != nil {
+		return err
+	}
+
+	// Owned by the send loop below, like the subscription state of StreamQuotes
+	subscribedSymbols := make(map[model.Symbol]bool)
+	var subscriberID string
+	var tradeChannel <-chan service.MarketUpdate
+
+	errChan := make(chan error, 1)
+	requestChan := make(chan *pb.StreamTradesRequest)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				errChan <- nil
+				return
+			}
+			if err != nil {
+				log.Printf("Error receiving from trade stream: %v", err)
+				errChan <- err
+				return
+			}
+
+			select {
+			case requestChan <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	resubscribe := func() {
+		if subscriberID != "" {
+			engine.UnsubscribeTrades(subscriberID)
+			subscriberID = ""
+			tradeChannel = nil
+		}
+		if len(subscribedSymbols) > 0 {
+			subscriberID, tradeChannel = engine.SubscribeTrades(subscribedSymbols)
+		}
+	}
+
+	heartbeatTicker := time.NewTicker(30 * time.Second)
+	defer heartbeatTicker.Stop()
+
+	defer func() {
+		if subscriberID != "" {
+			engine.UnsubscribeTrades(subscriberID)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errChan:
+			return err
+
+		case req := <-requestChan:
+			switch req.Action {
+			case "subscribe":
+				var errorMessages []string
+				for _, rawSymbol := range req.Symbols {
+					symbol, err := model.ParseSymbol(rawSymbol)
+					if err != nil {
+						errorMessages = append(errorMessages, err.Error())
+						continue
+					}
+					if _, exists := engine.Quote(symbol); !exists {
+						errorMessages = append(errorMessages, fmt.Sprintf("symbol %s not found", symbol))
+						continue
+					}
+					subscribedSymbols[symbol] = true
+				}
+				resubscribe()
+
+				for _, errorMessage := range errorMessages {
+					if err := stream.Send(&pb.StreamTradesResponse{
+						Type:         "error",
+						ErrorMessage: errorMessage,
+					}); err != nil {
+						return err
+					}
+				}
+
+			case "unsubscribe":
+				for _, rawSymbol := range req.Symbols {
+					if symbol, err := model.ParseSymbol(rawSymbol); err == nil {
+						delete(subscribedSymbols, symbol)
+					}
+				}
+				if subscriberID != "" {
+					resubscribe()
+				}
+			}
+
+		case <-heartbeatTicker.C:
+			if err := stream.Send(&pb.StreamTradesResponse{Type: "heartbeat"}); err != nil {
+				return err
+			}
+
+		case update, ok := <-tradeChannel:
+			if !ok {
+				return nil
+			}
+
+			for _, trades := range update.Trades {
+				for _, trade := range trades {
+					if err := stream.Send(&pb.StreamTradesResponse{
+						Type:  "trade",
+						Trade: toPBTrade(trade),
+					}); err != nil {
+						log.Printf("Failed to send trade: %v", err)
+						return err
+					}
+				}
+			}
+		}
+	}
+}
+
+var pbTradeSides = map[model.TradeSide]pb.TradeSide{
+	model.TradeSideBuy:  pb.TradeSide_TRADE_SIDE_BUY,
+	model.TradeSideSell: pb.TradeSide_TRADE_SIDE_SELL,
+}
+
+func toPBTrade(trade model.Trade) *pb.Trade {
+	return &pb.Trade{
+		TradeId:      trade.ID,
+		Symbol:       trade.Symbol,
+		PriceDecimal: trade.FormatPrice(trade.Price),
+		Size:         trade.Size,
+		Side:         pbTradeSides[trade.Side],
+		Timestamp:    trade.Timestamp.Format(time.RFC3339Nano),
+		Volume:       trade.Volume,
+		VwapDecimal:  model.FormatVWAP(trade.VWAP, trade.PricePrecision),
+	}
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"github.com/RodriguesYan/hub-market-data-service/internal/simulation"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/RodriguesYan/hub-market-data-service/internal/application/service"
+	domainService "github.com/RodriguesYan/hub-market-data-service/internal/domain/service"
+	pb "github.com/RodriguesYan/hub-market-data-service/internal/infrastructure/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MockStreamTradesServer is a mock implementation of MarketDataService_StreamTradesServer
+type MockStreamTradesServer struct {
+	mock.Mock
+	ctx context.Context
+}
+
+func (m *MockStreamTradesServer) Send(response *pb.StreamTradesResponse) error {
+	args := m.Called(response)
+	return args.Error(0)
+}
+
+func (m *MockStreamTradesServer) Recv() (*pb.StreamTradesRequest, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.StreamTradesRequest), args.Error(1)
+}
+
+func (m *MockStreamTradesServer) Context() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+	return m.ctx
+}
+
+func (m *MockStreamTradesServer) SendMsg(msg interface{}) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+func (m *MockStreamTradesServer) RecvMsg(msg interface{}) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+func (m *MockStreamTradesServer) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+func (m *MockStreamTradesServer) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+func (m *MockStreamTradesServer) SetTrailer(md metadata.MD) {
+}
+
+func TestGetRecentTrades(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	trades := service.NewTradeTapeService(domainService.NewDefaultSpreadService(), 100, simulation.NewRandom(1))
+	priceOscillationService := service.NewPriceOscillationServiceWithOptions(assetDataService, service.PriceOscillationOptions{
+		Trades: trades,
+	})
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	quote, _ := priceOscillationService.Quote("AAPL")
+	_, printed := trades.Print(quote, quote, time.Now())
+	last := printed[len(printed)-1]
+
+	// Act
+	resp, err := server.GetRecentTrades(context.Background(), &pb.GetRecentTradesRequest{Symbol: "aapl", Limit: 1})
+	empty, emptyErr := server.GetRecentTrades(context.Background(), &pb.GetRecentTradesRequest{Symbol: "MSFT"})
+	_, tooManyErr := server.GetRecentTrades(context.Background(), &pb.GetRecentTradesRequest{Symbol: "AAPL", Limit: 101})
+	_, notFoundErr := server.GetRecentTrades(context.Background(), &pb.GetRecentTradesRequest{Symbol: "UNKNOWN"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, resp.ApiResponse.Success)
+	assert.Len(t, resp.Trades, 1)
+	assert.Equal(t, last.ID, resp.Trades[0].TradeId)
+	assert.Equal(t, last.Price.StringFixed(quote.PricePrecision), resp.Trades[0].PriceDecimal)
+	assert.Equal(t, last.Size, resp.Trades[0].Size)
+	assert.Equal(t, last.Volume, resp.Trades[0].Volume)
+	assert.NotEqual(t, pb.TradeSide_TRADE_SIDE_UNSPECIFIED, resp.Trades[0].Side)
+	assert.NotEmpty(t, resp.Trades[0].VwapDecimal)
+
+	assert.NoError(t, emptyErr)
+	assert.Empty(t, empty.Trades)
+	assert.Equal(t, codes.InvalidArgument, status.Code(tooManyErr))
+	assert.Equal(t, codes.NotFound, status.Code(notFoundErr))
+}
+
+func TestStreamTrades_InvalidSymbol(t *testing.T) {
+	// Arrange
+	assetDataService := domainService.NewAssetDataService(time.Now())
+	priceOscillationService := service.NewPriceOscillationService(assetDataService)
+	defer priceOscillationService.Stop()
+	server := NewMarketDataGRPCServer(&MockGetMarketDataUseCase{}, &MockGetBatchMarketDataUseCase{}, priceOscillationService, nil)
+
+	mockStream := &MockStreamTradesServer{ctx: context.Background()}
+	mockStream.On("Recv").Return(&pb.StreamTradesRequest{
+		Action:  "subscribe",
+		Symbols: []string{"AAPL", "UNKNOWN"},
+	}, nil).Once()
+	mockStream.On("Recv").Return(nil, io.EOF).Once()
+
+	mockStream.On("Send", mock.MatchedBy(func(resp *pb.StreamTradesResponse) bool {
+		return resp.Type == "error"
+	})).Run(func(args mock.Arguments) {
+		assert.Contains(t, args.Get(0).(*pb.StreamTradesResponse).ErrorMessage, "UNKNOWN")
+	}).Return(nil).Once()
+
+	mockStream.On("Send", mock.AnythingOfType("*marketdatapb.StreamTradesResponse")).Return(nil).Maybe()
+
+	// Act
+	err := server.StreamTrades(mockStream)
+
+	// Assert
+	assert.NoError(t, err)
+	mockStream.AssertExpectations(t)
+}
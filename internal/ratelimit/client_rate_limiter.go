@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// ClientRateLimiter keeps one token bucket per client identity
+type ClientRateLimiter struct {
+	requestsPerSecond rate.Limit
+	burst             int
+	idleTTL           time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*clientBucket
+}
+
+// NewClientRateLimiter creates a limiter refilling requestsPerSecond tokens per second up to burst.
+// Buckets of clients idle for longer than idleTTL are dropped by EvictIdle.
+func NewClientRateLimiter(requestsPerSecond float64, burst int, idleTTL time.Duration) *ClientRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &ClientRateLimiter{
+		requestsPerSecond: rate.Limit(requestsPerSecond),
+		burst:             burst,
+		idleTTL:           idleTTL,
+		buckets:           make(map[string]*clientBucket),
+	}
+}
+
+// Allow consumes a token for the client. When the bucket is empty it returns false
+// and how long the client should wait before the next token is available.
+func (l *ClientRateLimiter) Allow(clientID string) (bool, time.Duration) {
+	now := time.Now()
+
+	l.mu.Lock()
+	bucket, exists := l.buckets[clientID]
+	if !exists {
+		bucket = &clientBucket{limiter: rate.NewLimiter(l.requestsPerSecond, l.burst)}
+		l.buckets[clientID] = bucket
+	}
+	bucket.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := bucket.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return false, time.Second
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return true, 0
+	}
+
+	reservation.CancelAt(now)
+	return false, delay
+}
+
+// EvictIdle removes the buckets of clients that have not made a request within the idle TTL
+func (l *ClientRateLimiter) EvictIdle() int {
+	cutoff := time.Now().Add(-l.idleTTL)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	evicted := 0
+	for clientID, bucket := range l.buckets {
+		if bucket.lastSeen.Before(cutoff) {
+			delete(l.buckets, clientID)
+			evicted++
+		}
+	}
+	return evicted
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRateLimiter_AllowsBurstThenLimits(t *testing.T) {
+	// Arrange
+	limiter := NewClientRateLimiter(1, 3, time.Minute)
+
+	// Act
+	results := make([]bool, 0, 4)
+	var retryAfter time.Duration
+	for i := 0; i < 4; i++ {
+		allowed, delay := limiter.Allow("client-a")
+		results = append(results, allowed)
+		retryAfter = delay
+	}
+
+	// Assert
+	assert.Equal(t, []bool{true, true, true, false}, results)
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, time.Second)
+}
+
+func TestClientRateLimiter_BucketsArePerClient(t *testing.T) {
+	// Arrange
+	limiter := NewClientRateLimiter(1, 1, time.Minute)
+
+	// Act
+	firstA, _ := limiter.Allow("client-a")
+	secondA, _ := limiter.Allow("client-a")
+	firstB, _ := limiter.Allow("client-b")
+
+	// Assert
+	assert.True(t, firstA)
+	assert.False(t, secondA)
+	assert.True(t, firstB)
+}
+
+func TestClientRateLimiter_EvictIdle(t *testing.T) {
+	// Arrange
+	limiter := NewClientRateLimiter(1, 1, time.Millisecond)
+	limiter.Allow("client-a")
+	time.Sleep(5 * time.Millisecond)
+
+	// Act
+	evicted := limiter.EvictIdle()
+	allowed, _ := limiter.Allow("client-a")
+
+	// Assert
+	assert.Equal(t, 1, evicted)
+	assert.True(t, allowed, "an evicted client starts again with a full bucket")
+}
+
+func TestStreamQuota_AcquireAndRelease(t *testing.T) {
+	// Arrange
+	quota := NewStreamQuota(2)
+
+	// Act
+	first := quota.Acquire("client-a")
+	second := quota.Acquire("client-a")
+	third := quota.Acquire("client-a")
+	quota.Release("client-a")
+	afterRelease := quota.Acquire("client-a")
+
+	// Assert
+	assert.True(t, first)
+	assert.True(t, second)
+	assert.False(t, third)
+	assert.True(t, afterRelease)
+	assert.Equal(t, 2, quota.ActiveStreams("client-a"))
+}
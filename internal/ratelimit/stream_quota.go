@@ -0,0 +1,51 @@
+package ratelimit
+
+import "sync"
+
+// StreamQuota caps the number of concurrent streams a single client may hold open
+type StreamQuota struct {
+	maxStreamsPerClient int
+
+	mu      sync.Mutex
+	streams map[string]int
+}
+
+func NewStreamQuota(maxStreamsPerClient int) *StreamQuota {
+	return &StreamQuota{
+		maxStreamsPerClient: maxStreamsPerClient,
+		streams:             make(map[string]int),
+	}
+}
+
+// Acquire reserves a stream slot for the client. It returns false when the client
+// already holds the maximum number of streams. A non-positive limit disables the quota.
+func (q *StreamQuota) Acquire(clientID string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.maxStreamsPerClient > 0 && q.streams[clientID] >= q.maxStreamsPerClient {
+		return false
+	}
+
+	q.streams[clientID]++
+	return true
+}
+
+// Release frees a slot previously obtained with Acquire
+func (q *StreamQuota) Release(clientID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.streams[clientID]--
+	if q.streams[clientID] <= 0 {
+		delete(q.streams, clientID)
+	}
+}
+
+// ActiveStreams returns how many streams the client currently holds
+func (q *StreamQuota) ActiveStreams(clientID string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.streams[clientID]
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualClock_Advance(t *testing.T) {
+	// Arrange
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	clock := NewVirtualClock(start)
+	ticker := clock.NewTicker(4 * time.Second)
+
+	// Act
+	clock.Advance(3 * time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker fired before its interval elapsed")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	tick := <-ticker.C()
+
+	// Assert
+	assert.Equal(t, start.Add(4*time.Second), tick)
+	assert.Equal(t, start.Add(4*time.Second), clock.Now())
+}
+
+func TestVirtualClock_DropsUnreceivedTicks(t *testing.T) {
+	// Arrange
+	start := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	clock := NewVirtualClock(start)
+	ticker := clock.NewTicker(time.Second)
+
+	// Act
+	clock.Advance(3 * time.Second)
+	first := <-ticker.C()
+
+	ticker.Stop()
+	clock.Advance(time.Second)
+
+	// Assert
+	assert.Equal(t, start.Add(time.Second), first)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker fired")
+	default:
+	}
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	mathRand "math/rand"
+	"sync"
+	"time"
+)
+
+// Random is a source of pseudo random numbers that is safe for concurrent use. Sources
+// created with the same seed return the same sequence.
+type Random struct {
+	mu   sync.Mutex
+	rand *mathRand.Rand
+}
+
+func NewRandom(seed int64) *Random {
+	return &Random{rand: mathRand.New(mathRand.NewSource(seed))}
+}
+
+// NewTimeSeededRandom returns a source seeded with the current time, for runs that do not
+// need to be reproduced
+func NewTimeSeededRandom() *Random {
+	return NewRandom(time.Now().UnixNano())
+}
+
+// Fork returns a new source seeded from this one. Collaborators drawing from their own fork
+// keep their sequences when another one draws more or fewer numbers.
+func (r *Random) Fork() *Random {
+	return NewRandom(r.Int63())
+}
+
+func (r *Random) Int63() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rand.Int63()
+}
+
+func (r *Random) Int63n(n int64) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rand.Int63n(n)
+}
+
+func (r *Random) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rand.Intn(n)
+}
+
+func (r *Random) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rand.Float64()
+}
+
+func (r *Random) NormFloat64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rand.NormFloat64()
+}
+
+func (r *Random) Shuffle(n int, swap func(i, j int)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rand.Shuffle(n, swap)
+}
@@ -0,0 +1,27 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func draw(random *Random) []float64 {
+	values := make([]float64, 5)
+	for i := range values {
+		values[i] = random.NormFloat64() + float64(random.Intn(100))
+	}
+	return values
+}
+
+func TestRandom_SameSeedSameSequence(t *testing.T) {
+	// Arrange
+	first := NewRandom(42)
+	second := NewRandom(42)
+	other := NewRandom(43)
+
+	// Act & Assert
+	assert.Equal(t, draw(first), draw(second))
+	assert.Equal(t, draw(first.Fork()), draw(second.Fork()))
+	assert.NotEqual(t, draw(NewRandom(42)), draw(other))
+}
@@ -0,0 +1 @@
+ALTER TABLE market_data DROP CONSTRAINT IF EXISTS chk_market_data_symbol_normalized;
@@ -0,0 +1,6 @@
+-- Symbols are stored in the same normalized form the service validates: trimmed and uppercase
+UPDATE market_data SET symbol = UPPER(TRIM(symbol)) WHERE symbol <> UPPER(TRIM(symbol));
+
+ALTER TABLE market_data
+    ADD CONSTRAINT chk_market_data_symbol_normalized
+    CHECK (symbol = UPPER(TRIM(symbol)) AND symbol ~ '^[A-Z0-9]([A-Z0-9-]{0,10}[A-Z0-9])?(\.[A-Z]{1,4})?$');
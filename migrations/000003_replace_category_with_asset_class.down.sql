@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS idx_market_data_asset_class;
+
+ALTER TABLE market_data ADD COLUMN category INTEGER;
+
+UPDATE market_data SET category = CASE asset_class
+    WHEN 'STOCK' THEN 1
+    WHEN 'ETF' THEN 2
+    WHEN 'CRYPTO' THEN 3
+    WHEN 'REIT' THEN 4
+    WHEN 'BOND' THEN 5
+    WHEN 'FX' THEN 6
+    WHEN 'INDEX' THEN 7
+    WHEN 'OPTION' THEN 8
+END;
+
+ALTER TABLE market_data ALTER COLUMN category SET NOT NULL;
+ALTER TABLE market_data DROP CONSTRAINT IF EXISTS chk_market_data_asset_class;
+ALTER TABLE market_data DROP COLUMN asset_class;
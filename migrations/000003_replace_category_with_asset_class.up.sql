@@ -0,0 +1,30 @@
+-- Replace the bare integer category with the asset class name used by the service.
+-- Legacy codes: 1 stock, 2 ETF, 3 crypto; 4-8 follow the gRPC AssetClass enum.
+ALTER TABLE market_data ADD COLUMN asset_class VARCHAR(16);
+
+UPDATE market_data SET asset_class = CASE category
+    WHEN 1 THEN 'STOCK'
+    WHEN 2 THEN 'ETF'
+    WHEN 3 THEN 'CRYPTO'
+    WHEN 4 THEN 'REIT'
+    WHEN 5 THEN 'BOND'
+    WHEN 6 THEN 'FX'
+    WHEN 7 THEN 'INDEX'
+    WHEN 8 THEN 'OPTION'
+END;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM market_data WHERE asset_class IS NULL) THEN
+        RAISE EXCEPTION 'market_data contains unknown category values, map them before migrating';
+    END IF;
+END $$;
+
+ALTER TABLE market_data
+    ALTER COLUMN asset_class SET NOT NULL,
+    ADD CONSTRAINT chk_market_data_asset_class
+    CHECK (asset_class IN ('STOCK', 'ETF', 'REIT', 'BOND', 'CRYPTO', 'FX', 'INDEX', 'OPTION'));
+
+ALTER TABLE market_data DROP COLUMN category;
+
+CREATE INDEX idx_market_data_asset_class ON market_data(asset_class);
@@ -0,0 +1,3 @@
+ALTER TABLE market_data DROP CONSTRAINT IF EXISTS chk_market_data_price_precision;
+ALTER TABLE market_data DROP COLUMN price_precision;
+ALTER TABLE market_data ALTER COLUMN last_quote TYPE DECIMAL(10, 2);
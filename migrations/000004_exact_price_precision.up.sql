@@ -0,0 +1,17 @@
+-- Prices are exact decimals with a per-instrument number of decimal places.
+-- last_quote is widened so FX and crypto prices are not truncated to cents.
+ALTER TABLE market_data ALTER COLUMN last_quote TYPE NUMERIC(20, 8);
+
+ALTER TABLE market_data ADD COLUMN price_precision SMALLINT;
+
+UPDATE market_data SET price_precision = CASE asset_class
+    WHEN 'FX' THEN 5
+    WHEN 'CRYPTO' THEN 4
+    WHEN 'BOND' THEN 3
+    ELSE 2
+END;
+
+ALTER TABLE market_data
+    ALTER COLUMN price_precision SET NOT NULL,
+    ALTER COLUMN price_precision SET DEFAULT 2,
+    ADD CONSTRAINT chk_market_data_price_precision CHECK (price_precision BETWEEN 0 AND 8);
@@ -0,0 +1,11 @@
+-- Closing price of every symbol at each daily session rollover. The latest row per
+-- symbol is the previous close the day change is measured against.
+CREATE TABLE IF NOT EXISTS session_closes (
+    symbol VARCHAR(20) NOT NULL,
+    session_date DATE NOT NULL,
+    close_price NUMERIC(20, 8) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (symbol, session_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_closes_session_date ON session_closes(session_date);
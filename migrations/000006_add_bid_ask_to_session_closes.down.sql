@@ -0,0 +1,5 @@
+ALTER TABLE session_closes
+    DROP COLUMN IF EXISTS close_bid,
+    DROP COLUMN IF EXISTS close_ask,
+    DROP COLUMN IF EXISTS close_bid_size,
+    DROP COLUMN IF EXISTS close_ask_size;
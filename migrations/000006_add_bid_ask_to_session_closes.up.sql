@@ -0,0 +1,7 @@
+-- Bid and ask quoted at each session close. Closes saved before bid/ask were simulated
+-- keep zeros.
+ALTER TABLE session_closes
+    ADD COLUMN IF NOT EXISTS close_bid NUMERIC(20, 8) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS close_ask NUMERIC(20, 8) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS close_bid_size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS close_ask_size BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,16 @@
+-- Latest simulated state of every symbol, saved periodically and on shutdown so a restart
+-- resumes from the last prices and session statistics
+CREATE TABLE IF NOT EXISTS quote_states (
+    symbol VARCHAR(20) PRIMARY KEY,
+    session_date DATE NOT NULL,
+    current_price NUMERIC(20, 8) NOT NULL,
+    base_price NUMERIC(20, 8) NOT NULL,
+    open_price NUMERIC(20, 8) NOT NULL,
+    high_price NUMERIC(20, 8) NOT NULL,
+    low_price NUMERIC(20, 8) NOT NULL,
+    previous_close NUMERIC(20, 8) NOT NULL,
+    volume BIGINT NOT NULL DEFAULT 0,
+    turnover NUMERIC(30, 8) NOT NULL DEFAULT 0,
+    vwap NUMERIC(20, 10) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL
+);
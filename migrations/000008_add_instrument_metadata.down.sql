@@ -0,0 +1,7 @@
+ALTER TABLE market_data
+    DROP CONSTRAINT IF EXISTS chk_market_data_tick_size,
+    DROP CONSTRAINT IF EXISTS chk_market_data_lot_size,
+    DROP CONSTRAINT IF EXISTS chk_market_data_currency,
+    DROP COLUMN IF EXISTS tick_size,
+    DROP COLUMN IF EXISTS lot_size,
+    DROP COLUMN IF EXISTS currency;
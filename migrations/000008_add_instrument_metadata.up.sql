@@ -0,0 +1,36 @@
+-- Trading metadata of every instrument: prices are whole multiples of tick_size and
+-- quantities whole multiples of lot_size, in currency. Existing rows get the defaults of
+-- their asset class; FX pairs are priced in their quote currency and B3 listings in reais.
+ALTER TABLE market_data
+    ADD COLUMN IF NOT EXISTS tick_size NUMERIC(20, 8),
+    ADD COLUMN IF NOT EXISTS lot_size BIGINT,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3);
+
+UPDATE market_data SET
+    tick_size = POWER(10::NUMERIC, -price_precision),
+    lot_size = CASE asset_class
+        WHEN 'BOND' THEN 10
+        WHEN 'CRYPTO' THEN 1
+        WHEN 'INDEX' THEN 1
+        WHEN 'OPTION' THEN 1
+        WHEN 'FX' THEN 100000
+        ELSE 100
+    END,
+    currency = CASE
+        WHEN asset_class = 'FX' THEN SUBSTRING(symbol FROM 4 FOR 3)
+        WHEN symbol LIKE '%.SA' THEN 'BRL'
+        ELSE 'USD'
+    END
+WHERE tick_size IS NULL OR lot_size IS NULL OR currency IS NULL;
+
+ALTER TABLE market_data
+    ALTER COLUMN tick_size SET NOT NULL,
+    ALTER COLUMN tick_size SET DEFAULT 0.01,
+    ALTER COLUMN lot_size SET NOT NULL,
+    ALTER COLUMN lot_size SET DEFAULT 100,
+    ALTER COLUMN currency SET NOT NULL,
+    ALTER COLUMN currency SET DEFAULT 'USD',
+    ADD CONSTRAINT chk_market_data_tick_size
+    CHECK (tick_size > 0 AND tick_size = ROUND(tick_size, price_precision)),
+    ADD CONSTRAINT chk_market_data_lot_size CHECK (lot_size > 0),
+    ADD CONSTRAINT chk_market_data_currency CHECK (currency ~ '^[A-Z]{3}$');
@@ -0,0 +1,23 @@
+-- Splits, cash dividends and symbol changes, applied at the first session rollover on or
+-- after ex_date. Applying an action back-adjusts the session closes before ex_date by
+-- price_factor and records when it was applied; pending actions have no applied_at.
+CREATE TABLE IF NOT EXISTS corporate_actions (
+    id BIGSERIAL PRIMARY KEY,
+    symbol VARCHAR(20) NOT NULL,
+    action_type VARCHAR(20) NOT NULL,
+    ex_date DATE NOT NULL,
+    split_from BIGINT NOT NULL DEFAULT 0,
+    split_to BIGINT NOT NULL DEFAULT 0,
+    dividend_amount NUMERIC(20, 8) NOT NULL DEFAULT 0,
+    new_symbol VARCHAR(20) NOT NULL DEFAULT '',
+    price_factor NUMERIC(24, 12),
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_corporate_actions_terms CHECK (
+        (action_type = 'SPLIT' AND split_from > 0 AND split_to > 0 AND split_from <> split_to)
+        OR (action_type = 'CASH_DIVIDEND' AND dividend_amount > 0)
+        OR (action_type = 'SYMBOL_CHANGE' AND new_symbol <> '' AND new_symbol <> symbol)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_corporate_actions_pending ON corporate_actions(ex_date) WHERE applied_at IS NULL;
@@ -0,0 +1,22 @@
+#!/bin/bash
+
+# Generates Go code for the market data proto contract.
+# common/common.proto is resolved from the hub-proto-contracts module so the
+# generated code reuses its Go types.
+
+set -e
+
+PROTO_DIR="internal/infrastructure/grpc/proto"
+CONTRACTS_DIR=$(go list -m -f '{{.Dir}}' github.com/RodriguesYan/hub-proto-contracts)
+
+if ! command -v protoc &> /dev/null; then
+    echo "protoc not found. Please install Protocol Buffers compiler."
+    exit 1
+fi
+
+protoc -I . -I "$CONTRACTS_DIR" \
+    --go_out=. --go_opt=paths=source_relative \
+    --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+    "$PROTO_DIR"/*.proto
+
+echo "Generated Go code in $PROTO_DIR"
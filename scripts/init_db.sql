@@ -5,21 +5,38 @@ CREATE TABLE IF NOT EXISTS market_data (
     id SERIAL PRIMARY KEY,
     symbol VARCHAR(50) NOT NULL UNIQUE,
     name VARCHAR(255) NOT NULL,
-    category INTEGER NOT NULL,
-    last_quote DECIMAL(10, 2) NOT NULL,
+    asset_class VARCHAR(16) NOT NULL CHECK (asset_class IN ('STOCK', 'ETF', 'REIT', 'BOND', 'CRYPTO', 'FX', 'INDEX', 'OPTION')),
+    last_quote NUMERIC(20, 8) NOT NULL,
+    price_precision SMALLINT NOT NULL DEFAULT 2 CHECK (price_precision BETWEEN 0 AND 8),
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
 -- Create index for faster symbol lookups
 CREATE INDEX IF NOT EXISTS idx_market_data_symbol ON market_data(symbol);
+CREATE INDEX IF NOT EXISTS idx_market_data_asset_class ON market_data(asset_class);
+
+-- Create session_closes table (closing price, bid and ask at each daily rollover)
+CREATE TABLE IF NOT EXISTS session_closes (
+    symbol VARCHAR(20) NOT NULL,
+    session_date DATE NOT NULL,
+    close_price NUMERIC(20, 8) NOT NULL,
+    close_bid NUMERIC(20, 8) NOT NULL DEFAULT 0,
+    close_ask NUMERIC(20, 8) NOT NULL DEFAULT 0,
+    close_bid_size BIGINT NOT NULL DEFAULT 0,
+    close_ask_size BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (symbol, session_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_closes_session_date ON session_closes(session_date);
 
 -- Insert initial test data
-INSERT INTO market_data (symbol, name, category, last_quote) VALUES
-('AAPL', 'Apple Inc.', 1, 150.00),
-('MSFT', 'Microsoft Corporation', 1, 300.00),
-('GOOGL', 'Alphabet Inc.', 1, 140.00),
-('AMZN', 'Amazon.com Inc.', 1, 180.00)
+INSERT INTO market_data (symbol, name, asset_class, last_quote) VALUES
+('AAPL', 'Apple Inc.', 'STOCK', 150.00),
+('MSFT', 'Microsoft Corporation', 'STOCK', 300.00),
+('GOOGL', 'Alphabet Inc.', 'STOCK', 140.00),
+('AMZN', 'Amazon.com Inc.', 'STOCK', 180.00)
 ON CONFLICT (symbol) DO NOTHING;
 
 -- Grant permissions (if needed)
@@ -97,12 +97,30 @@ CREATE TEMP TABLE temp_market_data (
 \COPY temp_market_data FROM '/tmp/market_data_export.csv' WITH CSV HEADER
 
 -- Insert with conflict handling (update if exists)
-INSERT INTO market_data (symbol, name, category, last_quote, created_at, updated_at)
-SELECT symbol, name, category, last_quote, created_at, updated_at
+-- The monolith still stores the legacy integer category, convert it to the asset class name
+INSERT INTO market_data (symbol, name, asset_class, price_precision, last_quote, created_at, updated_at)
+SELECT UPPER(TRIM(symbol)), name,
+    CASE category
+        WHEN 1 THEN 'STOCK'
+        WHEN 2 THEN 'ETF'
+        WHEN 3 THEN 'CRYPTO'
+        WHEN 4 THEN 'REIT'
+        WHEN 5 THEN 'BOND'
+        WHEN 6 THEN 'FX'
+        WHEN 7 THEN 'INDEX'
+        WHEN 8 THEN 'OPTION'
+    END,
+    CASE category
+        WHEN 3 THEN 4
+        WHEN 5 THEN 3
+        WHEN 6 THEN 5
+        ELSE 2
+    END,
+    last_quote, created_at, updated_at
 FROM temp_market_data
 ON CONFLICT (symbol) DO UPDATE SET
     name = EXCLUDED.name,
-    category = EXCLUDED.category,
+    asset_class = EXCLUDED.asset_class,
     last_quote = EXCLUDED.last_quote,
     updated_at = EXCLUDED.updated_at;
 
@@ -129,7 +147,7 @@ echo ""
 
 # Show sample data
 echo -e "${YELLOW}Sample data in target database:${NC}"
-PGPASSWORD="$TARGET_DB_PASSWORD" psql -h "$TARGET_DB_HOST" -p "$TARGET_DB_PORT" -U "$TARGET_DB_USER" -d "$TARGET_DB_NAME" -c "SELECT symbol, name, category, last_quote FROM market_data LIMIT 5"
+PGPASSWORD="$TARGET_DB_PASSWORD" psql -h "$TARGET_DB_HOST" -p "$TARGET_DB_PORT" -U "$TARGET_DB_USER" -d "$TARGET_DB_NAME" -c "SELECT symbol, name, asset_class, last_quote FROM market_data LIMIT 5"
 echo ""
 
 echo -e "${GREEN}========================================${NC}"
@@ -11,7 +11,9 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/RodriguesYan/hub-proto-contracts/monolith"
 )
@@ -26,6 +28,7 @@ var (
 type Stats struct {
 	successfulConnections int64
 	failedConnections     int64
+	rejectedConnections   int64
 	totalQuotes           int64
 	totalHeartbeats       int64
 	totalErrors           int64
@@ -106,6 +109,9 @@ func main() {
 	fmt.Printf("Failed Conns:         %d (%.1f%%)\n",
 		stats.failedConnections,
 		float64(stats.failedConnections)/float64(*numClientsFlag)*100)
+	fmt.Printf("Rejected by Quota:    %d (%.1f%%)\n",
+		stats.rejectedConnections,
+		float64(stats.rejectedConnections)/float64(*numClientsFlag)*100)
 	fmt.Println()
 	fmt.Printf("Total Quotes:         %d\n", stats.totalQuotes)
 	fmt.Printf("Total Heartbeats:     %d\n", stats.totalHeartbeats)
@@ -164,8 +170,9 @@ func runClient(clientID int, allSymbols []string, stats *Stats) {
 		return
 	}
 
-	atomic.AddInt64(&stats.successfulConnections, 1)
-
+	// Quotas are enforced once the subscription is processed, so a connection only counts as
+	// successful after its first response
+	connected := false
 	for {
 		resp, err := stream.Recv()
 		if err == io.EOF {
@@ -175,9 +182,18 @@ func runClient(clientID int, allSymbols []string, stats *Stats) {
 			if ctx.Err() == context.DeadlineExceeded {
 				break
 			}
+			if status.Code(err) == codes.ResourceExhausted {
+				// The server enforces per-client stream and symbol quotas (RATE_LIMIT_* settings)
+				atomic.AddInt64(&stats.rejectedConnections, 1)
+				break
+			}
 			atomic.AddInt64(&stats.totalErrors, 1)
 			break
 		}
+		if !connected {
+			connected = true
+			atomic.AddInt64(&stats.successfulConnections, 1)
+		}
 
 		switch resp.Type {
 		case "quote":
@@ -213,11 +229,13 @@ func printProgress(stats *Stats, elapsed time.Duration) {
 	heartbeats := atomic.LoadInt64(&stats.totalHeartbeats)
 	successful := atomic.LoadInt64(&stats.successfulConnections)
 	failed := atomic.LoadInt64(&stats.failedConnections)
+	rejected := atomic.LoadInt64(&stats.rejectedConnections)
 
-	log.Printf("📊 [%s] Conns: %d✓ %d✗ | Quotes: %d (%.1f/s) | Heartbeats: %d",
+	log.Printf("📊 [%s] Conns: %d✓ %d✗ %d⛔ | Quotes: %d (%.1f/s) | Heartbeats: %d",
 		elapsed.Round(time.Second),
 		successful,
 		failed,
+		rejected,
 		quotes,
 		float64(quotes)/elapsed.Seconds(),
 		heartbeats,